@@ -4,11 +4,13 @@
 
 // Command hb-client sends a HeartbeatRequest and checks response.
 //
-// Heartbeat exchanging feature is planned be included in the go-pfcp package's
-// built-in functions in the future.
+// This exchanges a single Heartbeat for demonstration. To send Heartbeats
+// periodically and detect the failure or restart of the peers, use
+// node.HeartbeatManager instead.
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
 	"net"
@@ -16,6 +18,7 @@ import (
 
 	"github.com/wmnsk/go-pfcp/ie"
 	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/transport"
 )
 
 func main() {
@@ -29,56 +32,37 @@ func main() {
 		log.Fatal(err)
 	}
 
-	conn, err := net.DialUDP("udp", nil, raddr)
+	conn, err := transport.Listen("udp", ":0", &transport.Config{T1: time.Second, N1: 2})
 	if err != nil {
 		log.Fatal(err)
 	}
+	defer conn.Close()
 
-	var seq uint32 = 1
-	hbreq, err := message.NewHeartbeatRequest(
-		seq,
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = conn.Serve(ctx, nil) }()
+
+	// The sequence number is allocated by conn.
+	hbreq := message.NewHeartbeatRequest(
+		0,
 		ie.NewRecoveryTimeStamp(time.Now()),
 		ie.NewSourceIPAddress(net.ParseIP("127.0.0.1"), net.ParseIP("2001::1"), 0),
-	).Marshal()
+	)
+
+	log.Printf("sending Heartbeat Request to: %s", raddr)
+	msg, err := conn.SendRequest(ctx, raddr, hbreq)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	if _, err := conn.Write(hbreq); err != nil {
-		log.Fatal(err)
+	hbres, ok := msg.(*message.HeartbeatResponse)
+	if !ok {
+		log.Fatalf("got unexpected message: %s, from: %s", msg.MessageTypeName(), raddr)
 	}
-	log.Printf("sent Heartbeat Request to: %s", raddr)
 
-	if err := conn.SetReadDeadline(time.Now().Add(3 * time.Second)); err != nil {
-		log.Fatal(err)
-	}
-
-	buf := make([]byte, 1500)
-	waiting := true
-	for waiting {
-		n, addr, err := conn.ReadFrom(buf)
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		msg, err := message.Parse(buf[:n])
-		if err != nil {
-			log.Printf("ignored undecodable message: %x, error: %s", buf[:n], err)
-			continue
-		}
-
-		hbres, ok := msg.(*message.HeartbeatResponse)
-		if !ok {
-			log.Printf("got unexpected message: %s, from: %s", msg.MessageTypeName(), addr)
-			continue
-		}
-
-		waiting = false
-		ts, err := hbres.RecoveryTimeStamp.RecoveryTimeStamp()
-		if err != nil {
-			log.Printf("got Heartbeat Response with invalid TS: %s, from: %s", err, addr)
-			break
-		}
-		log.Printf("got Heartbeat Response with TS: %s, from: %s", ts, addr)
+	ts, err := hbres.RecoveryTimeStamp.RecoveryTimeStamp()
+	if err != nil {
+		log.Fatalf("got Heartbeat Response with invalid TS: %s, from: %s", err, raddr)
 	}
+	log.Printf("got Heartbeat Response with TS: %s, from: %s", ts, raddr)
 }
@@ -4,18 +4,20 @@
 
 // Command hb-server sends a HeartbeatRequest and checks response.
 //
-// Heartbeat exchanging feature is planned be included in the go-pfcp package's
-// built-in functions in the future.
+// This exchanges a single Heartbeat for demonstration. To send Heartbeats
+// periodically and detect the failure or restart of the peers, use
+// node.HeartbeatManager instead.
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
-	"net"
 	"time"
 
 	"github.com/wmnsk/go-pfcp/ie"
 	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/server"
 )
 
 func main() {
@@ -24,54 +26,25 @@ func main() {
 	)
 	flag.Parse()
 
-	laddr, err := net.ResolveUDPAddr("udp", *listen)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	conn, err := net.ListenUDP("udp", laddr)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	buf := make([]byte, 1500)
-	for {
-		log.Printf("waiting for messages to come on: %s", laddr)
-		n, addr, err := conn.ReadFrom(buf)
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		msg, err := message.Parse(buf[:n])
-		if err != nil {
-			log.Printf("ignored undecodable message: %x, error: %s", buf[:n], err)
-			continue
-		}
-
-		hbreq, ok := msg.(*message.HeartbeatRequest)
-		if !ok {
-			log.Printf("got unexpected message: %s, from: %s", msg.MessageTypeName(), addr)
-			continue
-		}
-
-		ts, err := hbreq.RecoveryTimeStamp.RecoveryTimeStamp()
-		if err != nil {
-			log.Printf("got Heartbeat Request with invalid TS: %s, from: %s", err, addr)
-			continue
-		} else {
-			log.Printf("got Heartbeat Request with TS: %s, from: %s", ts, addr)
-		}
-
-		// Timestamp shouldn't be the time message is sent in the real deployment but anyway :D
-		var seq uint32 = 1
-		hbres, err := message.NewHeartbeatResponse(seq, ie.NewRecoveryTimeStamp(time.Now())).Marshal()
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		if _, err := conn.WriteTo(hbres, addr); err != nil {
-			log.Fatal(err)
-		}
-		log.Printf("sent Heartbeat Response to: %s", addr)
-	}
+	srv := server.New(*listen, nil)
+	srv.Handle(message.MsgTypeHeartbeatRequest, server.Typed(
+		func(w server.ResponseWriter, r *server.Request, hbreq *message.HeartbeatRequest) {
+			ts, err := hbreq.RecoveryTimeStamp.RecoveryTimeStamp()
+			if err != nil {
+				log.Printf("got Heartbeat Request with invalid TS: %s, from: %s", err, r.Peer)
+				return
+			}
+			log.Printf("got Heartbeat Request with TS: %s, from: %s", ts, r.Peer)
+
+			// Timestamp shouldn't be the time message is sent in the real deployment but anyway :D
+			if err := w.Write(message.NewHeartbeatResponse(0, ie.NewRecoveryTimeStamp(time.Now()))); err != nil {
+				log.Printf("failed to send Heartbeat Response to: %s, error: %s", r.Peer, err)
+				return
+			}
+			log.Printf("sent Heartbeat Response to: %s", r.Peer)
+		},
+	))
+
+	log.Printf("waiting for messages to come on: %s", *listen)
+	log.Fatal(srv.ListenAndServe(context.Background()))
 }
@@ -9,6 +9,18 @@ func NewAccessAvailabilityControlInformation(info *IE) *IE {
 	return newGroupedIE(AccessAvailabilityControlInformation, 0, info)
 }
 
+// NewAccessAvailabilityControlInformationFromFields creates a new
+// AccessAvailabilityControlInformation IE from the given fields.
+func NewAccessAvailabilityControlInformationFromFields(f *AccessAvailabilityControlInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewAccessAvailabilityControlInformation(
+		NewRequestedAccessAvailabilityInformation(f.RequestedAccessAvailabilityInformation),
+	)
+}
+
 // AccessAvailabilityControlInformation returns the IEs above AccessAvailabilityControlInformation if the type of IE matches.
 func (i *IE) AccessAvailabilityControlInformation() (*AccessAvailabilityControlInformationFields, error) {
 	switch i.Type {
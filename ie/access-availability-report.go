@@ -9,6 +9,18 @@ func NewAccessAvailabilityReport(info *IE) *IE {
 	return newGroupedIE(AccessAvailabilityReport, 0, info)
 }
 
+// NewAccessAvailabilityReportFromFields creates a new AccessAvailabilityReport IE
+// from the given fields.
+func NewAccessAvailabilityReportFromFields(f *AccessAvailabilityReportFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewAccessAvailabilityReport(
+		newUint8ValIE(AccessAvailabilityInformation, f.AccessAvailabilityInformation),
+	)
+}
+
 // AccessAvailabilityReport returns the IEs above AccessAvailabilityReport if the type of IE matches.
 func (i *IE) AccessAvailabilityReport() (*AccessAvailabilityReportFields, error) {
 	if i.Type != AccessAvailabilityReport {
@@ -5,6 +5,31 @@ func NewAddMBSUnicastParameters(ies ...*IE) *IE {
 	return newGroupedIE(AddMBSUnicastParameters, 0, ies...)
 }
 
+// NewAddMBSUnicastParametersFromFields creates a new AddMBSUnicastParameters IE
+// from the given fields.
+func NewAddMBSUnicastParametersFromFields(f *AddMBSUnicastParametersFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewDestinationInterface(f.DestinationInterface),
+		NewMBSUnicastParametersID(f.MBSUnicastParametersID),
+	}
+	if f.NetworkInstance != "" {
+		ies = append(ies, NewNetworkInstance(f.NetworkInstance))
+	}
+	ies = append(ies, newFieldsIE(OuterHeaderCreation, f.OuterHeaderCreation))
+	if f.HasTransportLevelMarking || f.TransportLevelMarking != 0 {
+		ies = append(ies, NewTransportLevelMarking(f.TransportLevelMarking))
+	}
+	if f.HasDestinationInterfaceType || f.DestinationInterfaceType != 0 {
+		ies = append(ies, NewTGPPInterfaceType(f.DestinationInterfaceType))
+	}
+
+	return NewAddMBSUnicastParameters(ies...)
+}
+
 // AddMBSUnicastParameters returns the IEs above AddMBSUnicastParameters if the type of IE matches.
 func (i *IE) AddMBSUnicastParameters() (*AddMBSUnicastParametersFields, error) {
 	switch i.Type {
@@ -44,12 +69,14 @@ func (i *IE) AddMBSUnicastParameters() (*AddMBSUnicastParametersFields, error) {
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type AddMBSUnicastParametersFields struct {
-	DestinationInterface     uint8
-	MBSUnicastParametersID   uint16
-	NetworkInstance          string
-	OuterHeaderCreation      *OuterHeaderCreationFields
-	TransportLevelMarking    uint16
-	DestinationInterfaceType uint8
+	DestinationInterface        uint8
+	MBSUnicastParametersID      uint16
+	NetworkInstance             string
+	OuterHeaderCreation         *OuterHeaderCreationFields
+	TransportLevelMarking       uint16
+	HasTransportLevelMarking    bool
+	DestinationInterfaceType    uint8
+	HasDestinationInterfaceType bool
 }
 
 // ParseAddMBSUnicastParametersFields returns the IEs above AddMBSUnicastParameters.
@@ -103,12 +130,14 @@ func (a *AddMBSUnicastParametersFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			a.TransportLevelMarking = transport
+			a.HasTransportLevelMarking = true
 		case TGPPInterfaceType:
 			tgppinterface, err := ie.TGPPInterfaceType()
 			if err != nil {
 				return err
 			}
 			a.DestinationInterfaceType = tgppinterface
+			a.HasDestinationInterfaceType = true
 		}
 	}
 	return nil
@@ -11,6 +11,40 @@ func NewAdditionalMonitoringTime(ies ...*IE) *IE {
 	return newGroupedIE(AdditionalMonitoringTime, 0, ies...)
 }
 
+// NewAdditionalMonitoringTimeFromFields creates a new AdditionalMonitoringTime IE
+// from the given fields.
+func NewAdditionalMonitoringTimeFromFields(f *AdditionalMonitoringTimeFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewMonitoringTime(f.MonitoringTime),
+		newFieldsIE(SubsequentVolumeThreshold, f.SubsequentVolumeThreshold),
+	}
+	if f.HasSubsequentTimeThreshold || f.SubsequentTimeThreshold != 0 {
+		ies = append(ies, NewSubsequentTimeThreshold(f.SubsequentTimeThreshold))
+	}
+	ies = append(ies, newFieldsIE(SubsequentVolumeQuota, f.SubsequentVolumeQuota))
+	if f.HasSubsequentTimeQuota || f.SubsequentTimeQuota != 0 {
+		ies = append(ies, NewSubsequentTimeQuota(f.SubsequentTimeQuota))
+	}
+	if f.HasSubsequentEventThreshold || f.SubsequentEventThreshold != 0 {
+		ies = append(ies, NewSubsequentEventThreshold(f.SubsequentEventThreshold))
+	}
+	if f.HasSubsequentEventQuota || f.SubsequentEventQuota != 0 {
+		ies = append(ies, NewSubsequentEventQuota(f.SubsequentEventQuota))
+	}
+	if f.HasEventThreshold || f.EventThreshold != 0 {
+		ies = append(ies, NewEventThreshold(f.EventThreshold))
+	}
+	if f.HasEventQuota || f.EventQuota != 0 {
+		ies = append(ies, NewEventQuota(f.EventQuota))
+	}
+
+	return NewAdditionalMonitoringTime(ies...)
+}
+
 // AdditionalMonitoringTime returns the IEs above AdditionalMonitoringTime if the type of IE matches.
 func (i *IE) AdditionalMonitoringTime() (*AdditionalMonitoringTimeFields, error) {
 	switch i.Type {
@@ -44,13 +78,21 @@ func (i *IE) AdditionalMonitoringTime() (*AdditionalMonitoringTimeFields, error)
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type AdditionalMonitoringTimeFields struct {
-	MonitoringTime            time.Time
-	SubsequentVolumeThreshold *SubsequentVolumeThresholdFields
-	SubsequentTimeThreshold   time.Duration
-	SubsequentVolumeQuota     *SubsequentVolumeQuotaFields
-	SubsequentTimeQuota       time.Duration
-	SubsequentEventThreshold  uint32
-	SubsequentEventQuota      uint32
+	MonitoringTime              time.Time
+	SubsequentVolumeThreshold   *SubsequentVolumeThresholdFields
+	SubsequentTimeThreshold     time.Duration
+	HasSubsequentTimeThreshold  bool
+	SubsequentVolumeQuota       *SubsequentVolumeQuotaFields
+	SubsequentTimeQuota         time.Duration
+	HasSubsequentTimeQuota      bool
+	SubsequentEventThreshold    uint32
+	HasSubsequentEventThreshold bool
+	SubsequentEventQuota        uint32
+	HasSubsequentEventQuota     bool
+	EventThreshold              uint32
+	HasEventThreshold           bool
+	EventQuota                  uint32
+	HasEventQuota               bool
 }
 
 func ParseAdditionalMonitoringTimeFields(b []byte) (*AdditionalMonitoringTimeFields, error) {
@@ -82,6 +124,7 @@ func ParseAdditionalMonitoringTimeFields(b []byte) (*AdditionalMonitoringTimeFie
 				return a, err
 			}
 			a.SubsequentTimeThreshold = duration
+			a.HasSubsequentTimeThreshold = true
 		case SubsequentVolumeQuota:
 			quota, err := ie.SubsequentVolumeQuota()
 			if err != nil {
@@ -94,18 +137,35 @@ func ParseAdditionalMonitoringTimeFields(b []byte) (*AdditionalMonitoringTimeFie
 				return a, err
 			}
 			a.SubsequentTimeQuota = quota
+			a.HasSubsequentTimeQuota = true
 		case SubsequentEventThreshold:
 			event, err := ie.SubsequentEventThreshold()
 			if err != nil {
 				return a, err
 			}
 			a.SubsequentEventThreshold = event
+			a.HasSubsequentEventThreshold = true
 		case SubsequentEventQuota:
 			event, err := ie.SubsequentEventQuota()
 			if err != nil {
 				return a, err
 			}
 			a.SubsequentEventQuota = event
+			a.HasSubsequentEventQuota = true
+		case EventThreshold:
+			event, err := ie.EventThreshold()
+			if err != nil {
+				return a, err
+			}
+			a.EventThreshold = event
+			a.HasEventThreshold = true
+		case EventQuota:
+			event, err := ie.EventQuota()
+			if err != nil {
+				return a, err
+			}
+			a.EventQuota = event
+			a.HasEventQuota = true
 		}
 	}
 	return a, nil
@@ -9,6 +9,15 @@ func NewAggregatedURRs(ies ...*IE) *IE {
 	return newGroupedIE(AggregatedURRs, 0, ies...)
 }
 
+// NewAggregatedURRsFromFields creates a new AggregatedURRs IE from the given fields.
+func NewAggregatedURRsFromFields(f *AggregatedURRsField) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewAggregatedURRs(NewAggregatedURRID(f.AggregatedURRID), f.Multiplier)
+}
+
 // AggregatedURRs returns the IEs above AggregatedURRs if the type of IE matches.
 func (i *IE) AggregatedURRs() (*AggregatedURRsField, error) {
 	switch i.Type {
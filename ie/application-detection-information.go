@@ -9,6 +9,27 @@ func NewApplicationDetectionInformation(ies ...*IE) *IE {
 	return newGroupedIE(ApplicationDetectionInformation, 0, ies...)
 }
 
+// NewApplicationDetectionInformationFromFields creates a new
+// ApplicationDetectionInformation IE from the given fields.
+func NewApplicationDetectionInformationFromFields(f *ApplicationDetectionInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewApplicationID(f.ApplicationID)}
+	if f.ApplicationInstanceID != "" {
+		ies = append(ies, NewApplicationInstanceID(f.ApplicationInstanceID))
+	}
+	if len(f.FlowInformation) > 0 {
+		ies = append(ies, New(FlowInformation, f.FlowInformation))
+	}
+	if f.HasPDRID || f.PDRID != 0 {
+		ies = append(ies, NewPDRID(f.PDRID))
+	}
+
+	return NewApplicationDetectionInformation(ies...)
+}
+
 // ApplicationDetectionInformation returns the IEs above ApplicationDetectionInformation if the type of IE matches.
 func (i *IE) ApplicationDetectionInformation() (*ApplicationDetectionInformationFields, error) {
 	switch i.Type {
@@ -48,6 +69,7 @@ type ApplicationDetectionInformationFields struct {
 	ApplicationInstanceID string
 	FlowInformation       []byte
 	PDRID                 uint16
+	HasPDRID              bool
 }
 
 // ParseApplicationDetectionInformationFields returns the IEs above Update FAR
@@ -95,6 +117,7 @@ func (a *ApplicationDetectionInformationFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			a.PDRID = v
+			a.HasPDRID = true
 		}
 	}
 	return nil
@@ -9,6 +9,21 @@ func NewApplicationIDsPFDs(ies ...*IE) *IE {
 	return newGroupedIE(ApplicationIDsPFDs, 0, ies...)
 }
 
+// NewApplicationIDsPFDsFromFields creates a new ApplicationIDsPFDs IE from the
+// given fields.
+func NewApplicationIDsPFDsFromFields(f *ApplicationIDsPFDsFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewApplicationID(f.ApplicationID)}
+	for _, v := range f.PFDContexts {
+		ies = append(ies, NewPFDContextFromFields(v))
+	}
+
+	return NewApplicationIDsPFDs(ies...)
+}
+
 // ApplicationIDsPFDs returns the IEs above ApplicationIDsPFDs if the type of IE matches.
 func (i *IE) ApplicationIDsPFDs() (*ApplicationIDsPFDsFields, error) {
 	if i.Type != ApplicationIDsPFDs {
@@ -205,7 +205,7 @@ func (a *ApplyActionFields) MarshalTo(b []byte) error {
 		firstOctet |= seventhBit
 	}
 	if a.Drft {
-		firstOctet |= seventhBit
+		firstOctet |= lastBit
 	}
 	if a.Edrt {
 		secondOctet |= firstBit
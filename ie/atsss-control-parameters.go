@@ -26,6 +26,7 @@ type ATSSSControlParametersFields struct {
 	MPTCPParameters   *MPTCPParametersFields
 	ATSSSLLParameters *ATSSSLLParametersFields
 	PMFParameters     *PMFParametersFields
+	MPQUICParameters  *MPQUICParametersFields
 }
 
 // ParseATSSSControlParametersFields returns the IEs above ProvideATSSSControlInformation IE
@@ -53,6 +54,12 @@ func ParseATSSSControlParametersFields(b []byte) (*ATSSSControlParametersFields,
 				return p, err
 			}
 			p.ATSSSLLParameters = v
+		case MPQUICParameters:
+			v, err := ie.MPQUICParameters()
+			if err != nil {
+				return p, err
+			}
+			p.MPQUICParameters = v
 		case PMFParameters:
 			v, err := ie.PMFParameters()
 			if err != nil {
@@ -13,6 +13,30 @@ func NewClockDriftControlInformation(ies ...*IE) *IE {
 	return newGroupedIE(ClockDriftControlInformation, 0, ies...)
 }
 
+// NewClockDriftControlInformationFromFields creates a new
+// ClockDriftControlInformation IE from the given fields.
+func NewClockDriftControlInformationFromFields(f *ClockDriftControlInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{newUint8ValIE(RequestedClockDriftInformation, f.RequestedClockDriftInformation)}
+	if f.HasTimeDomainNumber || f.TimeDomainNumber != 0 {
+		ies = append(ies, NewTSNTimeDomainNumber(f.TimeDomainNumber))
+	}
+	if f.HasConfiguredTimeDomain || f.ConfiguredTimeDomain != 0 {
+		ies = append(ies, NewConfiguredTimeDomain(f.ConfiguredTimeDomain))
+	}
+	if f.HasTimeOffsetThreshold || f.TimeOffsetThreshold != 0 {
+		ies = append(ies, NewTimeOffsetThreshold(f.TimeOffsetThreshold))
+	}
+	if f.HasCumulativeRateRatioThreshold || f.CumulativeRateRatioThreshold != 0 {
+		ies = append(ies, NewCumulativeRateRatioThreshold(f.CumulativeRateRatioThreshold))
+	}
+
+	return NewClockDriftControlInformation(ies...)
+}
+
 // ClockDriftControlInformation returns the IEs above ClockDriftControlInformation if the type of IE matches.
 func (i *IE) ClockDriftControlInformation() (*ClockDriftControlInformationFields, error) {
 	if i.Type != ClockDriftControlInformation {
@@ -27,11 +51,15 @@ func (i *IE) ClockDriftControlInformation() (*ClockDriftControlInformationFields
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type ClockDriftControlInformationFields struct {
-	RequestedClockDriftInformation uint8
-	TimeDomainNumber               uint8
-	ConfiguredTimeDomain           uint8
-	TimeOffsetThreshold            time.Duration
-	CumulativeRateRatioThreshold   uint32
+	RequestedClockDriftInformation  uint8
+	TimeDomainNumber                uint8
+	HasTimeDomainNumber             bool
+	ConfiguredTimeDomain            uint8
+	HasConfiguredTimeDomain         bool
+	TimeOffsetThreshold             time.Duration
+	HasTimeOffsetThreshold          bool
+	CumulativeRateRatioThreshold    uint32
+	HasCumulativeRateRatioThreshold bool
 }
 
 // ParseClockDriftControlInformationFields returns the IEs above ClockDriftControlInformation
@@ -67,24 +95,28 @@ func (far *ClockDriftControlInformationFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			far.TimeDomainNumber = v
+			far.HasTimeDomainNumber = true
 		case ConfiguredTimeDomain:
 			v, err := ie.ConfiguredTimeDomain()
 			if err != nil {
 				return err
 			}
 			far.ConfiguredTimeDomain = v
+			far.HasConfiguredTimeDomain = true
 		case TimeOffsetThreshold:
 			v, err := ie.TimeOffsetThreshold()
 			if err != nil {
 				return err
 			}
 			far.TimeOffsetThreshold = v
+			far.HasTimeOffsetThreshold = true
 		case CumulativeRateRatioThreshold:
 			v, err := ie.CumulativeRateRatioThreshold()
 			if err != nil {
 				return err
 			}
 			far.CumulativeRateRatioThreshold = v
+			far.HasCumulativeRateRatioThreshold = true
 		}
 	}
 	return nil
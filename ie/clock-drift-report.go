@@ -11,6 +11,39 @@ func NewClockDriftReport(ies ...*IE) *IE {
 	return newGroupedIE(ClockDriftReport, 0, ies...)
 }
 
+// NewClockDriftReportFromFields creates a new ClockDriftReport IE from the given
+// fields.
+func NewClockDriftReportFromFields(f *ClockDriftReportFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewTSNTimeDomainNumber(f.TSNTimeDomainNumber)}
+	if f.HasTimeOffsetMeasurement || f.TimeOffsetMeasurement != 0 {
+		ies = append(ies, NewTimeOffsetMeasurement(f.TimeOffsetMeasurement))
+	}
+	if f.HasTimeOffsetThreshold || f.TimeOffsetThreshold != 0 {
+		ies = append(ies, NewTimeOffsetThreshold(f.TimeOffsetThreshold))
+	}
+	if f.HasCumulativeRateRatioThreshold || f.CumulativeRateRatioThreshold != 0 {
+		ies = append(ies, NewCumulativeRateRatioThreshold(f.CumulativeRateRatioThreshold))
+	}
+	if !f.TimeStamp.IsZero() {
+		ies = append(ies, NewEventTimeStamp(f.TimeStamp))
+	}
+	if f.NetworkInstance != "" {
+		ies = append(ies, NewNetworkInstance(f.NetworkInstance))
+	}
+	if f.APNDNN != "" {
+		ies = append(ies, NewAPNDNN(f.APNDNN))
+	}
+	if len(f.SNSSAI) > 0 {
+		ies = append(ies, New(SNSSAI, f.SNSSAI))
+	}
+
+	return NewClockDriftReport(ies...)
+}
+
 // ClockDriftReport returns the IEs above ClockDriftReport if the type of IE matches.
 func (i *IE) ClockDriftReport() (*ClockDriftReportFields, error) {
 	if i.Type != ClockDriftReport {
@@ -25,13 +58,17 @@ func (i *IE) ClockDriftReport() (*ClockDriftReportFields, error) {
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type ClockDriftReportFields struct {
-	TSNTimeDomainNumber          uint8
-	TimeOffsetMeasurement        time.Duration
-	CumulativeRateRatioThreshold uint32
-	TimeStamp                    time.Time
-	NetworkInstance              string
-	APNDNN                       string
-	SNSSAI                       []byte
+	TSNTimeDomainNumber             uint8
+	TimeOffsetMeasurement           time.Duration
+	HasTimeOffsetMeasurement        bool
+	TimeOffsetThreshold             time.Duration
+	HasTimeOffsetThreshold          bool
+	CumulativeRateRatioThreshold    uint32
+	HasCumulativeRateRatioThreshold bool
+	TimeStamp                       time.Time
+	NetworkInstance                 string
+	APNDNN                          string
+	SNSSAI                          []byte
 }
 
 // ParseClockDriftReportFields returns the IEs above ClockDriftReport
@@ -67,12 +104,21 @@ func (far *ClockDriftReportFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			far.TimeOffsetMeasurement = v
+			far.HasTimeOffsetMeasurement = true
+		case TimeOffsetThreshold:
+			v, err := ie.TimeOffsetThreshold()
+			if err != nil {
+				return err
+			}
+			far.TimeOffsetThreshold = v
+			far.HasTimeOffsetThreshold = true
 		case CumulativeRateRatioThreshold:
 			v, err := ie.CumulativeRateRatioThreshold()
 			if err != nil {
 				return err
 			}
 			far.CumulativeRateRatioThreshold = v
+			far.HasCumulativeRateRatioThreshold = true
 		case EventTimeStamp:
 			v, err := ie.EventTimeStamp()
 			if err != nil {
@@ -11,6 +11,26 @@ func NewCreateBAR(ies ...*IE) *IE {
 	return newGroupedIE(CreateBAR, 0, ies...)
 }
 
+// NewCreateBARFromFields creates a new CreateBAR IE from the given fields.
+func NewCreateBARFromFields(f *CreateBARFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewBARID(f.BarID)}
+	if f.HasDownlinkDataNotificationDelay || f.DownlinkDataNotificationDelay != 0 {
+		ies = append(ies, NewDownlinkDataNotificationDelay(f.DownlinkDataNotificationDelay))
+	}
+	if f.HasSuggestedBufferingPacketsCount || f.SuggestedBufferingPacketsCount != 0 {
+		ies = append(ies, NewSuggestedBufferingPacketsCount(f.SuggestedBufferingPacketsCount))
+	}
+	if f.HasMTEDTControlInformation || f.MTEDTControlInformation != 0 {
+		ies = append(ies, NewMTEDTControlInformation(f.MTEDTControlInformation))
+	}
+
+	return NewCreateBAR(ies...)
+}
+
 // CreateBAR returns the IEs above CreateBAR if the type of IE matches.
 func (i *IE) CreateBAR() (*CreateBARFields, error) {
 	switch i.Type {
@@ -26,10 +46,13 @@ func (i *IE) CreateBAR() (*CreateBARFields, error) {
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type CreateBARFields struct {
-	BarID                          uint8
-	DownlinkDataNotificationDelay  time.Duration
-	SuggestedBufferingPacketsCount uint8
-	MTEDTControlInformation        uint8
+	BarID                             uint8
+	DownlinkDataNotificationDelay     time.Duration
+	HasDownlinkDataNotificationDelay  bool
+	SuggestedBufferingPacketsCount    uint8
+	HasSuggestedBufferingPacketsCount bool
+	MTEDTControlInformation           uint8
+	HasMTEDTControlInformation        bool
 }
 
 // ParseCreateBAR returns the IEs above CreateSRR
@@ -59,18 +82,21 @@ func ParseCreateBAR(b []byte) (*CreateBARFields, error) {
 				return bar, err
 			}
 			bar.DownlinkDataNotificationDelay = v
+			bar.HasDownlinkDataNotificationDelay = true
 		case SuggestedBufferingPacketsCount:
 			v, err := ie.SuggestedBufferingPacketsCount()
 			if err != nil {
 				return bar, err
 			}
 			bar.SuggestedBufferingPacketsCount = v
+			bar.HasSuggestedBufferingPacketsCount = true
 		case MTEDTControlInformation:
 			v, err := ie.MTEDTControlInformation()
 			if err != nil {
 				return bar, err
 			}
 			bar.MTEDTControlInformation = v
+			bar.HasMTEDTControlInformation = true
 		}
 	}
 
@@ -9,6 +9,35 @@ func NewCreateFAR(ies ...*IE) *IE {
 	return newGroupedIE(CreateFAR, 0, ies...)
 }
 
+// NewCreateFARFromFields creates a new CreateFAR IE from the given fields.
+func NewCreateFARFromFields(f *CreateFARFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewFARID(f.FARID),
+		newFieldsIE(ApplyAction, f.ApplyAction),
+		NewForwardingParametersFromFields(f.ForwardingParameters),
+	}
+	for _, v := range f.DuplicatingParameters {
+		ies = append(ies, NewDuplicatingParametersFromFields(v))
+	}
+	if f.HasBARID || f.BARID != 0 {
+		ies = append(ies, NewBARID(f.BARID))
+	}
+	ies = append(ies,
+		NewRedundantTransmissionParametersFromFields(f.RedundantTransmissionParameters),
+		NewRedundantTransmissionForwardingParametersFromFields(f.RedundantTransmissionForwardingParameters),
+		NewMBSMulticastParametersFromFields(f.MBSMulticastParameters),
+	)
+	for _, v := range f.AddMBSUnicastParameters {
+		ies = append(ies, NewAddMBSUnicastParametersFromFields(v))
+	}
+
+	return NewCreateFAR(ies...)
+}
+
 // CreateFAR returns the IEs above CreateFAR if the type of IE matches.
 func (i *IE) CreateFAR() (*CreateFARFields, error) {
 	if i.Type != CreateFAR {
@@ -36,6 +65,7 @@ type CreateFARFields struct {
 	ForwardingParameters                      *ForwardingParametersFields
 	DuplicatingParameters                     []*DuplicatingParametersFields
 	BARID                                     uint8
+	HasBARID                                  bool
 	RedundantTransmissionParameters           *RedundantTransmissionParametersField
 	RedundantTransmissionForwardingParameters *RedundantTransmissionForwardingParametersField
 	MBSMulticastParameters                    *MBSMulticastParametersFields
@@ -92,6 +122,7 @@ func (far *CreateFARFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			far.BARID = barID
+			far.HasBARID = true
 		case RedundantTransmissionParameters:
 			forward, err := ie.RedundantTransmissionParameters()
 			if err != nil {
@@ -9,6 +9,23 @@ func NewCreateMAR(ies ...*IE) *IE {
 	return newGroupedIE(CreateMAR, 0, ies...)
 }
 
+// NewCreateMARFromFields creates a new CreateMAR IE from the given fields.
+func NewCreateMARFromFields(f *CreateMARFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewCreateMAR(
+		NewMARID(f.ID),
+		NewSteeringFunctionality(f.SteeringFunctionality),
+		NewSteeringMode(f.SteeringMode),
+		NewTGPPAccessForwardingActionInformationFromFields(f.TGPPAccessForwardingActionInformation),
+		NewNonTGPPAccessForwardingActionInformationFromFields(f.NonTGPPAccessForwardingActionInformation),
+		newFieldsIE(Thresholds, f.Thresholds),
+		newFieldsIE(SteeringModeIndicator, f.SteeringModeIndicator),
+	)
+}
+
 // CreateMAR returns the IEs above CreateMAR if the type of IE matches.
 func (i *IE) CreateMAR() (*CreateMARFields, error) {
 	if i.Type != CreateMAR {
@@ -13,6 +13,59 @@ func NewCreatePDR(ies ...*IE) *IE {
 	return newGroupedIE(CreatePDR, 0, ies...)
 }
 
+// NewCreatePDRFromFields creates a new CreatePDR IE from the given fields.
+func NewCreatePDRFromFields(f *CreatePDRFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewPDRID(f.ID),
+		NewPrecedence(f.Precedence),
+		NewPDIFromFields(f.PDI),
+	}
+	if len(f.OuterHeaderRemoval) > 0 {
+		ies = append(ies, New(OuterHeaderRemoval, f.OuterHeaderRemoval))
+	}
+	if f.HasFARID || f.FARID != 0 {
+		ies = append(ies, NewFARID(f.FARID))
+	}
+	for _, v := range f.URRID {
+		ies = append(ies, NewURRID(v))
+	}
+	for _, v := range f.QERID {
+		ies = append(ies, NewQERID(v))
+	}
+	if f.ActivatePredefinedRules != "" {
+		ies = append(ies, NewActivatePredefinedRules(f.ActivatePredefinedRules))
+	}
+	if !f.ActivationTime.IsZero() {
+		ies = append(ies, NewActivationTime(f.ActivationTime))
+	}
+	if !f.DeactivationTime.IsZero() {
+		ies = append(ies, NewDeactivationTime(f.DeactivationTime))
+	}
+	if f.HasMarID || f.MarID != 0 {
+		ies = append(ies, NewMARID(f.MarID))
+	}
+	if f.HasPacketReplicationAndDetectionCarryOnInformation || f.PacketReplicationAndDetectionCarryOnInformation != 0 {
+		ies = append(ies, NewPacketReplicationAndDetectionCarryOnInformation(f.PacketReplicationAndDetectionCarryOnInformation))
+	}
+	for _, v := range f.IPMulticastAddressingInfo {
+		ies = append(ies, NewIPMulticastAddressingInfoFromFields(v))
+	}
+	ies = append(ies, f.UEIPAddressPoolIdentity...)
+	if f.HasMPTCPApplicationIndiciation || f.MPTCPApplicationIndiciation != 0 {
+		ies = append(ies, NewMPTCPApplicableIndication(f.MPTCPApplicationIndiciation))
+	}
+	ies = append(ies, f.TransportDelayReporting)
+	if f.HasRatType || f.RatType != 0 {
+		ies = append(ies, NewRATType(f.RatType))
+	}
+
+	return NewCreatePDR(ies...)
+}
+
 // CreatePDR returns the IEs above CreatePDR if the type of IE matches.
 func (i *IE) CreatePDR() (*CreatePDRFields, error) {
 	if i.Type != CreatePDR {
@@ -41,22 +94,27 @@ type CreatePDRFields struct {
 	// Precedence
 	Precedence uint32
 	// PDI
-	PDI                                             *PDIFields
-	OuterHeaderRemoval                              []byte
-	FARID                                           uint32
-	URRID                                           []uint32
-	QERID                                           []uint32
-	ActivatePredefinedRules                         string
-	ActivationTime                                  time.Time
-	DeactivationTime                                time.Time
-	MarID                                           uint16
-	PacketReplicationAndDetectionCarryOnInformation uint8
-	IPMulticastAddressingInfo                       []*IPMulticastAddressingInfoField
+	PDI                                                *PDIFields
+	OuterHeaderRemoval                                 []byte
+	FARID                                              uint32
+	HasFARID                                           bool
+	URRID                                              []uint32
+	QERID                                              []uint32
+	ActivatePredefinedRules                            string
+	ActivationTime                                     time.Time
+	DeactivationTime                                   time.Time
+	MarID                                              uint16
+	HasMarID                                           bool
+	PacketReplicationAndDetectionCarryOnInformation    uint8
+	HasPacketReplicationAndDetectionCarryOnInformation bool
+	IPMulticastAddressingInfo                          []*IPMulticastAddressingInfoField
 	// Max 2 times for IPv4 and IPv6
-	UEIPAddressPoolIdentity     []*IE
-	MPTCPApplicationIndiciation uint8
-	TransportDelayReporting     *IE
-	RatType                     uint8
+	UEIPAddressPoolIdentity        []*IE
+	MPTCPApplicationIndiciation    uint8
+	HasMPTCPApplicationIndiciation bool
+	TransportDelayReporting        *IE
+	RatType                        uint8
+	HasRatType                     bool
 }
 
 // ParseCreatePDRFields returns the IEs above CreatePDR
@@ -109,6 +167,7 @@ func (c *CreatePDRFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			c.FARID = a
+			c.HasFARID = true
 		case URRID:
 			a, err := ie.URRID()
 			if err != nil {
@@ -145,12 +204,14 @@ func (c *CreatePDRFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			c.MarID = a
+			c.HasMarID = true
 		case PacketReplicationAndDetectionCarryOnInformation:
 			a, err := ie.PacketReplicationAndDetectionCarryOnInformation()
 			if err != nil {
 				return err
 			}
 			c.PacketReplicationAndDetectionCarryOnInformation = a
+			c.HasPacketReplicationAndDetectionCarryOnInformation = true
 		case IPMulticastAddressingInfo:
 			v, err := ie.IPMulticastAddressingInfo()
 			if err != nil {
@@ -165,6 +226,7 @@ func (c *CreatePDRFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			c.MPTCPApplicationIndiciation = a
+			c.HasMPTCPApplicationIndiciation = true
 		case TransportDelayReporting:
 			c.TransportDelayReporting = ie
 		case RATType:
@@ -173,6 +235,7 @@ func (c *CreatePDRFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			c.RatType = a
+			c.HasRatType = true
 		}
 	}
 	return nil
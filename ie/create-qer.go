@@ -9,6 +9,43 @@ func NewCreateQER(ies ...*IE) *IE {
 	return newGroupedIE(CreateQER, 0, ies...)
 }
 
+// NewCreateQERFromFields creates a new CreateQER IE from the given fields.
+func NewCreateQERFromFields(f *CreateQERFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewQERID(f.QERID)}
+	if f.HasQERCorrelationID || f.QERCorrelationID != 0 {
+		ies = append(ies, NewQERCorrelationID(f.QERCorrelationID))
+	}
+	ies = append(ies, newUint8ValIE(GateStatus, uint8(f.GateStatus)))
+	ies = append(ies,
+		newFieldsIE(MBR, f.MBR),
+		newFieldsIE(GBR, f.GBR),
+		newFieldsIE(PacketRate, f.PacketRate),
+		newFieldsIE(PacketRateStatus, f.PacketRateStatus),
+		newFieldsIE(DLFlowLevelMarking, f.DLFlowLevelMarking),
+	)
+	if f.HasQFI || f.QFI != 0 {
+		ies = append(ies, NewQFI(f.QFI))
+	}
+	if f.HasReflectiveQoS || f.ReflectiveQoS != 0 {
+		ies = append(ies, NewRQI(f.ReflectiveQoS))
+	}
+	if f.HasPagingPolicyIndicator || f.PagingPolicyIndicator != 0 {
+		ies = append(ies, NewPagingPolicyIndicator(f.PagingPolicyIndicator))
+	}
+	if f.HasAveragingWindows || f.AveragingWindows != 0 {
+		ies = append(ies, NewAveragingWindow(f.AveragingWindows))
+	}
+	if f.HasQERControlIndications || f.QERControlIndications != 0 {
+		ies = append(ies, newUint8ValIE(QERControlIndications, f.QERControlIndications))
+	}
+
+	return NewCreateQER(ies...)
+}
+
 // CreateQER returns the IEs above CreateQER if the type of IE matches.
 func (i *IE) CreateQER() (*CreateQERFields, error) {
 	if i.Type != CreateQER {
@@ -32,19 +69,25 @@ func (i *IE) CreateQER() (*CreateQERFields, error) {
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type CreateQERFields struct {
-	QERID                 uint32
-	QERCorrelationID      uint32
-	GateStatus            Gate
-	MBR                   *MBRFields
-	GBR                   *GBRFields
-	PacketRate            *PacketRateFields
-	PacketRateStatus      *PacketRateStatusFields
-	DLFlowLevelMarking    *DLFlowLevelMarkingFields
-	QFI                   uint8
-	ReflectiveQoS         uint8
-	PagingPolicyIndicator uint8
-	AveragingWindows      uint32
-	QERControlIndications uint8
+	QERID                    uint32
+	QERCorrelationID         uint32
+	HasQERCorrelationID      bool
+	GateStatus               Gate
+	MBR                      *MBRFields
+	GBR                      *GBRFields
+	PacketRate               *PacketRateFields
+	PacketRateStatus         *PacketRateStatusFields
+	DLFlowLevelMarking       *DLFlowLevelMarkingFields
+	QFI                      uint8
+	HasQFI                   bool
+	ReflectiveQoS            uint8
+	HasReflectiveQoS         bool
+	PagingPolicyIndicator    uint8
+	HasPagingPolicyIndicator bool
+	AveragingWindows         uint32
+	HasAveragingWindows      bool
+	QERControlIndications    uint8
+	HasQERControlIndications bool
 }
 
 // ParseCreateQERFields returns the IEs above UpdateURR if the type of IE matches.
@@ -79,6 +122,7 @@ func (q *CreateQERFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			q.QERCorrelationID = a
+			q.HasQERCorrelationID = true
 		case GateStatus:
 			a, err := ie.GateStatus()
 			if err != nil {
@@ -121,30 +165,35 @@ func (q *CreateQERFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			q.QFI = m
+			q.HasQFI = true
 		case RQI:
 			m, err := ie.RQI()
 			if err != nil {
 				return err
 			}
 			q.ReflectiveQoS = m
+			q.HasReflectiveQoS = true
 		case PagingPolicyIndicator:
 			m, err := ie.PagingPolicyIndicator()
 			if err != nil {
 				return err
 			}
 			q.PagingPolicyIndicator = m
+			q.HasPagingPolicyIndicator = true
 		case AveragingWindow:
 			m, err := ie.AveragingWindow()
 			if err != nil {
 				return err
 			}
 			q.AveragingWindows = m
+			q.HasAveragingWindows = true
 		case QERControlIndications:
 			m, err := ie.QERControlIndications()
 			if err != nil {
 				return err
 			}
 			q.QERControlIndications = m
+			q.HasQERControlIndications = true
 		}
 	}
 	return nil
@@ -9,6 +9,20 @@ func NewCreateSRR(ies ...*IE) *IE {
 	return newGroupedIE(CreateSRR, 0, ies...)
 }
 
+// NewCreateSRRFromFields creates a new CreateSRR IE from the given fields.
+func NewCreateSRRFromFields(f *CreateSRRFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewCreateSRR(
+		NewSRRID(f.SSRID),
+		NewAccessAvailabilityControlInformationFromFields(f.AccessAvailabilityControlInformation),
+		NewQoSMonitoringPerQoSFlowControlInformationFromFields(f.QoSMonitoringPerQoSFlowControlInformation),
+		NewDirectReportingInformationFromFields(f.DirectReportingInformation),
+	)
+}
+
 // CreateSRR returns the IEs above CreateSRR if the type of IE matches.
 func (i *IE) CreateSRR() (*CreateSRRFields, error) {
 	switch i.Type {
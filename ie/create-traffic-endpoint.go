@@ -9,6 +9,51 @@ func NewCreateTrafficEndpoint(ies ...*IE) *IE {
 	return newGroupedIE(CreateTrafficEndpoint, 0, ies...)
 }
 
+// NewCreateTrafficEndpointFromFields creates a new CreateTrafficEndpoint IE from the given fields.
+func NewCreateTrafficEndpointFromFields(f *CreateTrafficEndpointFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewTrafficEndpointID(f.TrafficEndpointID),
+		newFieldsIE(FTEID, f.LocalFTEID),
+	}
+	if f.NetworkInstance != "" {
+		ies = append(ies, NewNetworkInstance(f.NetworkInstance))
+	}
+	ies = append(ies, NewRedundantTransmissionParametersFromFields(f.RedundantTransmissionDetectionParameters))
+	for _, v := range f.UEIPAddress {
+		ies = append(ies, newFieldsIE(UEIPAddress, v))
+	}
+	if f.HasEthernetPDUSessionInformation || f.EthernetPDUSessionInformation != 0 {
+		ies = append(ies, NewEthernetPDUSessionInformation(f.EthernetPDUSessionInformation))
+	}
+	for _, v := range f.FramedRoute {
+		ies = append(ies, NewFramedRoute(v))
+	}
+	if f.HasFramedRouting || f.FramedRouting != 0 {
+		ies = append(ies, NewFramedRouting(f.FramedRouting))
+	}
+	if f.FramedIPv6Route != "" {
+		ies = append(ies, NewFramedIPv6Route(f.FramedIPv6Route))
+	}
+	for _, v := range f.QFI {
+		ies = append(ies, NewQFI(v))
+	}
+	if f.HasSourceInterfaceType || f.SourceInterfaceType != 0 {
+		ies = append(ies, NewTGPPInterfaceType(f.SourceInterfaceType))
+	}
+	ies = append(ies, f.LocalIngressTunnel)
+	ies = append(ies, f.IpMulticastAddressingInfo...)
+	ies = append(ies, f.MBSSession, f.AreaSessionID)
+	if f.HasRatType || f.RatType != 0 {
+		ies = append(ies, NewRATType(f.RatType))
+	}
+
+	return NewCreateTrafficEndpoint(ies...)
+}
+
 // CreateTrafficEndpoint returns the IEs above CreateTrafficEndpoint if the type of IE matches.
 func (i *IE) CreateTrafficEndpoint() ([]*IE, error) {
 	if i.Type != CreateTrafficEndpoint {
@@ -29,16 +74,20 @@ type CreateTrafficEndpointFields struct {
 	RedundantTransmissionDetectionParameters *RedundantTransmissionParametersField
 	UEIPAddress                              []*UEIPAddressFields
 	EthernetPDUSessionInformation            uint8
+	HasEthernetPDUSessionInformation         bool
 	FramedRoute                              []string
 	FramedRouting                            uint32
+	HasFramedRouting                         bool
 	FramedIPv6Route                          string
 	QFI                                      []uint8
 	SourceInterfaceType                      uint8
+	HasSourceInterfaceType                   bool
 	LocalIngressTunnel                       *IE
 	IpMulticastAddressingInfo                []*IE
 	MBSSession                               *IE
 	AreaSessionID                            *IE
 	RatType                                  uint8
+	HasRatType                               bool
 }
 
 // ParseTrafficEndpointFields returns the IEs above CreateTrafficEndpoint
@@ -97,6 +146,7 @@ func (c *CreateTrafficEndpointFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			c.EthernetPDUSessionInformation = v
+			c.HasEthernetPDUSessionInformation = true
 		case FramedRoute:
 			v, err := ie.FramedRoute()
 			if err != nil {
@@ -109,6 +159,7 @@ func (c *CreateTrafficEndpointFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			c.FramedRouting = v
+			c.HasFramedRouting = true
 		case FramedIPv6Route:
 			v, err := ie.FramedIPv6Route()
 			if err != nil {
@@ -127,6 +178,7 @@ func (c *CreateTrafficEndpointFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			c.SourceInterfaceType = v
+			c.HasSourceInterfaceType = true
 		case LocalIngressTunnel:
 			c.LocalIngressTunnel = ie
 		case IPMulticastAddress:
@@ -141,6 +193,7 @@ func (c *CreateTrafficEndpointFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			c.RatType = v
+			c.HasRatType = true
 		}
 	}
 	return nil
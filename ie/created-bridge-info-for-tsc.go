@@ -11,6 +11,27 @@ func NewCreatedBridgeInfoForTSC(ies ...*IE) *IE {
 	return newGroupedIE(CreatedBridgeInfoForTSC, 0, ies...)
 }
 
+// NewCreatedBridgeInfoForTSCFromFields creates a new CreatedBridgeInfoForTSC IE
+// from the given fields.
+func NewCreatedBridgeInfoForTSCFromFields(f *CreatedBridgeInfoForTSCFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	var ies []*IE
+	if f.HasDSTTPortNumber || f.DSTTPortNumber != 0 {
+		ies = append(ies, NewDSTTPortNumber(f.DSTTPortNumber))
+	}
+	if f.HasNWTTPortNumber || f.NWTTPortNumber != 0 {
+		ies = append(ies, NewNWTTPortNumber(f.NWTTPortNumber))
+	}
+	if len(f.TSNBridgeID) > 0 {
+		ies = append(ies, NewTSNBridgeID(f.TSNBridgeID))
+	}
+
+	return NewCreatedBridgeInfoForTSC(ies...)
+}
+
 // CreatedBridgeInfoForTSC returns the IEs above CreatedBridgeInfoForTSC if the type of IE matches.
 func (i *IE) CreatedBridgeInfoForTSC() (*CreatedBridgeInfoForTSCFields, error) {
 	if i.Type != CreatedBridgeInfoForTSC {
@@ -25,8 +46,11 @@ func (i *IE) CreatedBridgeInfoForTSC() (*CreatedBridgeInfoForTSCFields, error) {
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type CreatedBridgeInfoForTSCFields struct {
-	DSTTPortNumber uint32
-	TSNBridgeID    net.HardwareAddr
+	DSTTPortNumber    uint32
+	HasDSTTPortNumber bool
+	NWTTPortNumber    uint32
+	HasNWTTPortNumber bool
+	TSNBridgeID       net.HardwareAddr
 }
 
 // ParseCreatedBridgeInfoForTSCFields returns the IEs above CreatedBridgeInfoForTSC
@@ -56,6 +80,15 @@ func (far *CreatedBridgeInfoForTSCFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			far.DSTTPortNumber = v
+			far.HasDSTTPortNumber = true
+
+		case NWTTPortNumber:
+			v, err := ie.NWTTPortNumber()
+			if err != nil {
+				return err
+			}
+			far.NWTTPortNumber = v
+			far.HasNWTTPortNumber = true
 
 		case TSNBridgeID:
 			v, err := ie.TSNBridgeID()
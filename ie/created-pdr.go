@@ -9,6 +9,24 @@ func NewCreatedPDR(ies ...*IE) *IE {
 	return newGroupedIE(CreatedPDR, 0, ies...)
 }
 
+// NewCreatedPDRFromFields creates a new CreatedPDR IE from the given fields.
+func NewCreatedPDRFromFields(f *CreatedPDRFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewPDRID(f.ID)}
+	for _, v := range f.LocalTeid {
+		ies = append(ies, newFieldsIE(FTEID, v))
+	}
+	for _, v := range f.UEIPAddress {
+		ies = append(ies, newFieldsIE(UEIPAddress, v))
+	}
+	ies = append(ies, newFieldsIE(LocalIngressTunnel, f.LocalIngressTunnel))
+
+	return NewCreatedPDR(ies...)
+}
+
 // CreatedPDR returns the IEs above CreatedPDR if the type of IE matches.
 func (i *IE) CreatedPDR() (*CreatedPDRFields, error) {
 	if i.Type != CreatedPDR {
@@ -9,6 +9,25 @@ func NewCreatedTrafficEndpoint(ies ...*IE) *IE {
 	return newGroupedIE(CreatedTrafficEndpoint, 0, ies...)
 }
 
+// NewCreatedTrafficEndpointFromFields creates a new CreatedTrafficEndpoint IE
+// from the given fields.
+func NewCreatedTrafficEndpointFromFields(f *CreatedTrafficEndpointFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewTrafficEndpointID(f.TrafficEndpointID)}
+	for _, v := range f.LocalFTEID {
+		ies = append(ies, newFieldsIE(FTEID, v))
+	}
+	for _, v := range f.UEIPAddress {
+		ies = append(ies, newFieldsIE(UEIPAddress, v))
+	}
+	ies = append(ies, f.LocalIngressTunnel)
+
+	return NewCreatedTrafficEndpoint(ies...)
+}
+
 // CreatedTrafficEndpoint returns the IEs above CreatedTrafficEndpoint if the type of IE matches.
 func (i *IE) CreatedTrafficEndpoint() (*CreatedTrafficEndpointFields, error) {
 	if i.Type != CreatedTrafficEndpoint {
@@ -5,6 +5,24 @@ func NewDirectReportingInformation(ies ...*IE) *IE {
 	return newGroupedIE(DirectReportingInformation, 0, ies...)
 }
 
+// NewDirectReportingInformationFromFields creates a new DirectReportingInformation
+// IE from the given fields.
+func NewDirectReportingInformationFromFields(f *DirectReportingInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewEventNotificationURI(f.EventNotificationURI)}
+	if f.NotificationCorrelationID != "" {
+		ies = append(ies, NewNotificationCorrelationID(f.NotificationCorrelationID))
+	}
+	if f.HasReportingFlags || f.ReportingFlags != 0 {
+		ies = append(ies, newUint8ValIE(ReportingFlags, f.ReportingFlags))
+	}
+
+	return NewDirectReportingInformation(ies...)
+}
+
 // DirectReportingInformation returns the IEs above DirectReportingInformation if the type of IE matches.
 func (i *IE) DirectReportingInformation() (*DirectReportingInformationFields, error) {
 	switch i.Type {
@@ -41,6 +59,7 @@ type DirectReportingInformationFields struct {
 	EventNotificationURI      string
 	NotificationCorrelationID string
 	ReportingFlags            uint8
+	HasReportingFlags         bool
 }
 
 // ParseDirectReportingInformationFields returns the IEs above DirectReportingInformation
@@ -76,6 +95,7 @@ func ParseDirectReportingInformationFields(b []byte) (*DirectReportingInformatio
 				return bar, err
 			}
 			bar.ReportingFlags = v
+			bar.HasReportingFlags = true
 		}
 	}
 
@@ -0,0 +1,26 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewDLPeriodicity creates a new DLPeriodicity IE.
+func NewDLPeriodicity(period uint32) *IE {
+	return newUint32ValIE(DLPeriodicity, period)
+}
+
+// DLPeriodicity returns DLPeriodicity in uint32 if the type of IE matches.
+func (i *IE) DLPeriodicity() (uint32, error) {
+	switch i.Type {
+	case DLPeriodicity:
+		return i.ValueAsUint32()
+	case TrafficParameterMeasurementReport:
+		ies, err := i.TrafficParameterMeasurementReport()
+		if err != nil {
+			return 0, err
+		}
+		return ies.DLPeriodicity, nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewDNSQueryFilter creates a new DNSQueryFilter IE.
+func NewDNSQueryFilter(pattern string) *IE {
+	fields := NewDNSQueryFilterFields(pattern)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(DNSQueryFilter, b)
+}
+
+// DNSQueryFilter returns DNSQueryFilter in structured format if the type of IE matches.
+func (i *IE) DNSQueryFilter() (*DNSQueryFilterFields, error) {
+	switch i.Type {
+	case DNSQueryFilter:
+		return ParseDNSQueryFilterFields(i.Payload)
+	case PDI:
+		ies, err := i.PDI()
+		if err != nil {
+			return nil, err
+		}
+		if ies.DNSQueryFilter != nil {
+			return ies.DNSQueryFilter.DNSQueryFilter()
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// DNSQueryFilterFields represents a fields contained in DNSQueryFilter IE.
+type DNSQueryFilterFields struct {
+	PatternLength uint16
+	Pattern       string
+}
+
+// NewDNSQueryFilterFields creates a new DNSQueryFilterFields.
+func NewDNSQueryFilterFields(pattern string) *DNSQueryFilterFields {
+	return &DNSQueryFilterFields{
+		PatternLength: uint16(len(pattern)),
+		Pattern:       pattern,
+	}
+}
+
+// ParseDNSQueryFilterFields parses b into DNSQueryFilterFields.
+func ParseDNSQueryFilterFields(b []byte) (*DNSQueryFilterFields, error) {
+	f := &DNSQueryFilterFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *DNSQueryFilterFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 2 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.PatternLength = binary.BigEndian.Uint16(b[0:2])
+	offset := 2
+
+	n := int(f.PatternLength)
+	if l < offset+n {
+		return io.ErrUnexpectedEOF
+	}
+	f.Pattern = string(b[offset : offset+n])
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of DNSQueryFilterFields.
+func (f *DNSQueryFilterFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *DNSQueryFilterFields) MarshalTo(b []byte) error {
+	l := len(b)
+	if l < 2 {
+		return io.ErrUnexpectedEOF
+	}
+
+	binary.BigEndian.PutUint16(b[0:2], uint16(len(f.Pattern)))
+	offset := 2
+
+	if l < offset+len(f.Pattern) {
+		return io.ErrUnexpectedEOF
+	}
+	copy(b[offset:], f.Pattern)
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *DNSQueryFilterFields) MarshalLen() int {
+	return 2 + len(f.Pattern)
+}
@@ -9,6 +9,27 @@ func NewDownlinkDataReport(ies ...*IE) *IE {
 	return newGroupedIE(DownlinkDataReport, 0, ies...)
 }
 
+// NewDownlinkDataReportFromFields creates a new DownlinkDataReport IE from the
+// given fields.
+func NewDownlinkDataReportFromFields(f *DownlinkDataReportFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewPDRID(f.PDRID)}
+	if len(f.DownlinkDataServiceInformation) > 0 {
+		ies = append(ies, New(DownlinkDataServiceInformation, f.DownlinkDataServiceInformation))
+	}
+	if f.HasDLDataPacketsSize || f.DLDataPacketsSize != 0 {
+		ies = append(ies, NewDLDataPacketsSize(f.DLDataPacketsSize))
+	}
+	if f.HasDLDataStatus || f.DLDataStatus != 0 {
+		ies = append(ies, NewDataStatus(f.DLDataStatus))
+	}
+
+	return NewDownlinkDataReport(ies...)
+}
+
 // DownlinkDataReport returns the IEs above DownlinkDataReport if the type of IE matches.
 func (i *IE) DownlinkDataReport() (*DownlinkDataReportFields, error) {
 	if i.Type != DownlinkDataReport {
@@ -26,7 +47,9 @@ type DownlinkDataReportFields struct {
 	PDRID                          uint16
 	DownlinkDataServiceInformation []byte
 	DLDataPacketsSize              uint16
+	HasDLDataPacketsSize           bool
 	DLDataStatus                   uint8
+	HasDLDataStatus                bool
 }
 
 func ParseDownlinkDataReport(b []byte) (*DownlinkDataReportFields, error) {
@@ -64,12 +87,14 @@ func (f *DownlinkDataReportFields) ParseIEs(ies []*IE) error {
 				return err
 			}
 			f.DLDataPacketsSize = v
+			f.HasDLDataPacketsSize = true
 		case DataStatus:
 			v, err := ie.DataStatus()
 			if err != nil {
 				return err
 			}
 			f.DLDataStatus = v
+			f.HasDLDataStatus = true
 		}
 	}
 	return nil
@@ -9,6 +9,25 @@ func NewDuplicatingParameters(ies ...*IE) *IE {
 	return newGroupedIE(DuplicatingParameters, 0, ies...)
 }
 
+// NewDuplicatingParametersFromFields creates a new DuplicatingParameters IE from
+// the given fields.
+func NewDuplicatingParametersFromFields(f *DuplicatingParametersFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewDestinationInterface(f.DestinationInterface),
+		newFieldsIE(OuterHeaderCreation, f.OuterHeaderCreation),
+	}
+	if f.HasTransportLevelMarking || f.TransportLevelMarking != 0 {
+		ies = append(ies, NewTransportLevelMarking(f.TransportLevelMarking))
+	}
+	ies = append(ies, newForwardingPolicyIE(f.ForwardingPolicy, f.ForwardingPolicyIdentifier))
+
+	return NewDuplicatingParameters(ies...)
+}
+
 // DuplicatingParameters returns the IEs above DuplicatingParameters if the type of IE matches.
 func (i *IE) DuplicatingParameters() (*DuplicatingParametersFields, error) {
 	switch i.Type {
@@ -39,6 +58,7 @@ type DuplicatingParametersFields struct {
 	DestinationInterface       uint8
 	OuterHeaderCreation        *OuterHeaderCreationFields
 	TransportLevelMarking      uint16
+	HasTransportLevelMarking   bool
 	ForwardingPolicy           []byte
 	ForwardingPolicyIdentifier string
 }
@@ -80,6 +100,7 @@ func (d *DuplicatingParametersFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			d.TransportLevelMarking = transport
+			d.HasTransportLevelMarking = true
 		case ForwardingPolicy:
 			policy, err := ie.ForwardingPolicy()
 			if err != nil {
@@ -9,6 +9,16 @@ func NewErrorIndicationReport(fteid *IE) *IE {
 	return newGroupedIE(ErrorIndicationReport, 0, fteid)
 }
 
+// NewErrorIndicationReportFromFields creates a new ErrorIndicationReport IE from
+// the given fields.
+func NewErrorIndicationReportFromFields(f *ErrorIndicationReportFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewErrorIndicationReport(newFieldsIE(FTEID, f.RemoteFTEID))
+}
+
 // ErrorIndicationReport returns the IEs above ErrorIndicationReport if the type of IE matches.
 func (i *IE) ErrorIndicationReport() (*ErrorIndicationReportFields, error) {
 	if i.Type != ErrorIndicationReport {
@@ -9,6 +9,16 @@ func NewEthernetContextInformation(mac *IE) *IE {
 	return newGroupedIE(EthernetContextInformation, 0, mac)
 }
 
+// NewEthernetContextInformationFromFields creates a new EthernetContextInformation
+// IE from the given fields.
+func NewEthernetContextInformationFromFields(f *EthernetContextInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewEthernetContextInformation(newFieldsIE(MACAddressesDetected, f.MACAddressesDetected))
+}
+
 // EthernetContextInformation returns the IEs above EthernetContextInformation if the type of IE matches.
 func (i *IE) EthernetContextInformation() (*EthernetContextInformationFields, error) {
 	if i.Type != EthernetContextInformation {
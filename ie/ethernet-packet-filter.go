@@ -9,6 +9,35 @@ func NewEthernetPacketFilter(ies ...*IE) *IE {
 	return newGroupedIE(EthernetPacketFilter, 0, ies...)
 }
 
+// NewEthernetPacketFilterFromFields creates a new EthernetPacketFilter IE from
+// the given fields.
+func NewEthernetPacketFilterFromFields(f *EthernetPacketFilterFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	var ies []*IE
+	if f.HasEthernetFilterID || f.EthernetFilterID != 0 {
+		ies = append(ies, NewEthernetFilterID(f.EthernetFilterID))
+	}
+	if f.HasEthernetFilterProperties || f.EthernetFilterProperties != 0 {
+		ies = append(ies, NewEthernetFilterProperties(f.EthernetFilterProperties))
+	}
+	ies = append(ies, newFieldsIE(MACAddress, f.MacAddress))
+	if f.HasEtherType || f.EtherType != 0 {
+		ies = append(ies, NewEthertype(f.EtherType))
+	}
+	ies = append(ies,
+		newFieldsIE(CTAG, f.CTag),
+		newFieldsIE(STAG, f.STAG),
+	)
+	for _, v := range f.SDFFilter {
+		ies = append(ies, newFieldsIE(SDFFilter, v))
+	}
+
+	return NewEthernetPacketFilter(ies...)
+}
+
 // EthernetPacketFilter returns the IEs above EthernetPacketFilter if the type of IE matches.
 func (i *IE) EthernetPacketFilter() (*EthernetPacketFilterFields, error) {
 	switch i.Type {
@@ -42,13 +71,16 @@ func (i *IE) EthernetPacketFilter() (*EthernetPacketFilterFields, error) {
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type EthernetPacketFilterFields struct {
-	EthernetFilterID         uint32
-	EthernetFilterProperties uint8
-	MacAddress               *MACAddressFields
-	EtherType                uint16
-	CTag                     *CTAGFields
-	STAG                     *STAGFields
-	SDFFilter                []*SDFFilterFields `tlv:"23"  json:"sdf_filter,omitempty"`
+	EthernetFilterID            uint32
+	HasEthernetFilterID         bool
+	EthernetFilterProperties    uint8
+	HasEthernetFilterProperties bool
+	MacAddress                  *MACAddressFields
+	EtherType                   uint16
+	HasEtherType                bool
+	CTag                        *CTAGFields
+	STAG                        *STAGFields
+	SDFFilter                   []*SDFFilterFields `tlv:"23"  json:"sdf_filter,omitempty"`
 }
 
 func ParseEthernetPacketFilter(b []byte) (*EthernetPacketFilterFields, error) {
@@ -74,12 +106,14 @@ func (f *EthernetPacketFilterFields) ParseIEs(ies []*IE) error {
 				return err
 			}
 			f.EthernetFilterID = v
+			f.HasEthernetFilterID = true
 		case EthernetFilterProperties:
 			v, err := ie.EthernetFilterProperties()
 			if err != nil {
 				return err
 			}
 			f.EthernetFilterProperties = v
+			f.HasEthernetFilterProperties = true
 		case MACAddress:
 			v, err := ie.MACAddress()
 			if err != nil {
@@ -92,6 +126,7 @@ func (f *EthernetPacketFilterFields) ParseIEs(ies []*IE) error {
 				return err
 			}
 			f.EtherType = v
+			f.HasEtherType = true
 		case CTAG:
 			v, err := ie.CTAG()
 			if err != nil {
@@ -9,6 +9,19 @@ func NewEthernetTrafficInformation(ies ...*IE) *IE {
 	return newGroupedIE(EthernetTrafficInformation, 0, ies...)
 }
 
+// NewEthernetTrafficInformationFromFields creates a new EthernetTrafficInformation
+// IE from the given fields.
+func NewEthernetTrafficInformationFromFields(f *EthernetTrafficInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewEthernetTrafficInformation(
+		newFieldsIE(MACAddressesDetected, f.MACAddressesDetected),
+		newFieldsIE(MACAddressesRemoved, f.MACAddressesRemoved),
+	)
+}
+
 // EthernetTrafficInformation returns the IEs above EthernetTrafficInformation if the type of IE matches.
 func (i *IE) EthernetTrafficInformation() (*EthernetTrafficInformationFields, error) {
 	switch i.Type {
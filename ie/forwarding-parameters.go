@@ -9,6 +9,45 @@ func NewForwardingParameters(ies ...*IE) *IE {
 	return newGroupedIE(ForwardingParameters, 0, ies...)
 }
 
+// NewForwardingParametersFromFields creates a new ForwardingParameters IE from
+// the given fields.
+func NewForwardingParametersFromFields(f *ForwardingParametersFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewDestinationInterface(f.DestinationInterface)}
+	if f.NetworkInstance != "" {
+		ies = append(ies, NewNetworkInstance(f.NetworkInstance))
+	}
+	ies = append(ies,
+		newFieldsIE(RedirectInformation, f.RedirectInformation),
+		newFieldsIE(OuterHeaderCreation, f.OuterHeaderCreation),
+	)
+	if f.HasTransportLevelMarking || f.TransportLevelMarking != 0 {
+		ies = append(ies, NewTransportLevelMarking(f.TransportLevelMarking))
+	}
+	ies = append(ies,
+		newForwardingPolicyIE(f.ForwardingPolicy, f.ForwardingPolicyIdentifier),
+		newFieldsIE(HeaderEnrichment, f.HeaderEnrichment),
+	)
+	if f.HasLinkedTrafficEndpointID || f.LinkedTrafficEndpointID != 0 {
+		ies = append(ies, NewTrafficEndpointID(f.LinkedTrafficEndpointID))
+	}
+	if f.HasProxying || f.Proxying != 0 {
+		ies = append(ies, newUint8ValIE(Proxying, f.Proxying))
+	}
+	if f.HasDestinationInterfaceType || f.DestinationInterfaceType != 0 {
+		ies = append(ies, NewTGPPInterfaceType(f.DestinationInterfaceType))
+	}
+	if f.DataNetworkAccessIdentifier != "" {
+		ies = append(ies, NewDataNetworkAccessIdentifier(f.DataNetworkAccessIdentifier))
+	}
+	ies = append(ies, newFieldsIE(IPAddressAndPortNumberReplacement, f.IPAddressAndPortNumberReplacement))
+
+	return NewForwardingParameters(ies...)
+}
+
 // ForwardingParameters returns the IEs above ForwardingParameters if the type of IE matches.
 func (i *IE) ForwardingParameters() (*ForwardingParametersFields, error) {
 	switch i.Type {
@@ -41,18 +80,23 @@ func (i *IE) ForwardingParameters() (*ForwardingParametersFields, error) {
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type ForwardingParametersFields struct {
-	DestinationInterface        uint8
-	NetworkInstance             string
-	RedirectInformation         *RedirectInformationFields
-	OuterHeaderCreation         *OuterHeaderCreationFields
-	TransportLevelMarking       uint16
-	ForwardingPolicy            []byte
-	ForwardingPolicyIdentifier  string
-	HeaderEnrichment            *HeaderEnrichmentFields
-	LinkedTrafficEndpointID     uint8
-	Proxying                    uint8
-	DestinationInterfaceType    uint8
-	DataNetworkAccessIdentifier string
+	DestinationInterface              uint8
+	NetworkInstance                   string
+	RedirectInformation               *RedirectInformationFields
+	OuterHeaderCreation               *OuterHeaderCreationFields
+	TransportLevelMarking             uint16
+	HasTransportLevelMarking          bool
+	ForwardingPolicy                  []byte
+	ForwardingPolicyIdentifier        string
+	HeaderEnrichment                  *HeaderEnrichmentFields
+	LinkedTrafficEndpointID           uint8
+	HasLinkedTrafficEndpointID        bool
+	Proxying                          uint8
+	HasProxying                       bool
+	DestinationInterfaceType          uint8
+	HasDestinationInterfaceType       bool
+	DataNetworkAccessIdentifier       string
+	IPAddressAndPortNumberReplacement *IPAddressAndPortNumberReplacementFields
 }
 
 // ParseForwardingParametersFields returns the IEs above ParseForwardingParameters.
@@ -106,6 +150,7 @@ func (f *ForwardingParametersFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			f.TransportLevelMarking = transport
+			f.HasTransportLevelMarking = true
 		case ForwardingPolicy:
 			policy, err := ie.ForwardingPolicy()
 			if err != nil {
@@ -129,24 +174,33 @@ func (f *ForwardingParametersFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			f.LinkedTrafficEndpointID = traficID
+			f.HasLinkedTrafficEndpointID = true
 		case Proxying:
 			proxying, err := ie.Proxying()
 			if err != nil {
 				return err
 			}
 			f.Proxying = proxying
+			f.HasProxying = true
 		case TGPPInterfaceType:
 			tgppinterface, err := ie.TGPPInterfaceType()
 			if err != nil {
 				return err
 			}
 			f.DestinationInterfaceType = tgppinterface
+			f.HasDestinationInterfaceType = true
 		case DataNetworkAccessIdentifier:
 			v, err := ie.DataNetworkAccessIdentifier()
 			if err != nil {
 				return err
 			}
 			f.DataNetworkAccessIdentifier = v
+		case IPAddressAndPortNumberReplacement:
+			v, err := ie.IPAddressAndPortNumberReplacement()
+			if err != nil {
+				return err
+			}
+			f.IPAddressAndPortNumberReplacement = v
 		}
 	}
 	return nil
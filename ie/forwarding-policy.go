@@ -17,6 +17,18 @@ func NewForwardingPolicy(id string) *IE {
 	return i
 }
 
+// newForwardingPolicyIE creates a ForwardingPolicy IE from the payload kept in
+// the fields of grouped IEs, or from the identifier if the payload is empty.
+func newForwardingPolicyIE(payload []byte, id string) *IE {
+	if len(payload) > 0 {
+		return New(ForwardingPolicy, payload)
+	}
+	if id != "" {
+		return NewForwardingPolicy(id)
+	}
+	return nil
+}
+
 // ForwardingPolicy returns ForwardingPolicy in []byte if the type of IE matches.
 func (i *IE) ForwardingPolicy() ([]byte, error) {
 	switch i.Type {
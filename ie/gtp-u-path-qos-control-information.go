@@ -11,6 +11,43 @@ func NewGTPUPathQoSControlInformation(ies ...*IE) *IE {
 	return newGroupedIE(GTPUPathQoSControlInformation, 0, ies...)
 }
 
+// NewGTPUPathQoSControlInformationFromFields creates a new
+// GTPUPathQoSControlInformation IE from the given fields.
+func NewGTPUPathQoSControlInformationFromFields(f *GTPUPathQoSControlInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{newFieldsIE(RemoteGTPUPeer, f.RemoteGTPUPeer)}
+	if f.HasGTPUPathInterfaceType || f.GTPUPathInterfaceType != 0 {
+		ies = append(ies, newUint8ValIE(GTPUPathInterfaceType, f.GTPUPathInterfaceType))
+	}
+	ies = append(ies, newUint8ValIE(QoSReportTrigger, f.QoSReportTrigger))
+	if f.HasDSCP || f.DSCP != 0 {
+		ies = append(ies, NewTransportLevelMarking(f.DSCP))
+	}
+	if f.HasMeasurementMethod || f.MeasurementMethod != 0 {
+		ies = append(ies, newUint8ValIE(MeasurementMethod, f.MeasurementMethod))
+	}
+	if f.HasMeasurementPeriod || f.MeasurementPeriod != 0 {
+		ies = append(ies, NewMeasurementPeriod(f.MeasurementPeriod))
+	}
+	if f.HasAveragePacketDelayThreshold || f.AveragePacketDelayThreshold != 0 {
+		ies = append(ies, NewAveragePacketDelay(f.AveragePacketDelayThreshold))
+	}
+	if f.HasMinimumPacketDelayThreshold || f.MinimumPacketDelayThreshold != 0 {
+		ies = append(ies, NewMinimumPacketDelay(f.MinimumPacketDelayThreshold))
+	}
+	if f.HasMaximumPacketDelayThreshold || f.MaximumPacketDelayThreshold != 0 {
+		ies = append(ies, NewMaximumPacketDelay(f.MaximumPacketDelayThreshold))
+	}
+	if f.HasMinimumWaitingTime || f.MinimumWaitingTime != 0 {
+		ies = append(ies, NewTimer(f.MinimumWaitingTime))
+	}
+
+	return NewGTPUPathQoSControlInformation(ies...)
+}
+
 // GTPUPathQoSControlInformation returns the IEs above GTPUPathQoSControlInformation if the type of IE matches.
 func (i *IE) GTPUPathQoSControlInformation() (*GTPUPathQoSControlInformationFields, error) {
 	if i.Type != GTPUPathQoSControlInformation {
@@ -25,15 +62,24 @@ func (i *IE) GTPUPathQoSControlInformation() (*GTPUPathQoSControlInformationFiel
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type GTPUPathQoSControlInformationFields struct {
-	RemoteGTPUPeer              *RemoteGTPUPeerFields
-	GTPUPathInterfaceType       uint8
-	QoSReportTrigger            uint8
-	DSCP                        uint16
-	MeasurementPeriod           time.Duration
-	AveragePacketDelayThreshold time.Duration
-	MinimumPacketDelayThreshold time.Duration
-	MaximumPacketDelayThreshold time.Duration
-	MinimumWaitingTime          time.Duration
+	RemoteGTPUPeer                 *RemoteGTPUPeerFields
+	GTPUPathInterfaceType          uint8
+	HasGTPUPathInterfaceType       bool
+	QoSReportTrigger               uint8
+	DSCP                           uint16
+	HasDSCP                        bool
+	MeasurementMethod              uint8
+	HasMeasurementMethod           bool
+	MeasurementPeriod              time.Duration
+	HasMeasurementPeriod           bool
+	AveragePacketDelayThreshold    time.Duration
+	HasAveragePacketDelayThreshold bool
+	MinimumPacketDelayThreshold    time.Duration
+	HasMinimumPacketDelayThreshold bool
+	MaximumPacketDelayThreshold    time.Duration
+	HasMaximumPacketDelayThreshold bool
+	MinimumWaitingTime             time.Duration
+	HasMinimumWaitingTime          bool
 }
 
 // ParseGTPUPathQoSControlInformationFields returns the IEs above GTPUPathQoSControlInformation
@@ -69,6 +115,7 @@ func (far *GTPUPathQoSControlInformationFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			far.GTPUPathInterfaceType = v
+			far.HasGTPUPathInterfaceType = true
 		case QoSReportTrigger:
 			v, err := ie.QoSReportTrigger()
 			if err != nil {
@@ -81,36 +128,49 @@ func (far *GTPUPathQoSControlInformationFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			far.DSCP = v
+			far.HasDSCP = true
+		case MeasurementMethod:
+			v, err := ie.MeasurementMethod()
+			if err != nil {
+				return err
+			}
+			far.MeasurementMethod = v
+			far.HasMeasurementMethod = true
 		case MeasurementPeriod:
 			v, err := ie.MeasurementPeriod()
 			if err != nil {
 				return err
 			}
 			far.MeasurementPeriod = v
+			far.HasMeasurementPeriod = true
 		case AveragePacketDelay:
 			v, err := ie.AveragePacketDelay()
 			if err != nil {
 				return err
 			}
 			far.AveragePacketDelayThreshold = v
+			far.HasAveragePacketDelayThreshold = true
 		case MinimumPacketDelay:
 			v, err := ie.MinimumPacketDelay()
 			if err != nil {
 				return err
 			}
 			far.MinimumPacketDelayThreshold = v
+			far.HasMinimumPacketDelayThreshold = true
 		case MaximumPacketDelay:
 			v, err := ie.MaximumPacketDelay()
 			if err != nil {
 				return err
 			}
 			far.MaximumPacketDelayThreshold = v
+			far.HasMaximumPacketDelayThreshold = true
 		case Timer:
 			v, err := ie.Timer()
 			if err != nil {
 				return err
 			}
 			far.MinimumWaitingTime = v
+			far.HasMinimumWaitingTime = true
 		}
 	}
 	return nil
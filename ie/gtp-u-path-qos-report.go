@@ -11,6 +11,29 @@ func NewGTPUPathQoSReport(ies ...*IE) *IE {
 	return newGroupedIE(GTPUPathQoSReport, 0, ies...)
 }
 
+// NewGTPUPathQoSReportFromFields creates a new GTPUPathQoSReport IE from the given
+// fields.
+func NewGTPUPathQoSReportFromFields(f *GTPUPathQoSReportFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{newFieldsIE(RemoteGTPUPeer, f.RemoteGTPUPeer)}
+	if f.HasGTPUPathInterfaceType || f.GTPUPathInterfaceType != 0 {
+		ies = append(ies, newUint8ValIE(GTPUPathInterfaceType, f.GTPUPathInterfaceType))
+	}
+	ies = append(ies, newUint8ValIE(QoSReportTrigger, f.QoSReportTrigger))
+	if !f.TimeStamp.IsZero() {
+		ies = append(ies, NewEventTimeStamp(f.TimeStamp))
+	}
+	if !f.StartTime.IsZero() {
+		ies = append(ies, NewStartTime(f.StartTime))
+	}
+	ies = append(ies, NewQoSInformationInGTPUPathQoSReportFromFields(f.QoSInformation))
+
+	return NewGTPUPathQoSReport(ies...)
+}
+
 // GTPUPathQoSReport returns the IEs above GTPUPathQoSReport if the type of IE matches.
 func (i *IE) GTPUPathQoSReport() (*GTPUPathQoSReportFields, error) {
 	if i.Type != GTPUPathQoSReport {
@@ -24,12 +47,13 @@ func (i *IE) GTPUPathQoSReport() (*GTPUPathQoSReportFields, error) {
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type GTPUPathQoSReportFields struct {
-	RemoteGTPUPeer        *RemoteGTPUPeerFields
-	GTPUPathInterfaceType uint8
-	QoSReportTrigger      uint8
-	TimeStamp             time.Time
-	StartTime             time.Time
-	QoSInformation        *QoSInformationInGTPUPathQoSReportFields
+	RemoteGTPUPeer           *RemoteGTPUPeerFields
+	GTPUPathInterfaceType    uint8
+	HasGTPUPathInterfaceType bool
+	QoSReportTrigger         uint8
+	TimeStamp                time.Time
+	StartTime                time.Time
+	QoSInformation           *QoSInformationInGTPUPathQoSReportFields
 }
 
 // ParseGTPUPathQoSReportFields returns the IEs above GTPUPathQoSReport
@@ -65,6 +89,7 @@ func (far *GTPUPathQoSReportFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			far.GTPUPathInterfaceType = v
+			far.HasGTPUPathInterfaceType = true
 		case QoSReportTrigger:
 			v, err := ie.QoSReportTrigger()
 			if err != nil {
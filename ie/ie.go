@@ -5,6 +5,7 @@
 package ie
 
 import (
+	"bytes"
 	"encoding/binary"
 	"io"
 	"time"
@@ -314,35 +315,69 @@ const (
 	PFCPSessionChangeInfo                                            IEType = 290
 	GroupID                                                          IEType = 291
 	CPIPAddress                                                      IEType = 292
-	IPAddressAndPortNumberRepalcement                                IEType = 293
-	DNSQueryFilter                                                   IEType = 294
-	DirectReportingInformation                                       IEType = 295
-	EventNotificationURI                                             IEType = 296
-	NotificationCorrelationID                                        IEType = 297
-	ReportingFlags                                                   IEType = 298
-	PredefinedRulesName                                              IEType = 299
-	MBSSessionN4mbControlInformation                                 IEType = 300
-	MBSMulticastParameters                                           IEType = 301
-	AddMBSUnicastParameters                                          IEType = 302
-	MBSSessionN4mbInformation                                        IEType = 303
-	RemoveMBSUnicastParameters                                       IEType = 304
-	MBSSessionIdentifier                                             IEType = 305
-	MulticastTransportInformation                                    IEType = 306
-	MBSN4mbReqFlags                                                  IEType = 307
-	LocalIngressTunnel                                               IEType = 308
-	MBSUnicastParametersID                                           IEType = 309
-	MBSSessionN4ControlInformation                                   IEType = 310
-	MBSSessionN4Information                                          IEType = 311
-	MBSN4RespFlags                                                   IEType = 312
-	TunnelPassword                                                   IEType = 313
-	AreaSessionID                                                    IEType = 314
-	PeerUPRestartReport                                              IEType = 315
-	DSCPToPPIControlInformation                                      IEType = 316
-	DSCPToPPIMappingInformation                                      IEType = 317
-	PFCPSDRspFlags                                                   IEType = 318
-	QERIndications                                                   IEType = 319
-	VendorSpecificNodeReportType                                     IEType = 320
-	ConfiguredTimeDomain                                             IEType = 321
+	IPAddressAndPortNumberReplacement                                IEType = 293
+	// Deprecated: use IPAddressAndPortNumberReplacement instead.
+	IPAddressAndPortNumberRepalcement             IEType = 293
+	DNSQueryFilter                                IEType = 294
+	DirectReportingInformation                    IEType = 295
+	EventNotificationURI                          IEType = 296
+	NotificationCorrelationID                     IEType = 297
+	ReportingFlags                                IEType = 298
+	PredefinedRulesName                           IEType = 299
+	MBSSessionN4mbControlInformation              IEType = 300
+	MBSMulticastParameters                        IEType = 301
+	AddMBSUnicastParameters                       IEType = 302
+	MBSSessionN4mbInformation                     IEType = 303
+	RemoveMBSUnicastParameters                    IEType = 304
+	MBSSessionIdentifier                          IEType = 305
+	MulticastTransportInformation                 IEType = 306
+	MBSN4mbReqFlags                               IEType = 307
+	LocalIngressTunnel                            IEType = 308
+	MBSUnicastParametersID                        IEType = 309
+	MBSSessionN4ControlInformation                IEType = 310
+	MBSSessionN4Information                       IEType = 311
+	MBSN4RespFlags                                IEType = 312
+	TunnelPassword                                IEType = 313
+	AreaSessionID                                 IEType = 314
+	PeerUPRestartReport                           IEType = 315
+	DSCPToPPIControlInformation                   IEType = 316
+	DSCPToPPIMappingInformation                   IEType = 317
+	PFCPSDRspFlags                                IEType = 318
+	QERIndications                                IEType = 319
+	VendorSpecificNodeReportType                  IEType = 320
+	ConfiguredTimeDomain                          IEType = 321
+	Metadata                                      IEType = 322
+	TrafficParameterMeasurementControlInformation IEType = 323
+	TrafficParameterMeasurementReport             IEType = 324
+	TrafficParameterThreshold                     IEType = 325
+	DLPeriodicity                                 IEType = 326
+	N6JitterMeasurement                           IEType = 327
+	TrafficParameterMeasurementIndication         IEType = 328
+	ULPeriodicity                                 IEType = 329
+	MPQUICControlInformation                      IEType = 330
+	MPQUICParameters                              IEType = 331
+	MPQUICAddressInformation                      IEType = 332
+	TransportMode                                 IEType = 333
+	ProtocolDescription                           IEType = 334
+	ReportingSuggestionInfo                       IEType = 335
+	TLContainer                                   IEType = 336
+	MeasurementIndication                         IEType = 337
+	HPLMNSNSSAI                                   IEType = 338
+	MediaTransportProtocol                        IEType = 339
+	RTPHeaderExtensionInformation                 IEType = 340
+	RTPPayloadInformation                         IEType = 341
+	RTPHeaderExtensionType                        IEType = 342
+	RTPHeaderExtensionID                          IEType = 343
+	RTPPayloadType                                IEType = 344
+	RTPPayloadFormat                              IEType = 345
+	ExtendedDLBufferingNotificationPolicy         IEType = 346
+	MTSDTControlInformation                       IEType = 347
+	ReportingThresholds                           IEType = 348
+	RTPHeaderExtensionAdditionalInformation       IEType = 349
+	MappedN6IPAddress                             IEType = 350
+	N6RoutingInformation                          IEType = 351
+	URI                                           IEType = 352
+	UELevelMeasurementsConfiguration              IEType = 353
 )
 
 // IE represents an Information Element of PFCP messages.
@@ -560,8 +595,52 @@ func ParseMultiIEs(b []byte) ([]*IE, error) {
 	return ies, nil
 }
 
+// DecodeInto decodes b into i like UnmarshalBinary, but reuses the ChildIEs i
+// holds (and their ChildIEs recursively) instead of allocating new ones.
+//
+// Note that the IEs i held before the call are overwritten, so they must not
+// be retained by the caller. Like Parse, i refers to b after the call.
+func DecodeInto(i *IE, b []byte) error {
+	i.Reset()
+	return i.unmarshal(b, true)
+}
+
+// DecodeMultiIEsInto decodes multiple IEs like ParseMultiIEs, but reuses the
+// IEs in ies, including the ones between its length and capacity, as
+// DecodeInto does. It returns the slice holding the decoded IEs.
+func DecodeMultiIEsInto(ies []*IE, b []byte) ([]*IE, error) {
+	ies = ies[:0]
+	for len(b) > 0 {
+		n := len(ies)
+		if n < cap(ies) {
+			ies = ies[:n+1]
+		} else {
+			ies = append(ies, nil)
+		}
+		if ies[n] == nil {
+			ies[n] = &IE{}
+		}
+
+		if err := DecodeInto(ies[n], b); err != nil {
+			return nil, err
+		}
+		b = b[ies[n].MarshalLen():]
+	}
+	return ies, nil
+}
+
+// Reset resets i to the zero value, keeping the ChildIEs so that they can be
+// reused by DecodeInto.
+func (i *IE) Reset() {
+	*i = IE{ChildIEs: i.ChildIEs[:0]}
+}
+
 // UnmarshalBinary parses b into IE.
 func (i *IE) UnmarshalBinary(b []byte) error {
+	return i.unmarshal(b, false)
+}
+
+func (i *IE) unmarshal(b []byte, reuse bool) error {
 	l := len(b)
 	if l < 4 {
 		return io.ErrUnexpectedEOF
@@ -596,7 +675,11 @@ func (i *IE) UnmarshalBinary(b []byte) error {
 
 	if i.IsGrouped() {
 		var err error
-		i.ChildIEs, err = ParseMultiIEs(i.Payload)
+		if reuse {
+			i.ChildIEs, err = DecodeMultiIEsInto(i.ChildIEs, i.Payload)
+		} else {
+			i.ChildIEs, err = ParseMultiIEs(i.Payload)
+		}
 		if err != nil {
 			return err
 		}
@@ -661,6 +744,32 @@ func (i *IE) MarshalLen() int {
 	return l + len(i.Payload)
 }
 
+// Clone returns a deep copy of the IE, which shares no memory with the
+// original one, e.g., the buffer given to Parse.
+//
+// The Payload and the ChildIEs are copied as they are, even if they do not
+// match with each other.
+func (i *IE) Clone() *IE {
+	if i == nil {
+		return nil
+	}
+
+	c := &IE{
+		Type:         i.Type,
+		Length:       i.Length,
+		EnterpriseID: i.EnterpriseID,
+		Payload:      bytes.Clone(i.Payload),
+	}
+	if i.ChildIEs != nil {
+		c.ChildIEs = make([]*IE, len(i.ChildIEs))
+		for n, ie := range i.ChildIEs {
+			c.ChildIEs[n] = ie.Clone()
+		}
+	}
+
+	return c
+}
+
 // SetLength sets the length in Length field.
 func (i *IE) SetLength() {
 	l := 0
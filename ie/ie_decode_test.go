@@ -0,0 +1,142 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// serializedSessionEstablishmentIEs returns the IEs in a typical Session
+// Establishment Request, with two PDRs, FARs and a URR and a QER.
+func serializedSessionEstablishmentIEs(tb testing.TB) []byte {
+	tb.Helper()
+
+	ies := []*ie.IE{
+		ie.NewNodeID("127.0.0.1", "", ""),
+		ie.NewFSEID(0x1111111122222222, net.ParseIP("127.0.0.1"), nil),
+		ie.NewCreatePDR(
+			ie.NewPDRID(1),
+			ie.NewPrecedence(100),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
+				ie.NewNetworkInstance("internet"),
+				ie.NewUEIPAddress(0x02, "10.0.0.1", "", 0, 0),
+			),
+			ie.NewOuterHeaderRemoval(0, 0),
+			ie.NewFARID(1),
+			ie.NewURRID(1),
+			ie.NewQERID(1),
+		),
+		ie.NewCreatePDR(
+			ie.NewPDRID(2),
+			ie.NewPrecedence(100),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceCore),
+				ie.NewNetworkInstance("internet"),
+				ie.NewUEIPAddress(0x06, "10.0.0.1", "", 0, 0),
+			),
+			ie.NewFARID(2),
+			ie.NewURRID(1),
+			ie.NewQERID(1),
+		),
+		ie.NewCreateFAR(
+			ie.NewFARID(1),
+			ie.NewApplyAction(0x02),
+			ie.NewForwardingParameters(
+				ie.NewDestinationInterface(ie.DstInterfaceCore),
+				ie.NewNetworkInstance("internet"),
+			),
+		),
+		ie.NewCreateFAR(
+			ie.NewFARID(2),
+			ie.NewApplyAction(0x02),
+			ie.NewForwardingParameters(
+				ie.NewDestinationInterface(ie.DstInterfaceAccess),
+				ie.NewNetworkInstance("internet"),
+				ie.NewOuterHeaderCreation(0x0100, 0x22222222, "127.0.0.2", "", 0, 0, 0),
+			),
+		),
+		ie.NewCreateURR(
+			ie.NewURRID(1),
+			ie.NewMeasurementMethod(0, 1, 0),
+			ie.NewReportingTriggers(0x01, 0x00),
+		),
+		ie.NewCreateQER(
+			ie.NewQERID(1),
+			ie.NewGateStatus(ie.GateStatusOpen, ie.GateStatusOpen),
+			ie.NewMBR(0x11111111, 0x22222222),
+		),
+	}
+
+	var b []byte
+	for _, i := range ies {
+		s, err := i.Marshal()
+		if err != nil {
+			tb.Fatal(err)
+		}
+		b = append(b, s...)
+	}
+	return b
+}
+
+func TestDecodeInto(t *testing.T) {
+	b := serializedSessionEstablishmentIEs(t)
+	want, err := ie.ParseMultiIEs(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// decode the IEs in a different order to reuse the IEs for other types.
+	got, err := ie.DecodeMultiIEsInto(nil, b[want[0].MarshalLen()+want[1].MarshalLen():])
+	if err != nil {
+		t.Fatal(err)
+	}
+	reused := got[0]
+	got, err = ie.DecodeMultiIEsInto(got, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got[0] != reused {
+		t.Error("IE is not reused")
+	}
+	if diff := cmp.Diff(got, want, cmpopts.EquateEmpty()); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func BenchmarkParseMultiIEs(b *testing.B) {
+	s := serializedSessionEstablishmentIEs(b)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := ie.ParseMultiIEs(s); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeMultiIEsInto(b *testing.B) {
+	s := serializedSessionEstablishmentIEs(b)
+
+	var ies []*ie.IE
+	b.ReportAllocs()
+	for b.Loop() {
+		var err error
+		if ies, err = ie.DecodeMultiIEsInto(ies, s); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() { ies, _ = ie.DecodeMultiIEsInto(ies, s) }); allocs != 0 {
+		b.Errorf("got %v allocs/op, want 0", allocs)
+	}
+}
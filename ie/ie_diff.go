@@ -0,0 +1,136 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Difference is a difference between two IEs found by Diff.
+type Difference struct {
+	// Path is the path to the IEs that differ, consisting of the names of
+	// the IE types separated by "/", e.g., "CreatePDR[2]/PDI/FTEID".
+	// The index starting from 0 is added to the name if there are multiple
+	// IEs of the same type in the same level.
+	Path string
+	// X and Y are the IEs that differ. Either of them is nil if the IE is
+	// missing on the side.
+	X, Y *IE
+}
+
+// String returns the difference in a human-readable format.
+func (d Difference) String() string {
+	return fmt.Sprintf("%s: %s != %s", d.Path, describeIE(d.X), describeIE(d.Y))
+}
+
+func describeIE(i *IE) string {
+	switch {
+	case i == nil:
+		return "(missing)"
+	case i.IsVendorSpecific():
+		return fmt.Sprintf("{Type: %s, EnterpriseID: %d, Payload: %#x}", i.Type, i.EnterpriseID, i.Payload)
+	default:
+		return fmt.Sprintf("{Type: %s, Payload: %#x}", i.Type, i.Payload)
+	}
+}
+
+// Equal reports whether the IE is semantically equal to j, i.e., Diff finds
+// no difference between them.
+func (i *IE) Equal(j *IE) bool {
+	return len(Diff(i, j)) == 0
+}
+
+// Diff returns the semantic differences between x and y.
+//
+// The non-grouped IEs are compared by their types and payloads. The grouped
+// IEs are compared by their child IEs, which are taken from ChildIEs, or from
+// Payload if ChildIEs is empty; the Length and how the child IEs are held do
+// not matter. The child IEs are matched by their types and the order among
+// the ones of the same type, and the order of the different types of IEs is
+// ignored.
+func Diff(x, y *IE) []Difference {
+	switch {
+	case x == nil && y == nil:
+		return nil
+	case x == nil:
+		return []Difference{{Path: y.Type.String(), Y: y}}
+	case y == nil:
+		return []Difference{{Path: x.Type.String(), X: x}}
+	case x.Type != y.Type:
+		return []Difference{{Path: x.Type.String(), X: x, Y: y}}
+	}
+
+	return diff(nil, x.Type.String(), x, y)
+}
+
+// DiffIEs returns the semantic differences between two lists of IEs, e.g.,
+// the IEs in messages. The IEs are matched in the same way as the child IEs
+// of grouped IEs in Diff, and the paths start with the names of the IEs in
+// the lists.
+func DiffIEs(x, y []*IE) []Difference {
+	return diffIEs(nil, "", x, y)
+}
+
+func diff(diffs []Difference, path string, x, y *IE) []Difference {
+	if x.IsVendorSpecific() && x.EnterpriseID != y.EnterpriseID {
+		return append(diffs, Difference{Path: path, X: x, Y: y})
+	}
+
+	if x.IsGrouped() {
+		cx, errx := x.ValueAsGrouped()
+		cy, erry := y.ValueAsGrouped()
+		if errx == nil && erry == nil {
+			return diffIEs(diffs, path+"/", cx, cy)
+		}
+	}
+
+	if !bytes.Equal(x.Payload, y.Payload) {
+		return append(diffs, Difference{Path: path, X: x, Y: y})
+	}
+	return diffs
+}
+
+func diffIEs(diffs []Difference, prefix string, x, y []*IE) []Difference {
+	var types []IEType
+	xs, ys := map[IEType][]*IE{}, map[IEType][]*IE{}
+	for _, l := range []struct {
+		ies []*IE
+		m   map[IEType][]*IE
+	}{{x, xs}, {y, ys}} {
+		for _, i := range l.ies {
+			if i == nil {
+				continue
+			}
+			if _, ok := xs[i.Type]; !ok {
+				if _, ok := ys[i.Type]; !ok {
+					types = append(types, i.Type)
+				}
+			}
+			l.m[i.Type] = append(l.m[i.Type], i)
+		}
+	}
+
+	for _, t := range types {
+		a, b := xs[t], ys[t]
+		for n := 0; n < max(len(a), len(b)); n++ {
+			path := prefix + t.String()
+			if len(a) > 1 || len(b) > 1 {
+				path += fmt.Sprintf("[%d]", n)
+			}
+
+			switch {
+			case n >= len(a):
+				diffs = append(diffs, Difference{Path: path, Y: b[n]})
+			case n >= len(b):
+				diffs = append(diffs, Difference{Path: path, X: a[n]})
+			default:
+				diffs = diff(diffs, path, a[n], b[n])
+			}
+		}
+	}
+
+	return diffs
+}
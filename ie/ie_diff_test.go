@@ -0,0 +1,108 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func newTestCreatePDR(id uint16, teid uint32) *ie.IE {
+	return ie.NewCreatePDR(
+		ie.NewPDRID(id),
+		ie.NewPrecedence(0x11111111),
+		ie.NewPDI(
+			ie.NewSourceInterface(ie.SrcInterfaceAccess),
+			ie.NewFTEID(0x01, teid, net.ParseIP("127.0.0.1"), nil, 0),
+		),
+		ie.NewFARID(0xffffffff),
+	)
+}
+
+func TestIEClone(t *testing.T) {
+	b, err := newTestCreatePDR(1, 0x11111111).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	i, err := ie.Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := i.Clone()
+	if diff := cmp.Diff(c, i); diff != "" {
+		t.Fatal(diff)
+	}
+
+	// the clone should not be affected by the changes in the buffer.
+	want, err := ie.Parse(append([]byte(nil), b...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n := range b {
+		b[n] = 0
+	}
+	if diff := cmp.Diff(c, want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestIEDiff(t *testing.T) {
+	t.Run("Representation", func(t *testing.T) {
+		x := newTestCreatePDR(1, 0x11111111)
+		y := newTestCreatePDR(1, 0x11111111)
+		y.ChildIEs = nil
+		y.Length = 0
+
+		if !x.Equal(y) {
+			t.Errorf("should be equal: %v", ie.Diff(x, y))
+		}
+	})
+
+	t.Run("Order", func(t *testing.T) {
+		x := ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceAccess), ie.NewNetworkInstance("go-pfcp"))
+		y := ie.NewPDI(ie.NewNetworkInstance("go-pfcp"), ie.NewSourceInterface(ie.SrcInterfaceAccess))
+
+		if !x.Equal(y) {
+			t.Errorf("should be equal: %v", ie.Diff(x, y))
+		}
+	})
+
+	t.Run("Paths", func(t *testing.T) {
+		x := []*ie.IE{
+			newTestCreatePDR(1, 0x11111111),
+			newTestCreatePDR(2, 0x22222222),
+			newTestCreatePDR(3, 0x33333333),
+			ie.NewApplyAction(0x02),
+		}
+		y := []*ie.IE{
+			newTestCreatePDR(1, 0x11111111),
+			newTestCreatePDR(2, 0x22222222),
+			newTestCreatePDR(3, 0x44444444),
+			ie.NewCreateFAR(ie.NewFARID(0xffffffff)),
+		}
+
+		got := ie.DiffIEs(x, y)
+		want := []ie.Difference{
+			{
+				Path: "CreatePDR[2]/PDI/FTEID",
+				X:    ie.NewFTEID(0x01, 0x33333333, net.ParseIP("127.0.0.1"), nil, 0),
+				Y:    ie.NewFTEID(0x01, 0x44444444, net.ParseIP("127.0.0.1"), nil, 0),
+			},
+			{Path: "ApplyAction", X: ie.NewApplyAction(0x02)},
+			{Path: "CreateFAR", Y: ie.NewCreateFAR(ie.NewFARID(0xffffffff))},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		if got, want := got[0].String(), "CreatePDR[2]/PDI/FTEID: {Type: FTEID, Payload: 0x01333333337f000001} != {Type: FTEID, Payload: 0x01444444447f000001}"; got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	})
+}
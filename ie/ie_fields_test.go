@@ -0,0 +1,111 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// roundTrip returns a function that parses the IE into its *XxxFields with
+// get, rebuilds an IE from them with build, and parses it again.
+// It returns the rebuilt IE, the fields parsed from it and the original ones.
+func roundTrip[F any](get func(*ie.IE) (F, error), build func(F) *ie.IE) func(*ie.IE) (*ie.IE, any, any, error) {
+	return func(i *ie.IE) (*ie.IE, any, any, error) {
+		want, err := get(i)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		rebuilt := build(want)
+		if rebuilt == nil {
+			return nil, nil, want, nil
+		}
+		got, err := get(rebuilt)
+		return rebuilt, got, want, err
+	}
+}
+
+var fromFields = map[ie.IEType]func(i *ie.IE) (*ie.IE, any, any, error){
+	ie.CreatePDR:                       roundTrip((*ie.IE).CreatePDR, ie.NewCreatePDRFromFields),
+	ie.PDI:                             roundTrip((*ie.IE).PDI, ie.NewPDIFromFields),
+	ie.UpdatePDR:                       roundTrip((*ie.IE).UpdatePDR, ie.NewUpdatePDRFromFields),
+	ie.CreatedPDR:                      roundTrip((*ie.IE).CreatedPDR, ie.NewCreatedPDRFromFields),
+	ie.UpdatedPDR:                      roundTrip((*ie.IE).UpdatedPDR, ie.NewUpdatedPDRFromFields),
+	ie.RedundantTransmissionParameters: roundTrip((*ie.IE).RedundantTransmissionParameters, ie.NewRedundantTransmissionParametersFromFields),
+	ie.IPMulticastAddressingInfo:       roundTrip((*ie.IE).IPMulticastAddressingInfo, ie.NewIPMulticastAddressingInfoFromFields),
+	ie.EthernetPacketFilter:            roundTrip((*ie.IE).EthernetPacketFilter, ie.NewEthernetPacketFilterFromFields),
+	ie.CreateFAR:                       roundTrip((*ie.IE).CreateFAR, ie.NewCreateFARFromFields),
+	ie.UpdateFAR:                       roundTrip((*ie.IE).UpdateFAR, ie.NewUpdateFARFromFields),
+	ie.ForwardingParameters:            roundTrip((*ie.IE).ForwardingParameters, ie.NewForwardingParametersFromFields),
+	ie.UpdateForwardingParameters:      roundTrip((*ie.IE).UpdateForwardingParameters, ie.NewUpdateForwardingParametersFromFields),
+	ie.DuplicatingParameters:           roundTrip((*ie.IE).DuplicatingParameters, ie.NewDuplicatingParametersFromFields),
+	ie.UpdateDuplicatingParameters:     roundTrip((*ie.IE).UpdateDuplicatingParameters, ie.NewUpdateDuplicatingParametersFromFields),
+	ie.RedundantTransmissionForwardingParameters:      roundTrip((*ie.IE).RedundantTransmissionForwardingParameters, ie.NewRedundantTransmissionForwardingParametersFromFields),
+	ie.MBSMulticastParameters:                         roundTrip((*ie.IE).MBSMulticastParameters, ie.NewMBSMulticastParametersFromFields),
+	ie.AddMBSUnicastParameters:                        roundTrip((*ie.IE).AddMBSUnicastParameters, ie.NewAddMBSUnicastParametersFromFields),
+	ie.RemoveMBSUnicastParameters:                     roundTrip((*ie.IE).RemoveMBSUnicastParameters, ie.NewRemoveMBSUnicastParametersFromFields),
+	ie.CreateQER:                                      roundTrip((*ie.IE).CreateQER, ie.NewCreateQERFromFields),
+	ie.UpdateQER:                                      roundTrip((*ie.IE).UpdateQER, ie.NewUpdateQERFromFields),
+	ie.CreateURR:                                      roundTrip((*ie.IE).CreateURR, ie.NewCreateURRFromFields),
+	ie.UpdateURR:                                      roundTrip((*ie.IE).UpdateURR, ie.NewUpdateURRFromFields),
+	ie.AggregatedURRs:                                 roundTrip((*ie.IE).AggregatedURRs, ie.NewAggregatedURRsFromFields),
+	ie.AdditionalMonitoringTime:                       roundTrip((*ie.IE).AdditionalMonitoringTime, ie.NewAdditionalMonitoringTimeFromFields),
+	ie.CreateBAR:                                      roundTrip((*ie.IE).CreateBAR, ie.NewCreateBARFromFields),
+	ie.UpdateBARWithinSessionModificationRequest:      roundTrip((*ie.IE).UpdateBAR, ie.NewUpdateBARWithinSessionModificationRequestFromFields),
+	ie.UpdateBARWithinSessionReportResponse:           roundTrip((*ie.IE).UpdateBAR, ie.NewUpdateBARWithinSessionReportResponseFromFields),
+	ie.CreateMAR:                                      roundTrip((*ie.IE).CreateMAR, ie.NewCreateMARFromFields),
+	ie.UpdateMAR:                                      roundTrip((*ie.IE).UpdateMAR, ie.NewUpdateMARFromFields),
+	ie.TGPPAccessForwardingActionInformation:          roundTrip((*ie.IE).TGPPAccessForwardingActionInformation, ie.NewTGPPAccessForwardingActionInformationFromFields),
+	ie.NonTGPPAccessForwardingActionInformation:       roundTrip((*ie.IE).NonTGPPAccessForwardingActionInformation, ie.NewNonTGPPAccessForwardingActionInformationFromFields),
+	ie.UpdateTGPPAccessForwardingActionInformation:    roundTrip((*ie.IE).UpdateTGPPAccessForwardingActionInformation, ie.NewUpdateTGPPAccessForwardingActionInformationFromFields),
+	ie.UpdateNonTGPPAccessForwardingActionInformation: roundTrip((*ie.IE).UpdateNonTGPPAccessForwardingActionInformation, ie.NewUpdateNonTGPPAccessForwardingActionInformationFromFields),
+	ie.CreateSRR:                                      roundTrip((*ie.IE).CreateSRR, ie.NewCreateSRRFromFields),
+	ie.UpdateSRR:                                      roundTrip((*ie.IE).UpdateSRR, ie.NewUpdateSRRFromFields),
+	ie.AccessAvailabilityControlInformation:           roundTrip((*ie.IE).AccessAvailabilityControlInformation, ie.NewAccessAvailabilityControlInformationFromFields),
+	ie.QoSMonitoringPerQoSFlowControlInformation:      roundTrip((*ie.IE).QoSMonitoringPerQoSFlowControlInformation, ie.NewQoSMonitoringPerQoSFlowControlInformationFromFields),
+	ie.DirectReportingInformation:                     roundTrip((*ie.IE).DirectReportingInformation, ie.NewDirectReportingInformationFromFields),
+	ie.CreateTrafficEndpoint: roundTrip(func(i *ie.IE) (*ie.CreateTrafficEndpointFields, error) {
+		return ie.ParseCreateTrafficEndpointFields(i.Payload)
+	}, ie.NewCreateTrafficEndpointFromFields),
+	ie.UpdateTrafficEndpoint:                                     roundTrip((*ie.IE).UpdateTrafficEndpoint, ie.NewUpdateTrafficEndpointFromFields),
+	ie.CreatedTrafficEndpoint:                                    roundTrip((*ie.IE).CreatedTrafficEndpoint, ie.NewCreatedTrafficEndpointFromFields),
+	ie.AccessAvailabilityReport:                                  roundTrip((*ie.IE).AccessAvailabilityReport, ie.NewAccessAvailabilityReportFromFields),
+	ie.ApplicationDetectionInformation:                           roundTrip((*ie.IE).ApplicationDetectionInformation, ie.NewApplicationDetectionInformationFromFields),
+	ie.ApplicationIDsPFDs:                                        roundTrip((*ie.IE).ApplicationIDsPFDs, ie.NewApplicationIDsPFDsFromFields),
+	ie.PFDContext:                                                roundTrip((*ie.IE).PFDContext, ie.NewPFDContextFromFields),
+	ie.ClockDriftControlInformation:                              roundTrip((*ie.IE).ClockDriftControlInformation, ie.NewClockDriftControlInformationFromFields),
+	ie.ClockDriftReport:                                          roundTrip((*ie.IE).ClockDriftReport, ie.NewClockDriftReportFromFields),
+	ie.CreatedBridgeInfoForTSC:                                   roundTrip((*ie.IE).CreatedBridgeInfoForTSC, ie.NewCreatedBridgeInfoForTSCFromFields),
+	ie.DownlinkDataReport:                                        roundTrip((*ie.IE).DownlinkDataReport, ie.NewDownlinkDataReportFromFields),
+	ie.ErrorIndicationReport:                                     roundTrip((*ie.IE).ErrorIndicationReport, ie.NewErrorIndicationReportFromFields),
+	ie.EthernetContextInformation:                                roundTrip((*ie.IE).EthernetContextInformation, ie.NewEthernetContextInformationFromFields),
+	ie.EthernetTrafficInformation:                                roundTrip((*ie.IE).EthernetTrafficInformation, ie.NewEthernetTrafficInformationFromFields),
+	ie.GTPUPathQoSControlInformation:                             roundTrip((*ie.IE).GTPUPathQoSControlInformation, ie.NewGTPUPathQoSControlInformationFromFields),
+	ie.GTPUPathQoSReport:                                         roundTrip((*ie.IE).GTPUPathQoSReport, ie.NewGTPUPathQoSReportFromFields),
+	ie.QoSInformationInGTPUPathQoSReport:                         roundTrip((*ie.IE).QoSInformationInGTPUPathQoSReport, ie.NewQoSInformationInGTPUPathQoSReportFromFields),
+	ie.JoinIPMulticastInformationWithinUsageReport:               roundTrip((*ie.IE).JoinIPMulticastInformationWithinUsageReport, ie.NewJoinIPMulticastInformationWithinUsageReportFromFields),
+	ie.LeaveIPMulticastInformationWithinUsageReport:              roundTrip((*ie.IE).LeaveIPMulticastInformationWithinUsageReport, ie.NewLeaveIPMulticastInformationWithinUsageReportFromFields),
+	ie.LoadControlInformation:                                    roundTrip((*ie.IE).LoadControlInformation, ie.NewLoadControlInformationFromFields),
+	ie.MPQUICParameters:                                          roundTrip((*ie.IE).MPQUICParameters, ie.NewMPQUICParametersFromFields),
+	ie.PeerUPRestartReport:                                       roundTrip((*ie.IE).PeerUPRestartReport, ie.NewPeerUPRestartReportFromFields),
+	ie.PFCPSessionRetentionInformation:                           roundTrip((*ie.IE).PFCPSessionRetentionInformation, ie.NewPFCPSessionRetentionInformationFromFields),
+	ie.ProtocolDescription:                                       roundTrip((*ie.IE).ProtocolDescription, ie.NewProtocolDescriptionFromFields),
+	ie.RTPHeaderExtensionInformation:                             roundTrip((*ie.IE).RTPHeaderExtensionInformation, ie.NewRTPHeaderExtensionInformationFromFields),
+	ie.RTPPayloadInformation:                                     roundTrip((*ie.IE).RTPPayloadInformation, ie.NewRTPPayloadInformationFromFields),
+	ie.QoSMonitoringReport:                                       roundTrip((*ie.IE).QoSMonitoringReport, ie.NewQoSMonitoringReportFromFields),
+	ie.OverloadControlInformation:                                roundTrip((*ie.IE).OverloadControlInformation, ie.NewOverloadControlInformationFromFields),
+	ie.SessionReport:                                             roundTrip((*ie.IE).SessionReport, ie.NewSessionReportFromFields),
+	ie.TrafficParameterMeasurementControlInformation:             roundTrip((*ie.IE).TrafficParameterMeasurementControlInformation, ie.NewTrafficParameterMeasurementControlInformationFromFields),
+	ie.TrafficParameterMeasurementReport:                         roundTrip((*ie.IE).TrafficParameterMeasurementReport, ie.NewTrafficParameterMeasurementReportFromFields),
+	ie.TSCManagementInformationWithinSessionModificationRequest:  roundTrip((*ie.IE).TSCManagementInformation, ie.NewTSCManagementInformationWithinSessionModificationRequestFromFields),
+	ie.TSCManagementInformationWithinSessionModificationResponse: roundTrip((*ie.IE).TSCManagementInformation, ie.NewTSCManagementInformationWithinSessionModificationResponseFromFields),
+	ie.TSCManagementInformationWithinSessionReportRequest:        roundTrip((*ie.IE).TSCManagementInformation, ie.NewTSCManagementInformationWithinSessionReportRequestFromFields),
+	ie.UEIPAddressUsageInformation:                               roundTrip((*ie.IE).UEIPAddressUsageInformation, ie.NewUEIPAddressUsageInformationFromFields),
+	ie.UserPlanePathFailureReport:                                roundTrip((*ie.IE).UserPlanePathFailureReport, ie.NewUserPlanePathFailureReportFromFields),
+	ie.UserPlanePathRecoveryReport:                               roundTrip((*ie.IE).UserPlanePathRecoveryReport, ie.NewUserPlanePathRecoveryReportFromFields),
+	ie.UsageReportWithinSessionModificationResponse:              roundTrip((*ie.IE).UsageReport, ie.NewUsageReportWithinSessionModificationResponseFromFields),
+	ie.UsageReportWithinSessionDeletionResponse:                  roundTrip((*ie.IE).UsageReport, ie.NewUsageReportWithinSessionDeletionResponseFromFields),
+	ie.UsageReportWithinSessionReportRequest:                     roundTrip((*ie.IE).UsageReport, ie.NewUsageReportWithinSessionReportRequestFromFields),
+}
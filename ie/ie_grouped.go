@@ -1,6 +1,10 @@
 package ie
 
-import "sync"
+import (
+	"sync"
+
+	"github.com/wmnsk/go-pfcp/internal/logger"
+)
 
 // We're using map to avoid iterating over a list.
 // The value `true` is not actually used.
@@ -109,6 +113,12 @@ var (
 		MBSSessionN4ControlInformation:                            true,
 		MBSSessionN4Information:                                   true,
 		DSCPToPPIControlInformation:                               true,
+		TrafficParameterMeasurementControlInformation:             true,
+		TrafficParameterMeasurementReport:                         true,
+		MPQUICParameters:                                          true,
+		ProtocolDescription:                                       true,
+		RTPHeaderExtensionInformation:                             true,
+		RTPPayloadInformation:                                     true,
 	}
 	isGroupedFun = func(t IEType) bool {
 		mu.RLock()
@@ -208,3 +218,25 @@ func (i *IE) FindByType(typ IEType) (*IE, error) {
 	}
 	return nil, ErrIENotFound
 }
+
+// newFieldsIE creates an IE of the given type with the payload serialized from
+// f, which is one of the *XxxFields structs of non-grouped IEs.
+// It returns nil if f is nil or cannot be serialized, so that the result can
+// be passed to the constructors of grouped IEs as it is. The IE that cannot be
+// serialized is logged, as the grouped IE is created without it.
+func newFieldsIE[T any, F interface {
+	*T
+	Marshal() ([]byte, error)
+}](itype IEType, f F) *IE {
+	if f == nil {
+		return nil
+	}
+
+	b, err := f.Marshal()
+	if err != nil {
+		logger.Logf("newFieldsIE() failed to marshal an IE(Type=%d), which is omitted: %v", itype, err)
+		return nil
+	}
+
+	return New(itype, b)
+}
@@ -0,0 +1,495 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MarshalText returns the name of IEType, which is used to represent IEType
+// in JSON.
+func (t IEType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText sets the IEType from its name. The name of the types without
+// definition in this package, e.g., "IEType(32770)", and the decimal value of
+// the type are also accepted.
+func (t *IEType) UnmarshalText(b []byte) error {
+	s := string(b)
+	if v, ok := ieTypesByName()[s]; ok {
+		*t = v
+		return nil
+	}
+
+	if strings.HasPrefix(s, "IEType(") && strings.HasSuffix(s, ")") {
+		s = s[len("IEType(") : len(s)-1]
+	}
+	v, err := strconv.ParseUint(s, 10, 16)
+	if err != nil {
+		return fmt.Errorf("%w: %q", ErrInvalidType, b)
+	}
+
+	*t = IEType(v)
+	return nil
+}
+
+// ieTypesByName returns the map of names of IEType to their values.
+var ieTypesByName = sync.OnceValue(func() map[string]IEType {
+	m := make(map[string]IEType)
+	for t := IEType(1); t < 1024; t++ {
+		if s := t.String(); !strings.HasPrefix(s, "IEType(") {
+			m[s] = t
+		}
+	}
+	return m
+})
+
+// ieJSON is the representation of IE in JSON.
+type ieJSON struct {
+	Type         IEType          `json:"type"`
+	EnterpriseID uint16          `json:"enterpriseID,omitempty"`
+	Value        json.RawMessage `json:"value,omitempty"`
+	Payload      string          `json:"payload,omitempty"`
+	ChildIEs     []*IE           `json:"childIEs,omitempty"`
+}
+
+// MarshalJSON returns the JSON encoding of an IE.
+//
+// The type of IE is represented by its name. The grouped IEs have their
+// ChildIEs as nested objects, and the other IEs known to this package have
+// their decoded values, e.g., *FTEIDFields for F-TEID IE. The payload of the
+// rest, including vendor-specific IEs and the IEs whose values cannot be
+// encoded back into the same bytes, is represented in hex.
+func (i *IE) MarshalJSON() ([]byte, error) {
+	j := &ieJSON{Type: i.Type}
+	if i.IsVendorSpecific() {
+		j.EnterpriseID = i.EnterpriseID
+	}
+
+	if i.IsGrouped() {
+		j.ChildIEs = i.ChildIEs
+		return json.Marshal(j)
+	}
+
+	if c, ok := valueCodecs[i.Type]; ok {
+		j.Value = c.marshal(i)
+	}
+	if j.Value == nil {
+		j.Payload = hex.EncodeToString(i.Payload)
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes the JSON encoding of an IE generated by MarshalJSON.
+func (i *IE) UnmarshalJSON(b []byte) error {
+	j := &ieJSON{}
+	if err := json.Unmarshal(b, j); err != nil {
+		return err
+	}
+
+	*i = IE{Type: j.Type}
+	if i.IsVendorSpecific() {
+		i.EnterpriseID = j.EnterpriseID
+	}
+
+	switch {
+	case i.IsGrouped():
+		g := newGroupedIE(i.Type, i.EnterpriseID, j.ChildIEs...)
+		if g == nil {
+			return ErrMalformed
+		}
+		*i = *g
+		return nil
+	case j.Value != nil:
+		c, ok := valueCodecs[i.Type]
+		if !ok {
+			return &InvalidTypeError{Type: i.Type}
+		}
+		p, err := c.build(j.Value)
+		if err != nil {
+			return err
+		}
+		i.Payload = p
+	default:
+		p, err := hex.DecodeString(j.Payload)
+		if err != nil {
+			return err
+		}
+		i.Payload = p
+	}
+
+	i.SetLength()
+	return nil
+}
+
+// valueCodec decodes the payload of a non-grouped IE into its value, and
+// builds the payload from the value in JSON.
+type valueCodec struct {
+	value func(i *IE) (any, error)
+	build func(b []byte) ([]byte, error)
+}
+
+// marshal returns the value of i in JSON, or nil if the value cannot be
+// decoded or encoded back into the same payload.
+func (c valueCodec) marshal(i *IE) json.RawMessage {
+	v, err := c.value(i)
+	if err != nil {
+		return nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+
+	p, err := c.build(b)
+	if err != nil || !bytes.Equal(p, i.Payload) {
+		return nil
+	}
+	return b
+}
+
+// valueOf returns the valueCodec of the IEs that have an accessor and a
+// constructor which take the same type of value.
+func valueOf[T any](get func(*IE) (T, error), build func(T) *IE) valueCodec {
+	return valueCodec{
+		value: func(i *IE) (any, error) {
+			return get(i)
+		},
+		build: func(b []byte) ([]byte, error) {
+			var v T
+			if err := json.Unmarshal(b, &v); err != nil {
+				return nil, err
+			}
+
+			i := build(v)
+			if i == nil {
+				return nil, ErrMalformed
+			}
+			return i.Payload, nil
+		},
+	}
+}
+
+// fieldsOf returns the valueCodec of the IEs that have the *XxxFields struct.
+func fieldsOf[T any, F interface {
+	*T
+	UnmarshalBinary(b []byte) error
+	Marshal() ([]byte, error)
+}]() valueCodec {
+	return valueCodec{
+		value: func(i *IE) (any, error) {
+			f := F(new(T))
+			if err := f.UnmarshalBinary(i.Payload); err != nil {
+				return nil, err
+			}
+			return f, nil
+		},
+		build: func(b []byte) ([]byte, error) {
+			f := F(new(T))
+			if err := json.Unmarshal(b, f); err != nil {
+				return nil, err
+			}
+			return f.Marshal()
+		},
+	}
+}
+
+// uint8Of returns the valueCodec of the IEs whose value is a single octet,
+// typically consisting of flags.
+func uint8Of(itype IEType) valueCodec {
+	return valueOf((*IE).ValueAsUint8, func(v uint8) *IE {
+		return newUint8ValIE(itype, v)
+	})
+}
+
+// timeOf returns the valueCodec of the IEs whose value is a timestamp.
+// The time is represented in UTC.
+func timeOf(get func(*IE) (time.Time, error), build func(time.Time) *IE) valueCodec {
+	return valueOf(func(i *IE) (time.Time, error) {
+		t, err := get(i)
+		return t.UTC(), err
+	}, build)
+}
+
+// durationOf returns the valueCodec of the IEs whose value is a duration.
+// The duration is represented in the format of time.Duration.String().
+func durationOf(get func(*IE) (time.Duration, error), build func(time.Duration) *IE) valueCodec {
+	return valueOf(func(i *IE) (jsonDuration, error) {
+		d, err := get(i)
+		return jsonDuration(d), err
+	}, func(d jsonDuration) *IE {
+		return build(time.Duration(d))
+	})
+}
+
+// ipOf returns the valueCodec of the IEs whose value is an IP address.
+func ipOf(get func(*IE) (net.IP, error), build func(string) *IE) valueCodec {
+	return valueOf(func(i *IE) (string, error) {
+		ip, err := get(i)
+		return ip.String(), err
+	}, build)
+}
+
+// jsonDuration is time.Duration represented in a human-readable format in JSON.
+type jsonDuration time.Duration
+
+// String returns the duration in the format of time.Duration.String().
+func (d jsonDuration) String() string {
+	return time.Duration(d).String()
+}
+
+// MarshalText returns the duration in the format of time.Duration.String().
+func (d jsonDuration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the duration with time.ParseDuration.
+func (d *jsonDuration) UnmarshalText(b []byte) error {
+	v, err := time.ParseDuration(string(b))
+	if err != nil {
+		return err
+	}
+
+	*d = jsonDuration(v)
+	return nil
+}
+
+// valueCodecs is the map of the types of non-grouped IEs to their valueCodec.
+// The IEs not in this map are represented with their payload in hex.
+var valueCodecs = map[IEType]valueCodec{
+	Cause:                             valueOf((*IE).Cause, NewCause),
+	SourceInterface:                   valueOf((*IE).SourceInterface, NewSourceInterface),
+	FTEID:                             fieldsOf[FTEIDFields](),
+	NetworkInstance:                   valueOf((*IE).NetworkInstance, NewNetworkInstance),
+	SDFFilter:                         fieldsOf[SDFFilterFields](),
+	ApplicationID:                     valueOf((*IE).ApplicationID, NewApplicationID),
+	GateStatus:                        uint8Of(GateStatus),
+	MBR:                               fieldsOf[MBRFields](),
+	GBR:                               fieldsOf[GBRFields](),
+	QERCorrelationID:                  valueOf((*IE).QERCorrelationID, NewQERCorrelationID),
+	Precedence:                        valueOf((*IE).Precedence, NewPrecedence),
+	TransportLevelMarking:             valueOf((*IE).TransportLevelMarking, NewTransportLevelMarking),
+	VolumeThreshold:                   fieldsOf[VolumeThresholdFields](),
+	TimeThreshold:                     durationOf((*IE).TimeThreshold, NewTimeThreshold),
+	MonitoringTime:                    timeOf((*IE).MonitoringTime, NewMonitoringTime),
+	SubsequentVolumeThreshold:         fieldsOf[SubsequentVolumeThresholdFields](),
+	SubsequentTimeThreshold:           durationOf((*IE).SubsequentTimeThreshold, NewSubsequentTimeThreshold),
+	InactivityDetectionTime:           valueOf((*IE).InactivityDetectionTime, NewInactivityDetectionTime),
+	RedirectInformation:               fieldsOf[RedirectInformationFields](),
+	ReportType:                        uint8Of(ReportType),
+	OffendingIE:                       valueOf((*IE).OffendingIE, NewOffendingIE),
+	DestinationInterface:              valueOf((*IE).DestinationInterface, NewDestinationInterface),
+	ApplyAction:                       fieldsOf[ApplyActionFields](),
+	DownlinkDataNotificationDelay:     durationOf((*IE).DownlinkDataNotificationDelay, NewDownlinkDataNotificationDelay),
+	DLBufferingDuration:               durationOf((*IE).DLBufferingDuration, NewDLBufferingDuration),
+	DLBufferingSuggestedPacketCount:   valueOf((*IE).DLBufferingSuggestedPacketCount, NewDLBufferingSuggestedPacketCount),
+	PFCPSMReqFlags:                    valueOf((*IE).PFCPSMReqFlags, NewPFCPSMReqFlags),
+	PFCPSRRspFlags:                    valueOf((*IE).PFCPSRRspFlags, NewPFCPSRRspFlags),
+	SequenceNumber:                    valueOf((*IE).SequenceNumber, NewSequenceNumber),
+	Metric:                            valueOf((*IE).Metric, NewMetric),
+	Timer:                             durationOf((*IE).Timer, NewTimer),
+	PDRID:                             valueOf((*IE).PDRID, NewPDRID),
+	FSEID:                             fieldsOf[FSEIDFields](),
+	PFDContents:                       fieldsOf[PFDContentsFields](),
+	MeasurementMethod:                 uint8Of(MeasurementMethod),
+	MeasurementPeriod:                 durationOf((*IE).MeasurementPeriod, NewMeasurementPeriod),
+	VolumeMeasurement:                 fieldsOf[VolumeMeasurementFields](),
+	DurationMeasurement:               durationOf((*IE).DurationMeasurement, NewDurationMeasurement),
+	TimeOfFirstPacket:                 timeOf((*IE).TimeOfFirstPacket, NewTimeOfFirstPacket),
+	TimeOfLastPacket:                  timeOf((*IE).TimeOfLastPacket, NewTimeOfLastPacket),
+	QuotaHoldingTime:                  durationOf((*IE).QuotaHoldingTime, NewQuotaHoldingTime),
+	DroppedDLTrafficThreshold:         fieldsOf[DroppedDLTrafficThresholdFields](),
+	VolumeQuota:                       fieldsOf[VolumeQuotaFields](),
+	TimeQuota:                         durationOf((*IE).TimeQuota, NewTimeQuota),
+	StartTime:                         timeOf((*IE).StartTime, NewStartTime),
+	EndTime:                           timeOf((*IE).EndTime, NewEndTime),
+	URRID:                             valueOf((*IE).URRID, NewURRID),
+	LinkedURRID:                       valueOf((*IE).LinkedURRID, NewLinkedURRID),
+	OuterHeaderCreation:               fieldsOf[OuterHeaderCreationFields](),
+	BARID:                             valueOf((*IE).BARID, NewBARID),
+	UsageInformation:                  uint8Of(UsageInformation),
+	ApplicationInstanceID:             valueOf((*IE).ApplicationInstanceID, NewApplicationInstanceID),
+	UEIPAddress:                       fieldsOf[UEIPAddressFields](),
+	PacketRate:                        fieldsOf[PacketRateFields](),
+	RecoveryTimeStamp:                 timeOf((*IE).RecoveryTimeStamp, NewRecoveryTimeStamp),
+	DLFlowLevelMarking:                fieldsOf[DLFlowLevelMarkingFields](),
+	HeaderEnrichment:                  fieldsOf[HeaderEnrichmentFields](),
+	MeasurementInformation:            valueOf((*IE).MeasurementInformation, NewMeasurementInformation),
+	NodeReportType:                    valueOf((*IE).NodeReportType, NewNodeReportType),
+	RemoteGTPUPeer:                    fieldsOf[RemoteGTPUPeerFields](),
+	URSEQN:                            valueOf((*IE).URSEQN, NewURSEQN),
+	ActivatePredefinedRules:           valueOf((*IE).ActivatePredefinedRules, NewActivatePredefinedRules),
+	DeactivatePredefinedRules:         valueOf((*IE).DeactivatePredefinedRules, NewDeactivatePredefinedRules),
+	FARID:                             valueOf((*IE).FARID, NewFARID),
+	QERID:                             valueOf((*IE).QERID, NewQERID),
+	OCIFlags:                          valueOf((*IE).OCIFlags, NewOCIFlags),
+	PFCPAssociationReleaseRequest:     uint8Of(PFCPAssociationReleaseRequest),
+	GracefulReleasePeriod:             durationOf((*IE).GracefulReleasePeriod, NewGracefulReleasePeriod),
+	PDNType:                           valueOf((*IE).PDNType, NewPDNType),
+	UserPlaneIPResourceInformation:    fieldsOf[UserPlaneIPResourceInformationFields](),
+	UserPlaneInactivityTimer:          durationOf((*IE).UserPlaneInactivityTimer, NewUserPlaneInactivityTimer),
+	AggregatedURRID:                   valueOf((*IE).AggregatedURRID, NewAggregatedURRID),
+	SubsequentVolumeQuota:             fieldsOf[SubsequentVolumeQuotaFields](),
+	SubsequentTimeQuota:               durationOf((*IE).SubsequentTimeQuota, NewSubsequentTimeQuota),
+	RQI:                               valueOf((*IE).RQI, NewRQI),
+	QFI:                               valueOf((*IE).QFI, NewQFI),
+	QueryURRReference:                 valueOf((*IE).QueryURRReference, NewQueryURRReference),
+	AdditionalUsageReportsInformation: valueOf((*IE).AdditionalUsageReportsInformation, NewAdditionalUsageReportsInformation),
+	TrafficEndpointID:                 valueOf((*IE).TrafficEndpointID, NewTrafficEndpointID),
+	MACAddress:                        fieldsOf[MACAddressFields](),
+	CTAG:                              fieldsOf[CTAGFields](),
+	STAG:                              fieldsOf[STAGFields](),
+	Ethertype:                         valueOf((*IE).Ethertype, NewEthertype),
+	Proxying:                          uint8Of(Proxying),
+	EthernetFilterID:                  valueOf((*IE).EthernetFilterID, NewEthernetFilterID),
+	EthernetFilterProperties:          valueOf((*IE).EthernetFilterProperties, NewEthernetFilterProperties),
+	SuggestedBufferingPacketsCount:    valueOf((*IE).SuggestedBufferingPacketsCount, NewSuggestedBufferingPacketsCount),
+	UserID:                            fieldsOf[UserIDFields](),
+	EthernetPDUSessionInformation:     valueOf((*IE).EthernetPDUSessionInformation, NewEthernetPDUSessionInformation),
+	MACAddressesDetected:              fieldsOf[MACAddressesDetectedFields](),
+	MACAddressesRemoved:               fieldsOf[MACAddressesRemovedFields](),
+	EthernetInactivityTimer:           durationOf((*IE).EthernetInactivityTimer, NewEthernetInactivityTimer),
+	EventQuota:                        valueOf((*IE).EventQuota, NewEventQuota),
+	EventThreshold:                    valueOf((*IE).EventThreshold, NewEventThreshold),
+	SubsequentEventQuota:              valueOf((*IE).SubsequentEventQuota, NewSubsequentEventQuota),
+	SubsequentEventThreshold:          valueOf((*IE).SubsequentEventThreshold, NewSubsequentEventThreshold),
+	TraceInformation:                  fieldsOf[TraceInformationFields](),
+	FramedRoute:                       valueOf((*IE).FramedRoute, NewFramedRoute),
+	FramedRouting:                     valueOf((*IE).FramedRouting, NewFramedRouting),
+	FramedIPv6Route:                   valueOf((*IE).FramedIPv6Route, NewFramedIPv6Route),
+	EventTimeStamp:                    timeOf((*IE).EventTimeStamp, NewEventTimeStamp),
+	AveragingWindow:                   valueOf((*IE).AveragingWindow, NewAveragingWindow),
+	PagingPolicyIndicator:             valueOf((*IE).PagingPolicyIndicator, NewPagingPolicyIndicator),
+	APNDNN:                            valueOf((*IE).APNDNN, NewAPNDNN),
+	TGPPInterfaceType:                 valueOf((*IE).TGPPInterfaceType, NewTGPPInterfaceType),
+	PFCPSRReqFlags:                    valueOf((*IE).PFCPSRReqFlags, NewPFCPSRReqFlags),
+	PFCPAUReqFlags:                    valueOf((*IE).PFCPAUReqFlags, NewPFCPAUReqFlags),
+	ActivationTime:                    timeOf((*IE).ActivationTime, NewActivationTime),
+	DeactivationTime:                  timeOf((*IE).DeactivationTime, NewDeactivationTime),
+	MARID:                             valueOf((*IE).MARID, NewMARID),
+	SteeringFunctionality:             valueOf((*IE).SteeringFunctionality, NewSteeringFunctionality),
+	SteeringMode:                      valueOf((*IE).SteeringMode, NewSteeringMode),
+	Weight:                            valueOf((*IE).Weight, NewWeight),
+	Priority:                          valueOf((*IE).Priority, NewPriority),
+	AlternativeSMFIPAddress:           fieldsOf[AlternativeSMFIPAddressFields](),
+	PacketReplicationAndDetectionCarryOnInformation: valueOf((*IE).PacketReplicationAndDetectionCarryOnInformation, NewPacketReplicationAndDetectionCarryOnInformation),
+	SMFSetID:                                valueOf((*IE).SMFSetID, NewSMFSetID),
+	QuotaValidityTime:                       durationOf((*IE).QuotaValidityTime, NewQuotaValidityTime),
+	NumberOfReports:                         valueOf((*IE).NumberOfReports, NewNumberOfReports),
+	PFCPASRspFlags:                          valueOf((*IE).PFCPASRspFlags, NewPFCPASRspFlags),
+	CPPFCPEntityIPAddress:                   fieldsOf[CPPFCPEntityIPAddressFields](),
+	PFCPSEReqFlags:                          valueOf((*IE).PFCPSEReqFlags, NewPFCPSEReqFlags),
+	IPMulticastAddress:                      fieldsOf[IPMulticastAddressFields](),
+	SourceIPAddress:                         fieldsOf[SourceIPAddressFields](),
+	PacketRateStatus:                        fieldsOf[PacketRateStatusFields](),
+	CreateBridgeInfoForTSC:                  valueOf((*IE).CreateBridgeInfoForTSC, NewCreateBridgeInfoForTSC),
+	DSTTPortNumber:                          valueOf((*IE).DSTTPortNumber, NewDSTTPortNumber),
+	NWTTPortNumber:                          valueOf((*IE).NWTTPortNumber, NewNWTTPortNumber),
+	PortManagementInformationContainer:      valueOf((*IE).PortManagementInformationContainer, NewPortManagementInformationContainer),
+	RequestedClockDriftInformation:          uint8Of(RequestedClockDriftInformation),
+	TSNTimeDomainNumber:                     valueOf((*IE).TSNTimeDomainNumber, NewTSNTimeDomainNumber),
+	TimeOffsetThreshold:                     durationOf((*IE).TimeOffsetThreshold, NewTimeOffsetThreshold),
+	CumulativeRateRatioThreshold:            valueOf((*IE).CumulativeRateRatioThreshold, NewCumulativeRateRatioThreshold),
+	TimeOffsetMeasurement:                   durationOf((*IE).TimeOffsetMeasurement, NewTimeOffsetMeasurement),
+	CumulativeRateRatioMeasurement:          valueOf((*IE).CumulativeRateRatioMeasurement, NewCumulativeRateRatioMeasurement),
+	SRRID:                                   valueOf((*IE).SRRID, NewSRRID),
+	RequestedAccessAvailabilityInformation:  valueOf((*IE).RequestedAccessAvailabilityInformation, NewRequestedAccessAvailabilityInformation),
+	AccessAvailabilityInformation:           uint8Of(AccessAvailabilityInformation),
+	MPTCPControlInformation:                 valueOf((*IE).MPTCPControlInformation, NewMPTCPControlInformation),
+	ATSSSLLControlInformation:               valueOf((*IE).ATSSSLLControlInformation, NewATSSSLLControlInformation),
+	PMFControlInformation:                   valueOf((*IE).PMFControlInformation, NewPMFControlInformation),
+	MPTCPAddressInformation:                 fieldsOf[MPTCPAddressInformationFields](),
+	UELinkSpecificIPAddress:                 fieldsOf[UELinkSpecificIPAddressFields](),
+	PMFAddressInformation:                   fieldsOf[PMFAddressInformationFields](),
+	ATSSSLLInformation:                      valueOf((*IE).ATSSSLLInformation, NewATSSSLLInformation),
+	DataNetworkAccessIdentifier:             valueOf((*IE).DataNetworkAccessIdentifier, NewDataNetworkAccessIdentifier),
+	AveragePacketDelay:                      durationOf((*IE).AveragePacketDelay, NewAveragePacketDelay),
+	MinimumPacketDelay:                      durationOf((*IE).MinimumPacketDelay, NewMinimumPacketDelay),
+	MaximumPacketDelay:                      durationOf((*IE).MaximumPacketDelay, NewMaximumPacketDelay),
+	QoSReportTrigger:                        uint8Of(QoSReportTrigger),
+	GTPUPathInterfaceType:                   uint8Of(GTPUPathInterfaceType),
+	RequestedQoSMonitoring:                  uint8Of(RequestedQoSMonitoring),
+	ReportingFrequency:                      uint8Of(ReportingFrequency),
+	PacketDelayThresholds:                   fieldsOf[PacketDelayThresholdsFields](),
+	MinimumWaitTime:                         durationOf((*IE).MinimumWaitTime, NewMinimumWaitTime),
+	QoSMonitoringMeasurement:                fieldsOf[QoSMonitoringMeasurementFields](),
+	MTEDTControlInformation:                 valueOf((*IE).MTEDTControlInformation, NewMTEDTControlInformation),
+	DLDataPacketsSize:                       valueOf((*IE).DLDataPacketsSize, NewDLDataPacketsSize),
+	QERControlIndications:                   uint8Of(QERControlIndications),
+	IPVersion:                               uint8Of(IPVersion),
+	PFCPASReqFlags:                          valueOf((*IE).PFCPASReqFlags, NewPFCPASReqFlags),
+	DataStatus:                              valueOf((*IE).DataStatus, NewDataStatus),
+	RDSConfigurationInformation:             valueOf((*IE).RDSConfigurationInformation, NewRDSConfigurationInformation),
+	MPTCPApplicableIndication:               valueOf((*IE).MPTCPApplicableIndication, NewMPTCPApplicableIndication),
+	BridgeManagementInformationContainer:    valueOf((*IE).BridgeManagementInformationContainer, NewBridgeManagementInformationContainer),
+	NumberOfUEIPAddresses:                   fieldsOf[NumberOfUEIPAddressesFields](),
+	ValidityTimer:                           durationOf((*IE).ValidityTimer, NewValidityTimer),
+	OffendingIEInformation:                  fieldsOf[OffendingIEInformationFields](),
+	RATType:                                 valueOf((*IE).RATType, NewRATType),
+	L2TPUserAuthentication:                  fieldsOf[L2TPUserAuthenticationFields](),
+	TunnelPreference:                        valueOf((*IE).TunnelPreference, NewTunnelPreference),
+	CallingNumber:                           valueOf((*IE).CallingNumber, NewCallingNumber),
+	CalledNumber:                            valueOf((*IE).CalledNumber, NewCalledNumber),
+	L2TPSessionIndications:                  valueOf((*IE).L2TPSessionIndications, NewL2TPSessionIndications),
+	MaximumReceiveUnit:                      valueOf((*IE).MaximumReceiveUnit, NewMaximumReceiveUnit),
+	Thresholds:                              fieldsOf[ThresholdsFields](),
+	SteeringModeIndicator:                   fieldsOf[SteeringModeIndicatorFields](),
+	CPIPAddress:                             fieldsOf[CPIPAddressFields](),
+	IPAddressAndPortNumberReplacement:       fieldsOf[IPAddressAndPortNumberReplacementFields](),
+	DNSQueryFilter:                          fieldsOf[DNSQueryFilterFields](),
+	EventNotificationURI:                    valueOf((*IE).EventNotificationURI, NewEventNotificationURI),
+	NotificationCorrelationID:               valueOf((*IE).NotificationCorrelationID, NewNotificationCorrelationID),
+	ReportingFlags:                          uint8Of(ReportingFlags),
+	PredefinedRulesName:                     valueOf((*IE).PredefinedRulesName, NewPredefinedRulesName),
+	MBSSessionIdentifier:                    fieldsOf[MBSSessionIdentifierFields](),
+	MulticastTransportInformation:           fieldsOf[MulticastTransportInformationFields](),
+	MBSN4mbReqFlags:                         valueOf((*IE).MBSN4mbReqFlags, NewMBSN4mbReqFlags),
+	LocalIngressTunnel:                      fieldsOf[LocalIngressTunnelFields](),
+	MBSUnicastParametersID:                  valueOf((*IE).MBSUnicastParametersID, NewMBSUnicastParametersID),
+	MBSN4RespFlags:                          valueOf((*IE).MBSN4RespFlags, NewMBSN4RespFlags),
+	TunnelPassword:                          valueOf((*IE).TunnelPassword, NewTunnelPassword),
+	AreaSessionID:                           valueOf((*IE).AreaSessionID, NewAreaSessionID),
+	DSCPToPPIMappingInformation:             fieldsOf[DSCPToPPIMappingInformationFields](),
+	PFCPSDRspFlags:                          valueOf((*IE).PFCPSDRspFlags, NewPFCPSDRspFlags),
+	QERIndications:                          valueOf((*IE).QERIndications, NewQERIndications),
+	VendorSpecificNodeReportType:            fieldsOf[VendorSpecificNodeReportTypeFields](),
+	ConfiguredTimeDomain:                    valueOf((*IE).ConfiguredTimeDomain, NewConfiguredTimeDomain),
+	TrafficParameterThreshold:               fieldsOf[TrafficParameterThresholdFields](),
+	DLPeriodicity:                           valueOf((*IE).DLPeriodicity, NewDLPeriodicity),
+	N6JitterMeasurement:                     fieldsOf[N6JitterMeasurementFields](),
+	TrafficParameterMeasurementIndication:   valueOf((*IE).TrafficParameterMeasurementIndication, NewTrafficParameterMeasurementIndication),
+	ULPeriodicity:                           valueOf((*IE).ULPeriodicity, NewULPeriodicity),
+	MPQUICControlInformation:                valueOf((*IE).MPQUICControlInformation, NewMPQUICControlInformation),
+	MPQUICAddressInformation:                fieldsOf[MPQUICAddressInformationFields](),
+	TransportMode:                           valueOf((*IE).TransportMode, NewTransportMode),
+	ReportingSuggestionInfo:                 fieldsOf[ReportingSuggestionInfoFields](),
+	MeasurementIndication:                   valueOf((*IE).MeasurementIndication, NewMeasurementIndication),
+	MediaTransportProtocol:                  valueOf((*IE).MediaTransportProtocol, NewMediaTransportProtocol),
+	RTPHeaderExtensionType:                  valueOf((*IE).RTPHeaderExtensionType, NewRTPHeaderExtensionType),
+	RTPHeaderExtensionID:                    valueOf((*IE).RTPHeaderExtensionID, NewRTPHeaderExtensionID),
+	RTPPayloadType:                          valueOf((*IE).RTPPayloadType, NewRTPPayloadType),
+	RTPPayloadFormat:                        valueOf((*IE).RTPPayloadFormat, NewRTPPayloadFormat),
+	ExtendedDLBufferingNotificationPolicy:   valueOf((*IE).ExtendedDLBufferingNotificationPolicy, NewExtendedDLBufferingNotificationPolicy),
+	MTSDTControlInformation:                 valueOf((*IE).MTSDTControlInformation, NewMTSDTControlInformation),
+	ReportingThresholds:                     fieldsOf[ReportingThresholdsFields](),
+	RTPHeaderExtensionAdditionalInformation: valueOf((*IE).RTPHeaderExtensionAdditionalInformation, NewRTPHeaderExtensionAdditionalInformation),
+	MappedN6IPAddress:                       fieldsOf[MappedN6IPAddressFields](),
+	N6RoutingInformation:                    fieldsOf[N6RoutingInformationFields](),
+	URI:                                     valueOf((*IE).URI, NewURI),
+	NodeID:                                  valueOf((*IE).NodeID, NewNodeIDHeuristic),
+	LNSAddress:                              ipOf((*IE).LNSAddress, NewLNSAddress),
+	DNSServerAddress:                        ipOf((*IE).DNSServerAddress, NewDNSServerAddress),
+	NBNSServerAddress:                       ipOf((*IE).NBNSServerAddress, NewNBNSServerAddress),
+}
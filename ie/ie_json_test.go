@@ -0,0 +1,113 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func TestIEJSON(t *testing.T) {
+	cases := []struct {
+		description string
+		structured  *ie.IE
+		json        string
+	}{
+		{
+			"Grouped",
+			ie.NewCreatePDR(
+				ie.NewPDRID(0xffff),
+				ie.NewPDI(
+					ie.NewSourceInterface(ie.SrcInterfaceCore),
+					ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
+				),
+			),
+			`{"type":"CreatePDR","childIEs":[` +
+				`{"type":"PDRID","value":65535},` +
+				`{"type":"PDI","childIEs":[` +
+				`{"type":"SourceInterface","value":1},` +
+				`{"type":"FTEID","value":{"Flags":1,"TEID":286331153,"IPv4Address":"127.0.0.1","IPv6Address":"","ChooseID":0}}` +
+				`]}]}`,
+		}, {
+			"Fields",
+			ie.NewUEIPAddress(0x02, "127.0.0.1", "", 0, 0),
+			`{"type":"UEIPAddress","value":{"Flags":2,"IPv4Address":"127.0.0.1","IPv6Address":"","IPv6PrefixDelegationBits":0,"IPv6PrefixLength":0}}`,
+		}, {
+			"Time",
+			ie.NewRecoveryTimeStamp(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			`{"type":"RecoveryTimeStamp","value":"2019-01-01T00:00:00Z"}`,
+		}, {
+			"Duration",
+			ie.NewTimer(20 * time.Hour),
+			`{"type":"Timer","value":"20h0m0s"}`,
+		}, {
+			"NodeID",
+			ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+			`{"type":"NodeID","value":"go-pfcp.epc.3gppnetwork.org"}`,
+		}, {
+			"IEType",
+			ie.NewOffendingIE(ie.NodeID),
+			`{"type":"OffendingIE","value":"NodeID"}`,
+		}, {
+			"NoRepresentation",
+			ie.NewFlowInformation(ie.FlowDirectionDownlink, "go-pfcp"),
+			`{"type":"FlowInformation","payload":"010007676f2d70666370"}`,
+		}, {
+			"NotRestorable",
+			ie.NewApplyAction(0x02),
+			`{"type":"ApplyAction","payload":"02"}`,
+		}, {
+			"VendorSpecific",
+			ie.NewVendorSpecificIE(32770, 10415, []byte{0xde, 0xad, 0xbe, 0xef}),
+			`{"type":"IEType(32770)","enterpriseID":10415,"payload":"deadbeef"}`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run("marshal/"+c.description, func(t *testing.T) {
+			got, err := json.Marshal(c.structured)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(string(got), c.json); diff != "" {
+				t.Error(diff)
+			}
+		})
+
+		t.Run("unmarshal/"+c.description, func(t *testing.T) {
+			i := &ie.IE{}
+			if err := json.Unmarshal([]byte(c.json), i); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := i.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := c.structured.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(got, want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+
+	t.Run("unmarshal/InvalidType", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`{"type":"NoSuchIE","payload":"00"}`), &ie.IE{})
+		if !errors.Is(err, ie.ErrInvalidType) {
+			t.Errorf("got %v, want %v", err, ie.ErrInvalidType)
+		}
+	})
+}
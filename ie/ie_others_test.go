@@ -5,6 +5,7 @@
 package ie_test
 
 import (
+	"net"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -25,6 +26,52 @@ func TestOffendingIE(t *testing.T) {
 	}
 }
 
+func TestFQCSIDIEs(t *testing.T) {
+	cases := []struct {
+		description string
+		structured  *ie.IE
+		nodeAddress []byte
+		csids       []uint16
+	}{
+		{
+			description: "IPv4/SingleCSID",
+			structured:  ie.NewFQCSID("127.0.0.1", 1),
+			nodeAddress: []byte{0x7f, 0x00, 0x00, 0x01},
+			csids:       []uint16{1},
+		}, {
+			description: "IPv4/MultiCSIDs",
+			structured:  ie.NewFQCSID("127.0.0.1", 1, 2),
+			nodeAddress: []byte{0x7f, 0x00, 0x00, 0x01},
+			csids:       []uint16{1, 2},
+		}, {
+			description: "IPv6/SingleCSID",
+			structured:  ie.NewFQCSID("2001::1", 1),
+			nodeAddress: []byte{0x20, 0x01, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x01},
+			csids:       []uint16{1},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			addr, err := c.structured.NodeAddress()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(addr, c.nodeAddress); diff != "" {
+				t.Error(diff)
+			}
+
+			csids, err := c.structured.CSIDs()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(csids, c.csids); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
 func TestApplyActionIEs(t *testing.T) {
 	cases := []struct {
 		description string
@@ -213,3 +260,368 @@ func TestDSCPToPPIMappingInformationIEs(t *testing.T) {
 		})
 	}
 }
+
+func TestIPAddressAndPortNumberReplacementIEs(t *testing.T) {
+	cases := []struct {
+		description string
+		structured  *ie.IE
+		decoded     *ie.IPAddressAndPortNumberReplacementFields
+	}{
+		{
+			description: "Destination",
+			structured:  ie.NewIPAddressAndPortNumberReplacement(net.ParseIP("127.0.0.1"), nil, 8805, nil, nil, 0),
+			decoded: &ie.IPAddressAndPortNumberReplacementFields{
+				Flags:                  0x05,
+				DestinationIPv4Address: net.IP{0x7f, 0x00, 0x00, 0x01},
+				DestinationPortNumber:  8805,
+			},
+		}, {
+			description: "Source",
+			structured:  ie.NewIPAddressAndPortNumberReplacement(nil, nil, 0, nil, net.ParseIP("2001::2"), 2152),
+			decoded: &ie.IPAddressAndPortNumberReplacementFields{
+				Flags:             0x30,
+				SourceIPv6Address: net.ParseIP("2001::2"),
+				SourcePortNumber:  2152,
+			},
+		}, {
+			description: "ForwardingParameters",
+			structured: ie.NewForwardingParameters(
+				ie.NewDestinationInterface(ie.DstInterfaceCore),
+				ie.NewIPAddressAndPortNumberReplacement(net.ParseIP("127.0.0.1"), nil, 8805, nil, nil, 0),
+			),
+			decoded: &ie.IPAddressAndPortNumberReplacementFields{
+				Flags:                  0x05,
+				DestinationIPv4Address: net.IP{0x7f, 0x00, 0x00, 0x01},
+				DestinationPortNumber:  8805,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			got, err := c.structured.IPAddressAndPortNumberReplacement()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(got, c.decoded); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestDNSQueryFilterIEs(t *testing.T) {
+	cases := []struct {
+		description string
+		structured  *ie.IE
+		decoded     *ie.DNSQueryFilterFields
+	}{
+		{
+			description: "DNSQueryFilter",
+			structured:  ie.NewDNSQueryFilter("*.go-pfcp"),
+			decoded:     &ie.DNSQueryFilterFields{PatternLength: 9, Pattern: "*.go-pfcp"},
+		}, {
+			description: "PDI",
+			structured: ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewDNSQueryFilter("*.go-pfcp"),
+			),
+			decoded: &ie.DNSQueryFilterFields{PatternLength: 9, Pattern: "*.go-pfcp"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			got, err := c.structured.DNSQueryFilter()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(got, c.decoded); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+
+	t.Run("EditedPattern", func(t *testing.T) {
+		// PatternLength is derived from Pattern when marshalling.
+		f := &ie.DNSQueryFilterFields{PatternLength: 9, Pattern: "*.example.com"}
+		b, err := f.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ie.ParseDNSQueryFilterFields(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := &ie.DNSQueryFilterFields{PatternLength: 13, Pattern: "*.example.com"}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
+
+func TestVendorSpecificNodeReportTypeIE(t *testing.T) {
+	structured := ie.NewVendorSpecificNodeReportType(10415, 0x01)
+	decoded := &ie.VendorSpecificNodeReportTypeFields{EnterpriseID: 10415, Flags: 0x01}
+
+	got, err := structured.VendorSpecificNodeReportType()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(got, decoded); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestPFCPSDRspFlagsIE(t *testing.T) {
+	if !ie.NewPFCPSDRspFlags(0x01).HasPURU() {
+		t.Error("PURU is not set")
+	}
+	if ie.NewPFCPSDRspFlags(0x00).HasPURU() {
+		t.Error("PURU is set")
+	}
+}
+
+func TestPortManagementInformationForTSCIE(t *testing.T) {
+	structured := ie.NewPortManagementInformationForTSCWithinSessionModificationRequest(
+		ie.NewPortManagementInformationContainer("go-pfcp"),
+	)
+	decoded := &ie.PortManagementInformationForTSCFields{PortManagementInformationContainer: "go-pfcp"}
+
+	got, err := structured.PortManagementInformationForTSC()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(got, decoded); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestProtocolDescriptionIE(t *testing.T) {
+	structured := ie.NewProtocolDescription(
+		ie.NewMediaTransportProtocol(0x01),
+		ie.NewRTPHeaderExtensionInformation(
+			ie.NewRTPHeaderExtensionType(0x01),
+			ie.NewRTPHeaderExtensionID(0x02),
+		),
+		ie.NewRTPPayloadInformation(
+			ie.NewRTPPayloadType(96),
+			ie.NewRTPPayloadFormat(0x01),
+		),
+	)
+	decoded := &ie.ProtocolDescriptionFields{
+		MediaTransportProtocol: 0x01,
+		RTPHeaderExtensionInformation: []*ie.RTPHeaderExtensionInformationFields{
+			{RTPHeaderExtensionType: 0x01, RTPHeaderExtensionID: 0x02},
+		},
+		RTPPayloadInformation: []*ie.RTPPayloadInformationFields{
+			{RTPPayloadType: 96, RTPPayloadFormat: 0x01, HasRTPPayloadFormat: true},
+		},
+	}
+
+	got, err := structured.ProtocolDescription()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(got, decoded); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestTrafficParameterMeasurementReportIE(t *testing.T) {
+	structured := ie.NewTrafficParameterMeasurementReport(
+		ie.NewQFI(0x01),
+		ie.NewN6JitterMeasurement(20, -5, 5),
+		ie.NewDLPeriodicity(20),
+	)
+
+	jitter, err := structured.N6JitterMeasurement()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(jitter, ie.NewN6JitterMeasurementFields(20, -5, 5)); diff != "" {
+		t.Error(diff)
+	}
+
+	dl, err := structured.DLPeriodicity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dl != 20 {
+		t.Errorf("got %d want %d", dl, 20)
+	}
+}
+
+func TestN6RoutingInformationIE(t *testing.T) {
+	structured := ie.NewN6RoutingInformation(net.ParseIP("127.0.0.1"), nil, 2152, nil, net.ParseIP("2001::2"), 8805)
+	decoded := &ie.N6RoutingInformationFields{
+		Flags:                  0x35,
+		SourceIPv4Address:      net.IP{0x7f, 0x00, 0x00, 0x01},
+		SourcePortNumber:       2152,
+		DestinationIPv6Address: net.ParseIP("2001::2"),
+		DestinationPortNumber:  8805,
+	}
+
+	got, err := structured.N6RoutingInformation()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(got, decoded); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestApplyActionFieldsMarshal(t *testing.T) {
+	cases := []struct {
+		description string
+		fields      *ie.ApplyActionFields
+		serialized  []byte
+	}{
+		{
+			description: "IPMD",
+			fields:      &ie.ApplyActionFields{Ipmd: true},
+			serialized:  []byte{0x40, 0x00},
+		}, {
+			// DFRT is bit 8 of octet 5, not the bit 7 used by IPMD.
+			description: "DFRT",
+			fields:      &ie.ApplyActionFields{Drft: true},
+			serialized:  []byte{0x80, 0x00},
+		}, {
+			description: "FORW/DFRT/EDRT",
+			fields:      &ie.ApplyActionFields{Forw: true, Drft: true, Edrt: true},
+			serialized:  []byte{0x82, 0x01},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			b, err := c.fields.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(b, c.serialized); diff != "" {
+				t.Error(diff)
+			}
+
+			got, err := ie.New(ie.ApplyAction, b).ApplyAction()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, c.fields); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestUEIPAddressFields(t *testing.T) {
+	t.Run("PrefixDelegationAndLength", func(t *testing.T) {
+		// V6, IPv6D and IP6PL are set: the prefix length follows the prefix
+		// delegation bits.
+		b := []byte{
+			0x49,
+			0x20, 0x01, 0x0d, 0xb8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+			0x10,
+			0x40,
+		}
+		got, err := ie.ParseUEIPAddressFields(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := &ie.UEIPAddressFields{
+			Flags:                    0x49,
+			IPv6Address:              net.ParseIP("2001:db8::1"),
+			IPv6PrefixDelegationBits: 0x10,
+			IPv6PrefixLength:         0x40,
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+
+		s, err := got.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(s, b); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("IPv4AddressIn16Bytes", func(t *testing.T) {
+		// net.ParseIP returns IPv4 addresses in 16-byte form.
+		f := &ie.UEIPAddressFields{Flags: 0x02, IPv4Address: net.ParseIP("10.0.0.1")}
+		b, err := f.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(b, []byte{0x02, 0x0a, 0x00, 0x00, 0x01}); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
+
+func TestIPv4AddressFieldsMarshal(t *testing.T) {
+	// the IPv4 addresses in 16-byte form should be marshalled in 4 bytes.
+	cases := []struct {
+		description string
+		fields      interface{ Marshal() ([]byte, error) }
+		want        *ie.IE
+	}{
+		{
+			description: "OuterHeaderCreation",
+			fields: &ie.OuterHeaderCreationFields{
+				OuterHeaderCreationDescription: 0x0100,
+				TEID:                           0x11111111,
+				IPv4Address:                    net.ParseIP("127.0.0.1"),
+			},
+			want: ie.NewOuterHeaderCreation(0x0100, 0x11111111, "127.0.0.1", "", 0, 0, 0),
+		}, {
+			description: "UserPlaneIPResourceInformation",
+			fields: &ie.UserPlaneIPResourceInformationFields{
+				Flags:       0x01,
+				IPv4Address: net.ParseIP("127.0.0.1"),
+			},
+			want: ie.NewUserPlaneIPResourceInformation(0x01, 0, "127.0.0.1", "", "", 0),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			got, err := c.fields.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, c.want.Payload); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestUserPlaneIPResourceInformationIE(t *testing.T) {
+	// ASSONI and ASSOSI are set: the Source Interface is in the last octet.
+	i := ie.NewUserPlaneIPResourceInformation(0x61, 0, "127.0.0.1", "", "some.instance.example", ie.SrcInterfaceCore)
+
+	got, err := i.UserPlaneIPResourceInformation()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ie.UserPlaneIPResourceInformationFields{
+		Flags:           0x61,
+		IPv4Address:     net.IP{127, 0, 0, 1},
+		NetworkInstance: "some.instance.example",
+		SourceInterface: ie.SrcInterfaceCore,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Error(diff)
+	}
+}
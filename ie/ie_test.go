@@ -5,6 +5,7 @@
 package ie_test
 
 import (
+	"encoding/json"
 	"io"
 	"net"
 	"testing"
@@ -142,6 +143,23 @@ func TestIEs(t *testing.T) {
 				0x00, 0x15, 0x73, 0x6f, 0x6d, 0x65, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
 				0x00, 0x1e, 0x00, 0x02, 0x11, 0x11,
 			},
+		}, {
+			"CreatePDR/ZeroValues",
+			ie.NewCreatePDR(
+				ie.NewPDRID(0x0001),
+				ie.NewPrecedence(0),
+				ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceAccess)),
+				ie.NewFARID(0),
+				ie.NewMARID(0),
+			),
+			[]byte{
+				0x00, 0x01, 0x00, 0x25,
+				0x00, 0x38, 0x00, 0x02, 0x00, 0x01,
+				0x00, 0x1d, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x02, 0x00, 0x05, 0x00, 0x14, 0x00, 0x01, 0x00,
+				0x00, 0x6c, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0xaa, 0x00, 0x02, 0x00, 0x00,
+			},
 		}, {
 			"PDI",
 			ie.NewPDI(
@@ -2753,6 +2771,15 @@ func TestIEs(t *testing.T) {
 				0x00, 0xc9, 0x00, 0x0b,
 				0x00, 0xca, 0x00, 0x07, 0x67, 0x6f, 0x2d, 0x70, 0x66, 0x63, 0x70,
 			},
+		}, {
+			"PortManagementInformationForTSCWithinSessionReportRequest",
+			ie.NewPortManagementInformationForTSCWithinSessionReportRequest(
+				ie.NewPortManagementInformationContainer("go-pfcp"),
+			),
+			[]byte{
+				0x00, 0xc9, 0x00, 0x0b,
+				0x00, 0xca, 0x00, 0x07, 0x67, 0x6f, 0x2d, 0x70, 0x66, 0x63, 0x70,
+			},
 		}, {
 			"PortManagementInformationContainer",
 			ie.NewPortManagementInformationContainer("go-pfcp"),
@@ -3583,6 +3610,192 @@ func TestIEs(t *testing.T) {
 				0x00, 0x15, 0x73, 0x6f, 0x6d, 0x65, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
 				0x00, 0x1e, 0x00, 0x02, 0x11, 0x11,
 			},
+		}, {
+			"IPAddressAndPortNumberReplacement/Destination",
+			ie.NewIPAddressAndPortNumberReplacement(net.ParseIP("127.0.0.1"), nil, 8805, nil, nil, 0),
+			[]byte{0x01, 0x25, 0x00, 0x07, 0x05, 0x7f, 0x00, 0x00, 0x01, 0x22, 0x65},
+		}, {
+			"IPAddressAndPortNumberReplacement/All",
+			ie.NewIPAddressAndPortNumberReplacement(
+				net.ParseIP("127.0.0.1"), net.ParseIP("2001::1"), 8805,
+				net.ParseIP("127.0.0.2"), net.ParseIP("2001::2"), 2152,
+			),
+			[]byte{
+				0x01, 0x25, 0x00, 0x2d, 0x3f,
+				0x7f, 0x00, 0x00, 0x01,
+				0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+				0x22, 0x65,
+				0x7f, 0x00, 0x00, 0x02,
+				0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02,
+				0x08, 0x68,
+			},
+		}, {
+			"DNSQueryFilter",
+			ie.NewDNSQueryFilter("*.go-pfcp"),
+			[]byte{0x01, 0x26, 0x00, 0x0b, 0x00, 0x09, 0x2a, 0x2e, 0x67, 0x6f, 0x2d, 0x70, 0x66, 0x63, 0x70},
+		}, {
+			"PFCPSDRspFlags",
+			ie.NewPFCPSDRspFlags(0x01),
+			[]byte{0x01, 0x3e, 0x00, 0x01, 0x01},
+		}, {
+			"VendorSpecificNodeReportType",
+			ie.NewVendorSpecificNodeReportType(10415, 0x01),
+			[]byte{0x01, 0x40, 0x00, 0x03, 0x28, 0xaf, 0x01},
+		}, {
+			"Metadata",
+			ie.NewMetadata([]byte{0xde, 0xad, 0xbe, 0xef}),
+			[]byte{0x01, 0x42, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef},
+		}, {
+			"TrafficParameterMeasurementControlInformation",
+			ie.NewTrafficParameterMeasurementControlInformation(
+				ie.NewTrafficParameterMeasurementIndication(0x07),
+				ie.NewTrafficParameterThreshold(100),
+			),
+			[]byte{0x01, 0x43, 0x00, 0x0e, 0x01, 0x48, 0x00, 0x01, 0x07, 0x01, 0x45, 0x00, 0x05, 0x01, 0x00, 0x00, 0x00, 0x64},
+		}, {
+			"TrafficParameterMeasurementReport",
+			ie.NewTrafficParameterMeasurementReport(
+				ie.NewQFI(0x01),
+				ie.NewN6JitterMeasurement(20, -5, 5),
+				ie.NewDLPeriodicity(20),
+				ie.NewULPeriodicity(40),
+			),
+			[]byte{0x01, 0x44, 0x00, 0x25, 0x00, 0x7c, 0x00, 0x01, 0x01, 0x01, 0x47, 0x00, 0x0c, 0x00, 0x00, 0x00, 0x14, 0xff, 0xff, 0xff, 0xfb, 0x00, 0x00, 0x00, 0x05, 0x01, 0x46, 0x00, 0x04, 0x00, 0x00, 0x00, 0x14, 0x01, 0x49, 0x00, 0x04, 0x00, 0x00, 0x00, 0x28},
+		}, {
+			"TrafficParameterThreshold",
+			ie.NewTrafficParameterThreshold(100),
+			[]byte{0x01, 0x45, 0x00, 0x05, 0x01, 0x00, 0x00, 0x00, 0x64},
+		}, {
+			"DLPeriodicity",
+			ie.NewDLPeriodicity(20),
+			[]byte{0x01, 0x46, 0x00, 0x04, 0x00, 0x00, 0x00, 0x14},
+		}, {
+			"N6JitterMeasurement",
+			ie.NewN6JitterMeasurement(20, -5, 5),
+			[]byte{0x01, 0x47, 0x00, 0x0c, 0x00, 0x00, 0x00, 0x14, 0xff, 0xff, 0xff, 0xfb, 0x00, 0x00, 0x00, 0x05},
+		}, {
+			"TrafficParameterMeasurementIndication",
+			ie.NewTrafficParameterMeasurementIndication(0x07),
+			[]byte{0x01, 0x48, 0x00, 0x01, 0x07},
+		}, {
+			"ULPeriodicity",
+			ie.NewULPeriodicity(40),
+			[]byte{0x01, 0x49, 0x00, 0x04, 0x00, 0x00, 0x00, 0x28},
+		}, {
+			"MPQUICControlInformation",
+			ie.NewMPQUICControlInformation(0x01),
+			[]byte{0x01, 0x4a, 0x00, 0x01, 0x01},
+		}, {
+			"MPQUICParameters",
+			ie.NewMPQUICParameters(
+				ie.NewMPQUICAddressInformation(8443, net.ParseIP("127.0.0.1"), nil),
+			),
+			[]byte{0x01, 0x4b, 0x00, 0x0b, 0x01, 0x4c, 0x00, 0x07, 0x01, 0x20, 0xfb, 0x7f, 0x00, 0x00, 0x01},
+		}, {
+			"MPQUICAddressInformation",
+			ie.NewMPQUICAddressInformation(8443, net.ParseIP("127.0.0.1"), net.ParseIP("2001::1")),
+			[]byte{0x01, 0x4c, 0x00, 0x17, 0x03, 0x20, 0xfb, 0x7f, 0x00, 0x00, 0x01, 0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+		}, {
+			"TransportMode",
+			ie.NewTransportMode(0x01),
+			[]byte{0x01, 0x4d, 0x00, 0x01, 0x01},
+		}, {
+			"ProtocolDescription",
+			ie.NewProtocolDescription(
+				ie.NewMediaTransportProtocol(0x01),
+				ie.NewRTPHeaderExtensionInformation(
+					ie.NewRTPHeaderExtensionType(0x01),
+					ie.NewRTPHeaderExtensionID(0x02),
+				),
+				ie.NewRTPPayloadInformation(
+					ie.NewRTPPayloadType(96),
+					ie.NewRTPPayloadFormat(0x01),
+				),
+			),
+			[]byte{0x01, 0x4e, 0x00, 0x21, 0x01, 0x53, 0x00, 0x01, 0x01, 0x01, 0x54, 0x00, 0x0a, 0x01, 0x56, 0x00, 0x01, 0x01, 0x01, 0x57, 0x00, 0x01, 0x02, 0x01, 0x55, 0x00, 0x0a, 0x01, 0x58, 0x00, 0x01, 0x60, 0x01, 0x59, 0x00, 0x01, 0x01},
+		}, {
+			"ReportingSuggestionInfo",
+			ie.NewReportingSuggestionInfo(0x02, 60),
+			[]byte{0x01, 0x4f, 0x00, 0x05, 0x02, 0x00, 0x00, 0x00, 0x3c},
+		}, {
+			"TLContainer",
+			ie.NewTLContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+			[]byte{0x01, 0x50, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef},
+		}, {
+			"MeasurementIndication",
+			ie.NewMeasurementIndication(0x01),
+			[]byte{0x01, 0x51, 0x00, 0x01, 0x01},
+		}, {
+			"HPLMNSNSSAI",
+			ie.NewHPLMNSNSSAI(0x01, 0x112233),
+			[]byte{0x01, 0x52, 0x00, 0x04, 0x01, 0x11, 0x22, 0x33},
+		}, {
+			"MediaTransportProtocol",
+			ie.NewMediaTransportProtocol(0x01),
+			[]byte{0x01, 0x53, 0x00, 0x01, 0x01},
+		}, {
+			"RTPHeaderExtensionInformation",
+			ie.NewRTPHeaderExtensionInformation(
+				ie.NewRTPHeaderExtensionType(0x01),
+				ie.NewRTPHeaderExtensionID(0x02),
+				ie.NewRTPHeaderExtensionAdditionalInformation(0x01),
+			),
+			[]byte{0x01, 0x54, 0x00, 0x0f, 0x01, 0x56, 0x00, 0x01, 0x01, 0x01, 0x57, 0x00, 0x01, 0x02, 0x01, 0x5d, 0x00, 0x01, 0x01},
+		}, {
+			"RTPPayloadInformation",
+			ie.NewRTPPayloadInformation(
+				ie.NewRTPPayloadType(96),
+				ie.NewRTPPayloadFormat(0x01),
+			),
+			[]byte{0x01, 0x55, 0x00, 0x0a, 0x01, 0x58, 0x00, 0x01, 0x60, 0x01, 0x59, 0x00, 0x01, 0x01},
+		}, {
+			"RTPHeaderExtensionType",
+			ie.NewRTPHeaderExtensionType(0x01),
+			[]byte{0x01, 0x56, 0x00, 0x01, 0x01},
+		}, {
+			"RTPHeaderExtensionID",
+			ie.NewRTPHeaderExtensionID(0x02),
+			[]byte{0x01, 0x57, 0x00, 0x01, 0x02},
+		}, {
+			"RTPPayloadType",
+			ie.NewRTPPayloadType(96),
+			[]byte{0x01, 0x58, 0x00, 0x01, 0x60},
+		}, {
+			"RTPPayloadFormat",
+			ie.NewRTPPayloadFormat(0x01),
+			[]byte{0x01, 0x59, 0x00, 0x01, 0x01},
+		}, {
+			"ExtendedDLBufferingNotificationPolicy",
+			ie.NewExtendedDLBufferingNotificationPolicy(0x01),
+			[]byte{0x01, 0x5a, 0x00, 0x01, 0x01},
+		}, {
+			"MTSDTControlInformation",
+			ie.NewMTSDTControlInformation(0x01),
+			[]byte{0x01, 0x5b, 0x00, 0x01, 0x01},
+		}, {
+			"ReportingThresholds",
+			ie.NewReportingThresholds(80, 90),
+			[]byte{0x01, 0x5c, 0x00, 0x03, 0x03, 0x50, 0x5a},
+		}, {
+			"RTPHeaderExtensionAdditionalInformation",
+			ie.NewRTPHeaderExtensionAdditionalInformation(0x01),
+			[]byte{0x01, 0x5d, 0x00, 0x01, 0x01},
+		}, {
+			"MappedN6IPAddress",
+			ie.NewMappedN6IPAddress(net.ParseIP("127.0.0.1"), nil),
+			[]byte{0x01, 0x5e, 0x00, 0x05, 0x02, 0x7f, 0x00, 0x00, 0x01},
+		}, {
+			"N6RoutingInformation",
+			ie.NewN6RoutingInformation(net.ParseIP("127.0.0.1"), nil, 2152, net.ParseIP("127.0.0.2"), nil, 8805),
+			[]byte{0x01, 0x5f, 0x00, 0x0d, 0x2d, 0x7f, 0x00, 0x00, 0x01, 0x08, 0x68, 0x7f, 0x00, 0x00, 0x02, 0x22, 0x65},
+		}, {
+			"URI",
+			ie.NewURI("https://go-pfcp"),
+			[]byte{0x01, 0x60, 0x00, 0x0f, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x66, 0x63, 0x70},
+		}, {
+			"UELevelMeasurementsConfiguration",
+			ie.NewUELevelMeasurementsConfiguration([]byte{0x7b, 0x7d}),
+			[]byte{0x01, 0x61, 0x00, 0x02, 0x7b, 0x7d},
 		}, {
 			"VendorSpecific",
 			ie.NewVendorSpecificIE(0xffff, 10415, []byte{0xde, 0xad, 0xbe, 0xef}),
@@ -3613,6 +3826,53 @@ func TestIEs(t *testing.T) {
 				t.Error(diff)
 			}
 		})
+
+		t.Run("json/"+c.description, func(t *testing.T) {
+			j, err := json.Marshal(c.structured)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := &ie.IE{}
+			if err := json.Unmarshal(j, got); err != nil {
+				t.Fatal(err)
+			}
+
+			b, err := got.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(b, c.serialized); diff != "" {
+				t.Errorf("%s\n%s", j, diff)
+			}
+		})
+
+		conv, ok := fromFields[c.structured.Type]
+		if !ok {
+			continue
+		}
+		t.Run("fields/"+c.description, func(t *testing.T) {
+			parsed, err := ie.Parse(c.serialized)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rebuilt, got, want, err := conv(parsed)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(got, want); diff != "" {
+				t.Error(diff)
+			}
+			b, err := rebuilt.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(b, c.serialized); diff != "" {
+				t.Error(diff)
+			}
+		})
 	}
 }
 
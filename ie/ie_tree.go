@@ -0,0 +1,161 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// TreeConfig is a set of options for WriteTree.
+type TreeConfig struct {
+	// Prefix is written at the beginning of every line.
+	Prefix string
+	// Indent is written for each level of nesting. "    " is used if empty.
+	Indent string
+	// Hex adds the raw bytes of each IE in hex.
+	Hex bool
+}
+
+func (c *TreeConfig) indent() string {
+	if c == nil || c.Indent == "" {
+		return "    "
+	}
+	return c.Indent
+}
+
+// WriteTree writes the IE to w in an indented tree format similar to the one
+// of Wireshark's PFCP dissector, i.e., the type and the length followed by
+// the decoded value or the child IEs for grouped IEs.
+//
+// The value of IE is shown in the same way as the "value" in JSON (see
+// MarshalJSON), and the payload is shown in hex if the IE cannot be decoded.
+// cfg can be nil to use the default options.
+func (i *IE) WriteTree(w io.Writer, cfg *TreeConfig) error {
+	if cfg == nil {
+		cfg = &TreeConfig{}
+	}
+
+	buf := &bytes.Buffer{}
+	i.writeTree(buf, cfg, cfg.Prefix)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// String returns the IE in the tree format of WriteTree with the default
+// options.
+func (i *IE) String() string {
+	buf := &bytes.Buffer{}
+	i.writeTree(buf, &TreeConfig{}, "")
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func (i *IE) writeTree(buf *bytes.Buffer, cfg *TreeConfig, prefix string) {
+	inner := prefix + cfg.indent()
+
+	buf.WriteString(prefix)
+	buf.WriteString(typeName(i.Type))
+	if i.IsVendorSpecific() {
+		fmt.Fprintf(buf, ", Enterprise ID: %d", i.EnterpriseID)
+	}
+	fmt.Fprintf(buf, ", Length: %d", i.Length)
+
+	var v any
+	if !i.IsGrouped() {
+		if c, ok := valueCodecs[i.Type]; ok {
+			if decoded, err := c.value(i); err == nil {
+				v = decoded
+			}
+		}
+	}
+
+	fields := structFields(v)
+	if v != nil && fields == nil {
+		fmt.Fprintf(buf, ", Value: %s", formatValue(reflect.ValueOf(v)))
+	}
+	buf.WriteString("\n")
+
+	if cfg.Hex {
+		if b, err := i.Marshal(); err == nil {
+			fmt.Fprintf(buf, "%sRaw: % x\n", inner, b)
+		}
+	}
+
+	switch {
+	case i.IsGrouped():
+		for _, c := range i.ChildIEs {
+			if c == nil {
+				continue
+			}
+			c.writeTree(buf, cfg, inner)
+		}
+	case fields != nil:
+		for _, f := range fields {
+			fmt.Fprintf(buf, "%s%s: %s\n", inner, f.name, formatValue(f.value))
+		}
+	case v == nil && len(i.Payload) > 0:
+		fmt.Fprintf(buf, "%sPayload: % x\n", inner, i.Payload)
+	}
+}
+
+// typeName returns the name of t followed by its value, or just the value
+// in the form of "IEType(n)" if t is unknown.
+func typeName(t IEType) string {
+	s := t.String()
+	if strings.HasPrefix(s, "IEType(") {
+		return s
+	}
+	return fmt.Sprintf("%s (%d)", s, t)
+}
+
+type treeField struct {
+	name  string
+	value reflect.Value
+}
+
+// structFields returns the exported fields of v with non-zero values if v
+// is a pointer to a struct, or nil otherwise.
+func structFields(v any) []treeField {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	rv = rv.Elem()
+	fields := []treeField{}
+	for n := 0; n < rv.NumField(); n++ {
+		f := rv.Type().Field(n)
+		if !f.IsExported() || rv.Field(n).IsZero() {
+			continue
+		}
+		fields = append(fields, treeField{name: f.Name, value: rv.Field(n)})
+	}
+	return fields
+}
+
+// formatValue returns the value in a human-readable format.
+func formatValue(v reflect.Value) string {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "<nil>"
+		}
+		if v.Elem().Kind() == reflect.Struct {
+			return fmt.Sprintf("%+v", v.Elem().Interface())
+		}
+	}
+
+	switch x := v.Interface().(type) {
+	case fmt.Stringer:
+		return x.String()
+	case []byte:
+		return fmt.Sprintf("%x", x)
+	default:
+		return fmt.Sprint(x)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+func TestIETree(t *testing.T) {
+	i := ie.NewCreatePDR(
+		ie.NewPDRID(0xffff),
+		ie.NewPDI(
+			ie.NewSourceInterface(ie.SrcInterfaceCore),
+			ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
+			ie.NewFlowInformation(ie.FlowDirectionDownlink, "go-pfcp"),
+		),
+		ie.NewTimer(20*time.Hour),
+		ie.NewVendorSpecificIE(32770, 10415, []byte{0xde, 0xad, 0xbe, 0xef}),
+	)
+
+	t.Run("String", func(t *testing.T) {
+		want := strings.Join([]string{
+			"CreatePDR (1), Length: 57",
+			"    PDRID (56), Length: 2, Value: 65535",
+			"    PDI (2), Length: 32",
+			"        SourceInterface (20), Length: 1, Value: 1",
+			"        FTEID (21), Length: 9",
+			"            Flags: 1",
+			"            TEID: 286331153",
+			"            IPv4Address: 127.0.0.1",
+			"        FlowInformation (92), Length: 10",
+			"            Payload: 01 00 07 67 6f 2d 70 66 63 70",
+			"    Timer (55), Length: 1, Value: 20h0m0s",
+			"    IEType(32770), Enterprise ID: 10415, Length: 6",
+			"        Payload: de ad be ef",
+		}, "\n")
+
+		if diff := cmp.Diff(i.String(), want); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("Hex", func(t *testing.T) {
+		want := strings.Join([]string{
+			"> PDRID (56), Length: 2, Value: 65535",
+			">   Raw: 00 38 00 02 ff ff",
+			"",
+		}, "\n")
+
+		b := &strings.Builder{}
+		if err := ie.NewPDRID(0xffff).WriteTree(b, &ie.TreeConfig{Prefix: "> ", Indent: "  ", Hex: true}); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(b.String(), want); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
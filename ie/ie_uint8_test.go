@@ -486,6 +486,11 @@ func TestUint8IEs(t *testing.T) {
 			structured:  ie.NewPFCPASRspFlags(0x01),
 			decoded:     0x01,
 			decoderFunc: func(i *ie.IE) (uint8, error) { return i.PFCPASRspFlags() },
+		}, {
+			description: "PFCPSDRspFlags",
+			structured:  ie.NewPFCPSDRspFlags(0x01),
+			decoded:     0x01,
+			decoderFunc: func(i *ie.IE) (uint8, error) { return i.PFCPSDRspFlags() },
 		}, {
 			description: "PFCPAUReqFlags",
 			structured:  ie.NewPFCPAUReqFlags(0x01),
@@ -302,6 +302,7 @@ func _() {
 	_ = x[PFCPSessionChangeInfo-290]
 	_ = x[GroupID-291]
 	_ = x[CPIPAddress-292]
+	_ = x[IPAddressAndPortNumberReplacement-293]
 	_ = x[IPAddressAndPortNumberRepalcement-293]
 	_ = x[DNSQueryFilter-294]
 	_ = x[DirectReportingInformation-295]
@@ -331,16 +332,48 @@ func _() {
 	_ = x[QERIndications-319]
 	_ = x[VendorSpecificNodeReportType-320]
 	_ = x[ConfiguredTimeDomain-321]
+	_ = x[Metadata-322]
+	_ = x[TrafficParameterMeasurementControlInformation-323]
+	_ = x[TrafficParameterMeasurementReport-324]
+	_ = x[TrafficParameterThreshold-325]
+	_ = x[DLPeriodicity-326]
+	_ = x[N6JitterMeasurement-327]
+	_ = x[TrafficParameterMeasurementIndication-328]
+	_ = x[ULPeriodicity-329]
+	_ = x[MPQUICControlInformation-330]
+	_ = x[MPQUICParameters-331]
+	_ = x[MPQUICAddressInformation-332]
+	_ = x[TransportMode-333]
+	_ = x[ProtocolDescription-334]
+	_ = x[ReportingSuggestionInfo-335]
+	_ = x[TLContainer-336]
+	_ = x[MeasurementIndication-337]
+	_ = x[HPLMNSNSSAI-338]
+	_ = x[MediaTransportProtocol-339]
+	_ = x[RTPHeaderExtensionInformation-340]
+	_ = x[RTPPayloadInformation-341]
+	_ = x[RTPHeaderExtensionType-342]
+	_ = x[RTPHeaderExtensionID-343]
+	_ = x[RTPPayloadType-344]
+	_ = x[RTPPayloadFormat-345]
+	_ = x[ExtendedDLBufferingNotificationPolicy-346]
+	_ = x[MTSDTControlInformation-347]
+	_ = x[ReportingThresholds-348]
+	_ = x[RTPHeaderExtensionAdditionalInformation-349]
+	_ = x[MappedN6IPAddress-350]
+	_ = x[N6RoutingInformation-351]
+	_ = x[URI-352]
+	_ = x[UELevelMeasurementsConfiguration-353]
 }
 
 const (
 	_IEType_name_0 = "CreatePDRPDICreateFARForwardingParametersDuplicatingParametersCreateURRCreateQERCreatedPDRUpdatePDRUpdateFARUpdateForwardingParametersUpdateBARWithinSessionReportResponseUpdateURRUpdateQERRemovePDRRemoveFARRemoveURRRemoveQERCauseSourceInterfaceFTEIDNetworkInstanceSDFFilterApplicationIDGateStatusMBRGBRQERCorrelationIDPrecedenceTransportLevelMarkingVolumeThresholdTimeThresholdMonitoringTimeSubsequentVolumeThresholdSubsequentTimeThresholdInactivityDetectionTimeReportingTriggersRedirectInformationReportTypeOffendingIEForwardingPolicyDestinationInterfaceUPFunctionFeaturesApplyActionDownlinkDataServiceInformationDownlinkDataNotificationDelayDLBufferingDurationDLBufferingSuggestedPacketCountPFCPSMReqFlagsPFCPSRRspFlagsLoadControlInformationSequenceNumberMetricOverloadControlInformationTimerPDRIDFSEIDApplicationIDsPFDsPFDContextNodeIDPFDContentsMeasurementMethodUsageReportTriggerMeasurementPeriodFQCSIDVolumeMeasurementDurationMeasurementApplicationDetectionInformationTimeOfFirstPacketTimeOfLastPacketQuotaHoldingTimeDroppedDLTrafficThresholdVolumeQuotaTimeQuotaStartTimeEndTimeQueryURRUsageReportWithinSessionModificationResponseUsageReportWithinSessionDeletionResponseUsageReportWithinSessionReportRequestURRIDLinkedURRIDDownlinkDataReportOuterHeaderCreationCreateBARUpdateBARWithinSessionModificationRequestRemoveBARBARIDCPFunctionFeaturesUsageInformationApplicationInstanceIDFlowInformationUEIPAddressPacketRateOuterHeaderRemovalRecoveryTimeStampDLFlowLevelMarkingHeaderEnrichmentErrorIndicationReportMeasurementInformationNodeReportTypeUserPlanePathFailureReportRemoteGTPUPeerURSEQNUpdateDuplicatingParametersActivatePredefinedRulesDeactivatePredefinedRulesFARIDQERIDOCIFlagsPFCPAssociationReleaseRequestGracefulReleasePeriodPDNTypeFailedRuleIDTimeQuotaMechanismUserPlaneIPResourceInformationUserPlaneInactivityTimerAggregatedURRsMultiplierAggregatedURRIDSubsequentVolumeQuotaSubsequentTimeQuotaRQIQFIQueryURRReferenceAdditionalUsageReportsInformationCreateTrafficEndpointCreatedTrafficEndpointUpdateTrafficEndpointRemoveTrafficEndpointTrafficEndpointIDEthernetPacketFilterMACAddressCTAGSTAGEthertypeProxyingEthernetFilterIDEthernetFilterPropertiesSuggestedBufferingPacketsCountUserIDEthernetPDUSessionInformationEthernetTrafficInformationMACAddressesDetectedMACAddressesRemovedEthernetInactivityTimerAdditionalMonitoringTimeEventQuotaEventThresholdSubsequentEventQuotaSubsequentEventThresholdTraceInformationFramedRouteFramedRoutingFramedIPv6RouteEventTimeStampAveragingWindowPagingPolicyIndicatorAPNDNNTGPPInterfaceTypePFCPSRReqFlagsPFCPAUReqFlagsActivationTimeDeactivationTimeCreateMARTGPPAccessForwardingActionInformationNonTGPPAccessForwardingActionInformationRemoveMARUpdateMARMARIDSteeringFunctionalitySteeringModeWeightPriorityUpdateTGPPAccessForwardingActionInformationUpdateNonTGPPAccessForwardingActionInformationUEIPAddressPoolIdentityAlternativeSMFIPAddressPacketReplicationAndDetectionCarryOnInformationSMFSetIDQuotaValidityTimeNumberOfReportsPFCPSessionRetentionInformationPFCPASRspFlagsCPPFCPEntityIPAddressPFCPSEReqFlagsUserPlanePathRecoveryReportIPMulticastAddressingInfoJoinIPMulticastInformationWithinUsageReportLeaveIPMulticastInformationWithinUsageReportIPMulticastAddressSourceIPAddressPacketRateStatusCreateBridgeInfoForTSCCreatedBridgeInfoForTSCDSTTPortNumberNWTTPortNumberTSNBridgeIDTSCManagementInformationWithinSessionModificationRequestTSCManagementInformationWithinSessionModificationResponseTSCManagementInformationWithinSessionReportRequestPortManagementInformationContainerClockDriftControlInformationRequestedClockDriftInformationClockDriftReportTSNTimeDomainNumberTimeOffsetThresholdCumulativeRateRatioThresholdTimeOffsetMeasurementCumulativeRateRatioMeasurementRemoveSRRCreateSRRUpdateSRRSessionReportSRRIDAccessAvailabilityControlInformationRequestedAccessAvailabilityInformationAccessAvailabilityReportAccessAvailabilityInformationProvideATSSSControlInformationATSSSControlParametersMPTCPControlInformationATSSSLLControlInformationPMFControlInformationMPTCPParametersATSSSLLParametersPMFParametersMPTCPAddressInformationUELinkSpecificIPAddressPMFAddressInformationATSSSLLInformationDataNetworkAccessIdentifierUEIPAddressPoolInformationAveragePacketDelayMinimumPacketDelayMaximumPacketDelayQoSReportTriggerGTPUPathQoSControlInformationGTPUPathQoSReportQoSInformationInGTPUPathQoSReportGTPUPathInterfaceTypeQoSMonitoringPerQoSFlowControlInformationRequestedQoSMonitoringReportingFrequencyPacketDelayThresholdsMinimumWaitTimeQoSMonitoringReportQoSMonitoringMeasurementMTEDTControlInformationDLDataPacketsSizeQERControlIndicationsPacketRateStatusReportNFInstanceIDEthernetContextInformationRedundantTransmissionParametersUpdatedPDRSNSSAIIPVersionPFCPASReqFlagsDataStatusProvideRDSConfigurationInformationRDSConfigurationInformationQueryPacketRateStatusWithinSessionModificationRequestPacketRateStatusReportWithinSessionModificationResponseMPTCPApplicableIndicationBridgeManagementInformationContainerUEIPAddressUsageInformationNumberOfUEIPAddressesValidityTimerRedundantTransmissionForwardingParametersTransportDelayReportingPartialFailureInformation"
-	_IEType_name_1 = "OffendingIEInformationRATTypeL2TPTunnelInformationL2TPSessionInformationL2TPUserAuthenticationCreatedL2TPSessionLNSAddressTunnelPreferenceCallingNumberCalledNumberL2TPSessionIndicationsDNSServerAddressNBNSServerAddressMaximumReceiveUnitThresholdsSteeringModeIndicatorPFCPSessionChangeInfoGroupIDCPIPAddressIPAddressAndPortNumberRepalcementDNSQueryFilterDirectReportingInformationEventNotificationURINotificationCorrelationIDReportingFlagsPredefinedRulesNameMBSSessionN4mbControlInformationMBSMulticastParametersAddMBSUnicastParametersMBSSessionN4mbInformationRemoveMBSUnicastParametersMBSSessionIdentifierMulticastTransportInformationMBSN4mbReqFlagsLocalIngressTunnelMBSUnicastParametersIDMBSSessionN4ControlInformationMBSSessionN4InformationMBSN4RespFlagsTunnelPasswordAreaSessionIDPeerUPRestartReportDSCPToPPIControlInformationDSCPToPPIMappingInformationPFCPSDRspFlagsQERIndicationsVendorSpecificNodeReportTypeConfiguredTimeDomain"
+	_IEType_name_1 = "OffendingIEInformationRATTypeL2TPTunnelInformationL2TPSessionInformationL2TPUserAuthenticationCreatedL2TPSessionLNSAddressTunnelPreferenceCallingNumberCalledNumberL2TPSessionIndicationsDNSServerAddressNBNSServerAddressMaximumReceiveUnitThresholdsSteeringModeIndicatorPFCPSessionChangeInfoGroupIDCPIPAddressIPAddressAndPortNumberReplacementDNSQueryFilterDirectReportingInformationEventNotificationURINotificationCorrelationIDReportingFlagsPredefinedRulesNameMBSSessionN4mbControlInformationMBSMulticastParametersAddMBSUnicastParametersMBSSessionN4mbInformationRemoveMBSUnicastParametersMBSSessionIdentifierMulticastTransportInformationMBSN4mbReqFlagsLocalIngressTunnelMBSUnicastParametersIDMBSSessionN4ControlInformationMBSSessionN4InformationMBSN4RespFlagsTunnelPasswordAreaSessionIDPeerUPRestartReportDSCPToPPIControlInformationDSCPToPPIMappingInformationPFCPSDRspFlagsQERIndicationsVendorSpecificNodeReportTypeConfiguredTimeDomainMetadataTrafficParameterMeasurementControlInformationTrafficParameterMeasurementReportTrafficParameterThresholdDLPeriodicityN6JitterMeasurementTrafficParameterMeasurementIndicationULPeriodicityMPQUICControlInformationMPQUICParametersMPQUICAddressInformationTransportModeProtocolDescriptionReportingSuggestionInfoTLContainerMeasurementIndicationHPLMNSNSSAIMediaTransportProtocolRTPHeaderExtensionInformationRTPPayloadInformationRTPHeaderExtensionTypeRTPHeaderExtensionIDRTPPayloadTypeRTPPayloadFormatExtendedDLBufferingNotificationPolicyMTSDTControlInformationReportingThresholdsRTPHeaderExtensionAdditionalInformationMappedN6IPAddressN6RoutingInformationURIUELevelMeasurementsConfiguration"
 )
 
 var (
 	_IEType_index_0 = [...]uint16{0, 9, 12, 21, 41, 62, 71, 80, 90, 99, 108, 134, 170, 179, 188, 197, 206, 215, 224, 229, 244, 249, 264, 273, 286, 296, 299, 302, 318, 328, 349, 364, 377, 391, 416, 439, 462, 479, 498, 508, 519, 535, 555, 573, 584, 614, 643, 662, 693, 707, 721, 743, 757, 763, 789, 794, 799, 804, 822, 832, 838, 849, 866, 884, 901, 907, 924, 943, 974, 991, 1007, 1023, 1048, 1059, 1068, 1077, 1084, 1092, 1136, 1176, 1213, 1218, 1229, 1247, 1266, 1275, 1316, 1325, 1330, 1348, 1364, 1385, 1400, 1411, 1421, 1439, 1456, 1474, 1490, 1511, 1533, 1547, 1573, 1587, 1593, 1620, 1643, 1668, 1673, 1678, 1686, 1715, 1736, 1743, 1755, 1773, 1803, 1827, 1841, 1851, 1866, 1887, 1906, 1909, 1912, 1929, 1962, 1983, 2005, 2026, 2047, 2064, 2084, 2094, 2098, 2102, 2111, 2119, 2135, 2159, 2189, 2195, 2224, 2250, 2270, 2289, 2312, 2336, 2346, 2360, 2380, 2404, 2420, 2431, 2444, 2459, 2473, 2488, 2509, 2515, 2532, 2546, 2560, 2574, 2590, 2599, 2636, 2676, 2685, 2694, 2699, 2720, 2732, 2738, 2746, 2789, 2835, 2858, 2881, 2928, 2936, 2953, 2968, 2999, 3013, 3034, 3048, 3075, 3100, 3143, 3187, 3205, 3220, 3236, 3258, 3281, 3295, 3309, 3320, 3376, 3433, 3483, 3517, 3545, 3575, 3591, 3610, 3629, 3657, 3678, 3708, 3717, 3726, 3735, 3748, 3753, 3789, 3827, 3851, 3880, 3910, 3932, 3955, 3980, 4001, 4016, 4033, 4046, 4069, 4092, 4113, 4131, 4158, 4184, 4202, 4220, 4238, 4254, 4283, 4300, 4333, 4354, 4395, 4417, 4435, 4456, 4471, 4490, 4514, 4537, 4554, 4575, 4597, 4609, 4635, 4666, 4676, 4682, 4691, 4705, 4715, 4749, 4776, 4829, 4884, 4909, 4945, 4972, 4993, 5006, 5047, 5070, 5095}
-	_IEType_index_1 = [...]uint16{0, 22, 29, 50, 72, 94, 112, 122, 138, 151, 163, 185, 201, 218, 236, 246, 267, 288, 295, 306, 339, 353, 379, 399, 424, 438, 457, 489, 511, 534, 559, 585, 605, 634, 649, 667, 689, 719, 742, 756, 770, 783, 802, 829, 856, 870, 884, 912, 932}
+	_IEType_index_1 = [...]uint16{0, 22, 29, 50, 72, 94, 112, 122, 138, 151, 163, 185, 201, 218, 236, 246, 267, 288, 295, 306, 339, 353, 379, 399, 424, 438, 457, 489, 511, 534, 559, 585, 605, 634, 649, 667, 689, 719, 742, 756, 770, 783, 802, 829, 856, 870, 884, 912, 932, 940, 985, 1018, 1043, 1056, 1075, 1112, 1125, 1149, 1165, 1189, 1202, 1221, 1244, 1255, 1276, 1287, 1309, 1338, 1359, 1381, 1401, 1415, 1431, 1468, 1491, 1510, 1549, 1566, 1586, 1589, 1621}
 )
 
 func (i IEType) String() string {
@@ -348,7 +381,7 @@ func (i IEType) String() string {
 	case 1 <= i && i <= 272:
 		i -= 1
 		return _IEType_name_0[_IEType_index_0[i]:_IEType_index_0[i+1]]
-	case 274 <= i && i <= 321:
+	case 274 <= i && i <= 353:
 		i -= 274
 		return _IEType_name_1[_IEType_index_1[i]:_IEType_index_1[i+1]]
 	default:
@@ -179,7 +179,7 @@ func ParseIPMulticastAddressFields(b []byte) (*IPMulticastAddressFields, error)
 // UnmarshalBinary parses b into IE.
 func (f *IPMulticastAddressFields) UnmarshalBinary(b []byte) error {
 	l := len(b)
-	if l < 2 {
+	if l < 1 {
 		return io.ErrUnexpectedEOF
 	}
 
@@ -9,6 +9,19 @@ func NewIPMulticastAddressingInfo(ies ...*IE) *IE {
 	return newGroupedIE(IPMulticastAddressingInfo, 0, ies...)
 }
 
+// NewIPMulticastAddressingInfoFromFields creates a new IPMulticastAddressingInfo
+// IE from the given fields.
+func NewIPMulticastAddressingInfoFromFields(f *IPMulticastAddressingInfoField) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewIPMulticastAddressingInfo(
+		newFieldsIE(IPMulticastAddress, f.IPMulticastAddress),
+		newFieldsIE(SourceIPAddress, f.SourceIPAddress),
+	)
+}
+
 // IPMulticastAddressingInfo returns the IEs above IPMulticastAddressingInfo if the type of IE matches.
 func (i *IE) IPMulticastAddressingInfo() (*IPMulticastAddressingInfoField, error) {
 	switch i.Type {
@@ -9,6 +9,19 @@ func NewJoinIPMulticastInformationWithinUsageReport(ies ...*IE) *IE {
 	return newGroupedIE(JoinIPMulticastInformationWithinUsageReport, 0, ies...)
 }
 
+// NewJoinIPMulticastInformationWithinUsageReportFromFields creates a new
+// JoinIPMulticastInformationWithinUsageReport IE from the given fields.
+func NewJoinIPMulticastInformationWithinUsageReportFromFields(f *JoinIPMulticastInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewJoinIPMulticastInformationWithinUsageReport(
+		newFieldsIE(IPMulticastAddress, f.IPMulticastAddress),
+		newFieldsIE(SourceIPAddress, f.SourceIPAddress),
+	)
+}
+
 // JoinIPMulticastInformationWithinUsageReport returns the IEs above JoinIPMulticastInformationWithinUsageReport if the type of IE matches.
 func (i *IE) JoinIPMulticastInformationWithinUsageReport() (*JoinIPMulticastInformationFields, error) {
 	switch i.Type {
@@ -149,7 +149,7 @@ func (f *L2TPUserAuthenticationFields) MarshalTo(b []byte) error {
 	offset := 1
 
 	if has2ndBit(f.Flags) && !has5thBit(f.Flags) {
-		copy(b[offset:offset+4], f.IPv4Address)
+		copy(b[offset:offset+4], f.IPv4Address.To4())
 		offset += 4
 	}
 
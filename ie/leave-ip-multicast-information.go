@@ -9,6 +9,19 @@ func NewLeaveIPMulticastInformationWithinUsageReport(ies ...*IE) *IE {
 	return newGroupedIE(LeaveIPMulticastInformationWithinUsageReport, 0, ies...)
 }
 
+// NewLeaveIPMulticastInformationWithinUsageReportFromFields creates a new
+// LeaveIPMulticastInformationWithinUsageReport IE from the given fields.
+func NewLeaveIPMulticastInformationWithinUsageReportFromFields(f *LeaveIPMulticastInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewLeaveIPMulticastInformationWithinUsageReport(
+		newFieldsIE(IPMulticastAddress, f.IPMulticastAddress),
+		newFieldsIE(SourceIPAddress, f.SourceIPAddress),
+	)
+}
+
 // LeaveIPMulticastInformationWithinUsageReport returns the IEs above LeaveIPMulticastInformationWithinUsageReport if the type of IE matches.
 func (i *IE) LeaveIPMulticastInformationWithinUsageReport() (*LeaveIPMulticastInformationFields, error) {
 	switch i.Type {
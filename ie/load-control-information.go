@@ -9,6 +9,16 @@ func NewLoadControlInformation(ies ...*IE) *IE {
 	return newGroupedIE(LoadControlInformation, 0, ies...)
 }
 
+// NewLoadControlInformationFromFields creates a new LoadControlInformation IE from
+// the given fields.
+func NewLoadControlInformationFromFields(f *LoadControlInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewLoadControlInformation(NewSequenceNumber(f.Sequence), NewMetric(f.Metric))
+}
+
 // LoadControlInformation returns the IEs above LoadControlInformation if the type of IE matches.
 func (i *IE) LoadControlInformation() (*LoadControlInformationFields, error) {
 	if i.Type != LoadControlInformation {
@@ -173,7 +173,7 @@ func (f *LocalIngressTunnelFields) MarshalTo(b []byte) error {
 
 	offset := 3
 	if has2ndBit(f.Flags) {
-		copy(b[offset:offset+4], f.IPv4Address)
+		copy(b[offset:offset+4], f.IPv4Address.To4())
 		offset += 4
 	}
 
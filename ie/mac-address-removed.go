@@ -99,33 +99,36 @@ func (f *MACAddressesRemovedFields) UnmarshalBinary(b []byte) error {
 	f.NumberOfMACAddresses = b[0]
 	offset := 1
 
-	for i := 0; i <= int(f.NumberOfMACAddresses); i++ {
+	for i := 0; i < int(f.NumberOfMACAddresses); i++ {
 		if l < offset+6 {
 			return io.ErrUnexpectedEOF
 		}
-		f.MACAddresses = append(f.MACAddresses, b[offset:offset+6])
+		f.MACAddresses = append(f.MACAddresses, net.HardwareAddr(b[offset:offset+6]))
 		offset += 6
 	}
 
-	if l < offset {
+	if l < offset+1 {
 		return io.ErrUnexpectedEOF
 	}
 	f.CTAGLength = b[offset]
+	offset++
 
 	if l < offset+int(f.CTAGLength) {
 		return io.ErrUnexpectedEOF
 	}
-	copy(f.CTAG, b[offset:offset+int(f.CTAGLength)])
+	f.CTAG = b[offset : offset+int(f.CTAGLength)]
+	offset += int(f.CTAGLength)
 
-	if l < offset {
+	if l < offset+1 {
 		return io.ErrUnexpectedEOF
 	}
 	f.STAGLength = b[offset]
+	offset++
 
 	if l < offset+int(f.STAGLength) {
 		return io.ErrUnexpectedEOF
 	}
-	copy(f.STAG, b[offset:offset+int(f.STAGLength)])
+	f.STAG = b[offset : offset+int(f.STAGLength)]
 
 	return nil
 }
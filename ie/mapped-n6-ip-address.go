@@ -0,0 +1,165 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+	"net"
+)
+
+// NewMappedN6IPAddress creates a new MappedN6IPAddress IE.
+func NewMappedN6IPAddress(v4, v6 net.IP) *IE {
+	fields := NewMappedN6IPAddressFields(v4, v6)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(MappedN6IPAddress, b)
+}
+
+// MappedN6IPAddress returns MappedN6IPAddress in structured format if the type of IE matches.
+func (i *IE) MappedN6IPAddress() (*MappedN6IPAddressFields, error) {
+	if i.Type != MappedN6IPAddress {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	fields, err := ParseMappedN6IPAddressFields(i.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// MappedN6IPAddressFields represents a fields contained in MappedN6IPAddress IE.
+type MappedN6IPAddressFields struct {
+	Flags       uint8
+	IPv4Address net.IP
+	IPv6Address net.IP
+}
+
+// NewMappedN6IPAddressFields creates a new NewMappedN6IPAddressFields.
+func NewMappedN6IPAddressFields(v4, v6 net.IP) *MappedN6IPAddressFields {
+	f := &MappedN6IPAddressFields{}
+
+	if v4 != nil {
+		f.IPv4Address = v4
+		f.SetIPv4Flag()
+	}
+	if v6 != nil {
+		f.IPv6Address = v6
+		f.SetIPv6Flag()
+	}
+
+	return f
+}
+
+// HasIPv4 reports whether IPv4 flag is set.
+func (f *MappedN6IPAddressFields) HasIPv4() bool {
+	return has2ndBit(f.Flags)
+}
+
+// SetIPv4Flag sets IPv4 flag in MappedN6IPAddress.
+func (f *MappedN6IPAddressFields) SetIPv4Flag() {
+	f.Flags |= 0x02
+}
+
+// HasIPv6 reports whether IPv6 flag is set.
+func (f *MappedN6IPAddressFields) HasIPv6() bool {
+	return has1stBit(f.Flags)
+}
+
+// SetIPv6Flag sets IPv6 flag in MappedN6IPAddress.
+func (f *MappedN6IPAddressFields) SetIPv6Flag() {
+	f.Flags |= 0x01
+}
+
+// ParseMappedN6IPAddressFields parses b into MappedN6IPAddressFields.
+func ParseMappedN6IPAddressFields(b []byte) (*MappedN6IPAddressFields, error) {
+	f := &MappedN6IPAddressFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *MappedN6IPAddressFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 2 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	offset := 1
+
+	if f.HasIPv4() {
+		if l < offset+4 {
+			return io.ErrUnexpectedEOF
+		}
+		f.IPv4Address = net.IP(b[offset : offset+4])
+		offset += 4
+	}
+
+	if f.HasIPv6() {
+		if l < offset+16 {
+			return io.ErrUnexpectedEOF
+		}
+		f.IPv6Address = net.IP(b[offset : offset+16])
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of MappedN6IPAddressFields.
+func (f *MappedN6IPAddressFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *MappedN6IPAddressFields) MarshalTo(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	offset := 1
+
+	if f.IPv4Address != nil {
+		if l < offset+4 {
+			return io.ErrUnexpectedEOF
+		}
+		copy(b[offset:offset+4], f.IPv4Address.To4())
+		offset += 4
+	}
+	if f.IPv6Address != nil {
+		if l < offset+16 {
+			return io.ErrUnexpectedEOF
+		}
+		copy(b[offset:offset+16], f.IPv6Address.To16())
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *MappedN6IPAddressFields) MarshalLen() int {
+	l := 1
+	if f.IPv4Address != nil {
+		l += 4
+	}
+	if f.IPv6Address != nil {
+		l += 16
+	}
+
+	return l
+}
@@ -5,6 +5,28 @@ func NewMBSMulticastParameters(ies ...*IE) *IE {
 	return newGroupedIE(MBSMulticastParameters, 0, ies...)
 }
 
+// NewMBSMulticastParametersFromFields creates a new MBSMulticastParameters IE
+// from the given fields.
+func NewMBSMulticastParametersFromFields(f *MBSMulticastParametersFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewDestinationInterface(f.DestinationInterface)}
+	if f.NetworkInstance != "" {
+		ies = append(ies, NewNetworkInstance(f.NetworkInstance))
+	}
+	ies = append(ies, newFieldsIE(OuterHeaderCreation, f.OuterHeaderCreation))
+	if f.HasTransportLevelMarking || f.TransportLevelMarking != 0 {
+		ies = append(ies, NewTransportLevelMarking(f.TransportLevelMarking))
+	}
+	if f.HasDestinationInterfaceType || f.DestinationInterfaceType != 0 {
+		ies = append(ies, NewTGPPInterfaceType(f.DestinationInterfaceType))
+	}
+
+	return NewMBSMulticastParameters(ies...)
+}
+
 // MBSMulticastParameters returns the IEs above MBSMulticastParameters if the type of IE matches.
 func (i *IE) MBSMulticastParameters() (*MBSMulticastParametersFields, error) {
 	switch i.Type {
@@ -29,11 +51,13 @@ func (i *IE) MBSMulticastParameters() (*MBSMulticastParametersFields, error) {
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type MBSMulticastParametersFields struct {
-	DestinationInterface     uint8
-	NetworkInstance          string
-	OuterHeaderCreation      *OuterHeaderCreationFields
-	TransportLevelMarking    uint16
-	DestinationInterfaceType uint8
+	DestinationInterface        uint8
+	NetworkInstance             string
+	OuterHeaderCreation         *OuterHeaderCreationFields
+	TransportLevelMarking       uint16
+	HasTransportLevelMarking    bool
+	DestinationInterfaceType    uint8
+	HasDestinationInterfaceType bool
 }
 
 // ParseMBSUnicastParametersFields returns the IEs above MBSUnicastParameters.
@@ -73,12 +97,14 @@ func ParseMBSMulticastParametersFields(b []byte) (*MBSMulticastParametersFields,
 				return f, err
 			}
 			f.TransportLevelMarking = transport
+			f.HasTransportLevelMarking = true
 		case TGPPInterfaceType:
 			tgppinterface, err := ie.TGPPInterfaceType()
 			if err != nil {
 				return f, err
 			}
 			f.DestinationInterfaceType = tgppinterface
+			f.HasDestinationInterfaceType = true
 		}
 	}
 	return f, nil
@@ -0,0 +1,26 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMediaTransportProtocol creates a new MediaTransportProtocol IE.
+func NewMediaTransportProtocol(protocol uint8) *IE {
+	return newUint8ValIE(MediaTransportProtocol, protocol)
+}
+
+// MediaTransportProtocol returns MediaTransportProtocol in uint8 if the type of IE matches.
+func (i *IE) MediaTransportProtocol() (uint8, error) {
+	switch i.Type {
+	case MediaTransportProtocol:
+		return i.ValueAsUint8()
+	case ProtocolDescription:
+		ies, err := i.ProtocolDescription()
+		if err != nil {
+			return 0, err
+		}
+		return ies.MediaTransportProtocol, nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
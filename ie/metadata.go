@@ -0,0 +1,19 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMetadata creates a new Metadata IE.
+func NewMetadata(metadata []byte) *IE {
+	return New(Metadata, metadata)
+}
+
+// Metadata returns Metadata in []byte if the type of IE matches.
+func (i *IE) Metadata() ([]byte, error) {
+	if i.Type != Metadata {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.Payload, nil
+}
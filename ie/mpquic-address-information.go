@@ -0,0 +1,191 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+)
+
+// NewMPQUICAddressInformation creates a new MPQUICAddressInformation IE.
+func NewMPQUICAddressInformation(port uint16, v4, v6 net.IP) *IE {
+	fields := NewMPQUICAddressInformationFields(port, v4, v6)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(MPQUICAddressInformation, b)
+}
+
+// MPQUICAddressInformation returns MPQUICAddressInformation in structured format if the type of IE matches.
+func (i *IE) MPQUICAddressInformation() (*MPQUICAddressInformationFields, error) {
+	switch i.Type {
+	case MPQUICAddressInformation:
+		fields, err := ParseMPQUICAddressInformationFields(i.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return fields, nil
+	case ATSSSControlParameters:
+		ies, err := i.ATSSSControlParameters()
+		if err != nil {
+			return nil, err
+		}
+		if ies.MPQUICParameters != nil && ies.MPQUICParameters.MPQUICAddressInformation != nil {
+			return ies.MPQUICParameters.MPQUICAddressInformation, nil
+		}
+		return nil, ErrIENotFound
+	case MPQUICParameters:
+		ies, err := i.MPQUICParameters()
+		if err != nil {
+			return nil, err
+		}
+		if ies.MPQUICAddressInformation != nil {
+			return ies.MPQUICAddressInformation, nil
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MPQUICAddressInformationFields represents a fields contained in MPQUICAddressInformation IE.
+type MPQUICAddressInformationFields struct {
+	Flags             uint8
+	MPQUICProxyPort   uint16
+	MPQUICIPv4Address net.IP
+	MPQUICIPv6Address net.IP
+}
+
+// NewMPQUICAddressInformationFields creates a new NewMPQUICAddressInformationFields.
+func NewMPQUICAddressInformationFields(port uint16, v4, v6 net.IP) *MPQUICAddressInformationFields {
+	f := &MPQUICAddressInformationFields{
+		MPQUICProxyPort: port,
+	}
+
+	if v4 != nil {
+		f.SetIPv4Flag()
+		f.MPQUICIPv4Address = v4
+	}
+	if v6 != nil {
+		f.SetIPv6Flag()
+		f.MPQUICIPv6Address = v6
+	}
+
+	return f
+}
+
+// HasIPv6 reports whether IPv6 flag is set.
+func (f *MPQUICAddressInformationFields) HasIPv6() bool {
+	return has2ndBit(f.Flags)
+}
+
+// SetIPv6Flag sets IPv6 flag in MPQUICAddressInformation.
+func (f *MPQUICAddressInformationFields) SetIPv6Flag() {
+	f.Flags |= 0x02
+}
+
+// HasIPv4 reports whether IPv4 flag is set.
+func (f *MPQUICAddressInformationFields) HasIPv4() bool {
+	return has1stBit(f.Flags)
+}
+
+// SetIPv4Flag sets IPv4 flag in MPQUICAddressInformation.
+func (f *MPQUICAddressInformationFields) SetIPv4Flag() {
+	f.Flags |= 0x01
+}
+
+// ParseMPQUICAddressInformationFields parses b into MPQUICAddressInformationFields.
+func ParseMPQUICAddressInformationFields(b []byte) (*MPQUICAddressInformationFields, error) {
+	f := &MPQUICAddressInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *MPQUICAddressInformationFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 3 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	f.MPQUICProxyPort = binary.BigEndian.Uint16(b[1:3])
+	offset := 3
+
+	if f.HasIPv4() {
+		if l < offset+4 {
+			return io.ErrUnexpectedEOF
+		}
+		f.MPQUICIPv4Address = net.IP(b[offset : offset+4])
+		offset += 4
+	}
+
+	if f.HasIPv6() {
+		if l < offset+16 {
+			return io.ErrUnexpectedEOF
+		}
+		f.MPQUICIPv6Address = net.IP(b[offset : offset+16])
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of MPQUICAddressInformationFields.
+func (f *MPQUICAddressInformationFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *MPQUICAddressInformationFields) MarshalTo(b []byte) error {
+	l := len(b)
+	if l < 3 {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	binary.BigEndian.PutUint16(b[1:3], f.MPQUICProxyPort)
+	offset := 3
+
+	if f.HasIPv4() {
+		if l < offset+4 {
+			return io.ErrUnexpectedEOF
+		}
+		copy(b[offset:offset+4], f.MPQUICIPv4Address.To4())
+		offset += 4
+	}
+
+	if f.HasIPv6() {
+		if l < offset+16 {
+			return io.ErrUnexpectedEOF
+		}
+		copy(b[offset:offset+16], f.MPQUICIPv6Address.To16())
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *MPQUICAddressInformationFields) MarshalLen() int {
+	l := 1 + 2
+	if f.HasIPv4() {
+		l += 4
+	}
+	if f.HasIPv6() {
+		l += 16
+	}
+
+	return l
+}
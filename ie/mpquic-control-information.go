@@ -0,0 +1,26 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMPQUICControlInformation creates a new MPQUICControlInformation IE.
+func NewMPQUICControlInformation(qci uint8) *IE {
+	return newUint8ValIE(MPQUICControlInformation, qci)
+}
+
+// MPQUICControlInformation returns MPQUICControlInformation in uint8 if the type of IE matches.
+func (i *IE) MPQUICControlInformation() (uint8, error) {
+	switch i.Type {
+	case MPQUICControlInformation:
+		return i.ValueAsUint8()
+	case ProvideATSSSControlInformation:
+		ies, err := i.ProvideATSSSControlInformation()
+		if err != nil {
+			return 0, err
+		}
+		return ies.MPQUICControlInformation, nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMPQUICParameters creates a new MPQUICParameters IE.
+func NewMPQUICParameters(ies ...*IE) *IE {
+	return newGroupedIE(MPQUICParameters, 0, ies...)
+}
+
+// NewMPQUICParametersFromFields creates a new MPQUICParameters IE from the given
+// fields.
+func NewMPQUICParametersFromFields(f *MPQUICParametersFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewMPQUICParameters(
+		newFieldsIE(MPQUICAddressInformation, f.MPQUICAddressInformation),
+		newFieldsIE(UELinkSpecificIPAddress, f.UELinkSpecificIPAddress),
+	)
+}
+
+// MPQUICParameters returns the IEs above MPQUICParameters if the type of IE matches.
+func (i *IE) MPQUICParameters() (*MPQUICParametersFields, error) {
+	if i.Type != MPQUICParameters {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return ParseMPQUICParametersFields(i.Payload)
+}
+
+// MPQUICParametersFields is a set of fields in MPQUICParameters IE.
+//
+// The contained fields are of type struct, as they are too complex to handle with
+// existing (standard) types in Go.
+type MPQUICParametersFields struct {
+	MPQUICAddressInformation *MPQUICAddressInformationFields
+	UELinkSpecificIPAddress  *UELinkSpecificIPAddressFields
+}
+
+// ParseMPQUICParametersFields returns the IEs above MPQUICParameters.
+func ParseMPQUICParametersFields(b []byte) (*MPQUICParametersFields, error) {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return nil, err
+	}
+	f := &MPQUICParametersFields{}
+	if err := f.ParseIEs(ies...); err != nil {
+		return f, err
+	}
+	return f, nil
+}
+
+// ParseIEs will iterator over all childs IE to avoid to use Parse or ParseMultiIEs any time we iterate in IE
+func (f *MPQUICParametersFields) ParseIEs(ies ...*IE) error {
+	for _, ie := range ies {
+		if ie == nil {
+			continue
+		}
+
+		switch ie.Type {
+		case MPQUICAddressInformation:
+			v, err := ie.MPQUICAddressInformation()
+			if err != nil {
+				return err
+			}
+			f.MPQUICAddressInformation = v
+		case UELinkSpecificIPAddress:
+			v, err := ie.UELinkSpecificIPAddress()
+			if err != nil {
+				return err
+			}
+			f.UELinkSpecificIPAddress = v
+		}
+	}
+	return nil
+}
@@ -165,7 +165,7 @@ func (f *MulticastAddress) MarshalTo(b []byte) error {
 		// Address Type 0 and Address Length 4 shall be used when Address is an IPv4 address.
 		b[offset] = 0x04
 		offset += 1
-		copy(b[offset:offset+4], f.IPv4Address)
+		copy(b[offset:offset+4], f.IPv4Address.To4())
 		offset += 4
 	}
 	if f.IPv6Address != nil {
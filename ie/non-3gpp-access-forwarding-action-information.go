@@ -9,6 +9,16 @@ func NewNonTGPPAccessForwardingActionInformation(ies ...*IE) *IE {
 	return newGroupedIE(NonTGPPAccessForwardingActionInformation, 0, ies...)
 }
 
+// NewNonTGPPAccessForwardingActionInformationFromFields creates a new NonTGPPAccessForwardingActionInformation IE
+// from the given fields.
+func NewNonTGPPAccessForwardingActionInformationFromFields(f *NonTGPPAccessForwardingActionInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewNonTGPPAccessForwardingActionInformation(accessForwardingActionInformationIEs((*TGPPAccessForwardingActionInformationFields)(f))...)
+}
+
 // NonTGPPAccessForwardingActionInformation returns the IEs above NonTGPPAccessForwardingActionInformation if the type of IE matches.
 func (i *IE) NonTGPPAccessForwardingActionInformation() (*NonTGPPAccessForwardingActionInformationFields, error) {
 	switch i.Type {
@@ -42,11 +52,16 @@ func (i *IE) NonTGPPAccessForwardingActionInformation() (*NonTGPPAccessForwardin
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type NonTGPPAccessForwardingActionInformationFields struct {
-	FARID    uint32
-	Weight   uint8
-	Priority uint8
-	URRID    uint32
-	RATType  uint8
+	FARID       uint32
+	HasFARID    bool
+	Weight      uint8
+	HasWeight   bool
+	Priority    uint8
+	HasPriority bool
+	URRID       uint32
+	HasURRID    bool
+	RATType     uint8
+	HasRATType  bool
 }
 
 // TGPPNonAccessForwardingActionInformation returns the IEs above NonTGPPAccessForwardingActionInformation.
@@ -69,30 +84,35 @@ func ParseNonTGPPAccessForwardingActionInformationFields(b []byte) (*NonTGPPAcce
 				return t, err
 			}
 			t.FARID = v
+			t.HasFARID = true
 		case Weight:
 			v, err := ie.Weight()
 			if err != nil {
 				return t, err
 			}
 			t.Weight = v
+			t.HasWeight = true
 		case Priority:
 			v, err := ie.Priority()
 			if err != nil {
 				return t, err
 			}
 			t.Priority = v
+			t.HasPriority = true
 		case URRID:
 			v, err := ie.URRID()
 			if err != nil {
 				return t, err
 			}
 			t.URRID = v
+			t.HasURRID = true
 		case RATType:
 			v, err := ie.RATType()
 			if err != nil {
 				return t, err
 			}
 			t.RATType = v
+			t.HasRATType = true
 		}
 	}
 
@@ -429,7 +429,7 @@ func (f *OuterHeaderCreationFields) MarshalTo(b []byte) error {
 	}
 
 	if has1stBit(oct5) || has3rdBit(oct5) || has5thBit(oct5) {
-		copy(b[offset:offset+4], f.IPv4Address)
+		copy(b[offset:offset+4], f.IPv4Address.To4())
 		offset += 4
 	}
 
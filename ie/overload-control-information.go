@@ -11,6 +11,25 @@ func NewOverloadControlInformation(ies ...*IE) *IE {
 	return newGroupedIE(OverloadControlInformation, 0, ies...)
 }
 
+// NewOverloadControlInformationFromFields creates a new OverloadControlInformation
+// IE from the given fields.
+func NewOverloadControlInformationFromFields(f *OverLoadControlInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewSequenceNumber(f.Sequence),
+		NewMetric(f.Metric),
+		NewTimer(f.PeriodOfValidity),
+	}
+	if f.HasOverloadControlInformationsFlags || f.OverloadControlInformationsFlags != 0 {
+		ies = append(ies, NewOCIFlags(f.OverloadControlInformationsFlags))
+	}
+
+	return NewOverloadControlInformation(ies...)
+}
+
 // OverloadControlInformation returns the IEs above OverloadControlInformation if the type of IE matches.
 func (i *IE) OverloadControlInformation() (*OverLoadControlInformationFields, error) {
 	if i.Type != OverloadControlInformation {
@@ -30,10 +49,11 @@ func (i *IE) OverloadControlInformation() (*OverLoadControlInformationFields, er
 
 // OverLoadControlInformationFields represents a fields contained in OverLoadControlInformation IE.
 type OverLoadControlInformationFields struct {
-	Sequence                         uint32
-	Metric                           uint8
-	PeriodOfValidity                 time.Duration
-	OverloadControlInformationsFlags uint8
+	Sequence                            uint32
+	Metric                              uint8
+	PeriodOfValidity                    time.Duration
+	OverloadControlInformationsFlags    uint8
+	HasOverloadControlInformationsFlags bool
 }
 
 // ParseOverLoadControlInformationFields creates a new OverLoadControlInformation IE.
@@ -81,6 +101,7 @@ func (l *OverLoadControlInformationFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			l.OverloadControlInformationsFlags = v
+			l.HasOverloadControlInformationsFlags = true
 		}
 	}
 	return nil
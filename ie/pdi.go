@@ -9,6 +9,60 @@ func NewPDI(ies ...*IE) *IE {
 	return newGroupedIE(PDI, 0, ies...)
 }
 
+// NewPDIFromFields creates a new PDI IE from the given fields.
+func NewPDIFromFields(f *PDIFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewSourceInterface(f.SourceInterface),
+		newFieldsIE(FTEID, f.LocalFTEID),
+		newFieldsIE(LocalIngressTunnel, f.LocalIngressTunnel),
+	}
+	if f.NetworkInstance != "" {
+		ies = append(ies, NewNetworkInstance(f.NetworkInstance))
+	}
+	ies = append(ies, NewRedundantTransmissionParametersFromFields(f.RedundantTransmissionParameters))
+	for _, v := range f.UEIPAddress {
+		ies = append(ies, newFieldsIE(UEIPAddress, v))
+	}
+	for _, v := range f.TrafficEndpointID {
+		ies = append(ies, NewTrafficEndpointID(v))
+	}
+	for _, v := range f.SDFFilter {
+		ies = append(ies, newFieldsIE(SDFFilter, v))
+	}
+	if f.ApplicationID != "" {
+		ies = append(ies, NewApplicationID(f.ApplicationID))
+	}
+	if f.HasEthernetPDUSessionInformation || f.EthernetPDUSessionInformation != 0 {
+		ies = append(ies, NewEthernetPDUSessionInformation(f.EthernetPDUSessionInformation))
+	}
+	ies = append(ies, NewEthernetPacketFilterFromFields(f.EthernetPacketFilter))
+	for _, v := range f.QFI {
+		ies = append(ies, NewQFI(v))
+	}
+	for _, v := range f.FramedRoute {
+		ies = append(ies, NewFramedRoute(v))
+	}
+	if f.HasFramedRouting || f.FramedRouting != 0 {
+		ies = append(ies, NewFramedRouting(f.FramedRouting))
+	}
+	if f.FramedIPv6Route != "" {
+		ies = append(ies, NewFramedIPv6Route(f.FramedIPv6Route))
+	}
+	if f.HasSourceInterfaceType || f.SourceInterfaceType != 0 {
+		ies = append(ies, NewTGPPInterfaceType(f.SourceInterfaceType))
+	}
+	for _, v := range f.IPMulticastAddressingInfo {
+		ies = append(ies, NewIPMulticastAddressingInfoFromFields(v))
+	}
+	ies = append(ies, f.DNSQueryFilter, f.MBSSession, f.AreaSessionID)
+
+	return NewPDI(ies...)
+}
+
 // PDI returns the IEs above PDI if the type of IE matches.
 func (i *IE) PDI() (*PDIFields, error) {
 	switch i.Type {
@@ -51,26 +105,29 @@ func (i *IE) PDI() (*PDIFields, error) {
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type PDIFields struct {
-	SourceInterface                 uint8
-	LocalFTEID                      *FTEIDFields
-	LocalIngressTunnel              *LocalIngressTunnelFields
-	NetworkInstance                 string
-	RedundantTransmissionParameters *RedundantTransmissionParametersField
-	UEIPAddress                     []*UEIPAddressFields
-	TrafficEndpointID               []uint8
-	SDFFilter                       []*SDFFilterFields
-	ApplicationID                   string
-	EthernetPDUSessionInformation   uint8
-	EthernetPacketFilter            *EthernetPacketFilterFields
-	QFI                             []uint8
-	FramedRoute                     []string
-	FramedRouting                   uint32
-	FramedIPv6Route                 string
-	SourceInterfaceType             uint8
-	IPMulticastAddressingInfo       []*IPMulticastAddressingInfoField
-	DNSQueryFilter                  *IE
-	MBSSession                      *IE
-	AreaSessionID                   *IE
+	SourceInterface                  uint8
+	LocalFTEID                       *FTEIDFields
+	LocalIngressTunnel               *LocalIngressTunnelFields
+	NetworkInstance                  string
+	RedundantTransmissionParameters  *RedundantTransmissionParametersField
+	UEIPAddress                      []*UEIPAddressFields
+	TrafficEndpointID                []uint8
+	SDFFilter                        []*SDFFilterFields
+	ApplicationID                    string
+	EthernetPDUSessionInformation    uint8
+	HasEthernetPDUSessionInformation bool
+	EthernetPacketFilter             *EthernetPacketFilterFields
+	QFI                              []uint8
+	FramedRoute                      []string
+	FramedRouting                    uint32
+	HasFramedRouting                 bool
+	FramedIPv6Route                  string
+	SourceInterfaceType              uint8
+	HasSourceInterfaceType           bool
+	IPMulticastAddressingInfo        []*IPMulticastAddressingInfoField
+	DNSQueryFilter                   *IE
+	MBSSession                       *IE
+	AreaSessionID                    *IE
 }
 
 // ParsePDIFields returns the IEs above PDI
@@ -155,6 +212,7 @@ func (p *PDIFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			p.EthernetPDUSessionInformation = v
+			p.HasEthernetPDUSessionInformation = true
 		case EthernetPacketFilter:
 			v, err := ie.EthernetPacketFilter()
 			if err != nil {
@@ -179,6 +237,7 @@ func (p *PDIFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			p.FramedRouting = v
+			p.HasFramedRouting = true
 		case FramedIPv6Route:
 			v, err := ie.FramedIPv6Route()
 			if err != nil {
@@ -190,14 +249,20 @@ func (p *PDIFields) ParseIEs(ies ...*IE) error {
 			if err != nil {
 				return err
 			}
-			p.SourceInterface = v
-
+			p.SourceInterfaceType = v
+			p.HasSourceInterfaceType = true
 		case IPMulticastAddressingInfo:
 			v, err := ie.IPMulticastAddressingInfo()
 			if err != nil {
 				return err
 			}
 			p.IPMulticastAddressingInfo = append(p.IPMulticastAddressingInfo, v)
+		case DNSQueryFilter:
+			p.DNSQueryFilter = ie
+		case MBSSessionIdentifier:
+			p.MBSSession = ie
+		case AreaSessionID:
+			p.AreaSessionID = ie
 		}
 	}
 	return nil
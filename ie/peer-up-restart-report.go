@@ -9,6 +9,16 @@ func NewPeerUPRestartReport(peer *IE) *IE {
 	return newGroupedIE(PeerUPRestartReport, 0, peer)
 }
 
+// NewPeerUPRestartReportFromFields creates a new PeerUPRestartReport IE from the
+// given fields.
+func NewPeerUPRestartReportFromFields(f *PeerUPRestartReportFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewPeerUPRestartReport(newFieldsIE(RemoteGTPUPeer, f.RemoteGTPUPeer))
+}
+
 // PeerUPRestartReport returns the IEs above PeerUPRestartReport if the type of IE matches.
 func (i *IE) PeerUPRestartReport() (*PeerUPRestartReportFields, error) {
 	if i.Type != PeerUPRestartReport {
@@ -9,6 +9,16 @@ func NewPFCPSessionRetentionInformation(cpIP *IE) *IE {
 	return newGroupedIE(PFCPSessionRetentionInformation, 0, cpIP)
 }
 
+// NewPFCPSessionRetentionInformationFromFields creates a new
+// PFCPSessionRetentionInformation IE from the given fields.
+func NewPFCPSessionRetentionInformationFromFields(f *PFCPSessionRetentionInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewPFCPSessionRetentionInformation(newFieldsIE(CPPFCPEntityIPAddress, f.CPPFCPEntityIPAddress))
+}
+
 // PFCPSessionRetentionInformation returns the IEs above PFCPSessionRetentionInformation if the type of IE matches.
 func (i *IE) PFCPSessionRetentionInformation() (*PFCPSessionRetentionInformationFields, error) {
 	if i.Type != PFCPSessionRetentionInformation {
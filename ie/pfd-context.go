@@ -9,6 +9,20 @@ func NewPFDContext(contents ...*IE) *IE {
 	return newGroupedIE(PFDContext, 0, contents...)
 }
 
+// NewPFDContextFromFields creates a new PFDContext IE from the given fields.
+func NewPFDContextFromFields(f *PFDContextFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := make([]*IE, 0, len(f.PFDContents))
+	for _, v := range f.PFDContents {
+		ies = append(ies, newFieldsIE(PFDContents, v))
+	}
+
+	return NewPFDContext(ies...)
+}
+
 // PFDContext returns the IEs above PFDContext if the type of IE matches.
 func (i *IE) PFDContext() (*PFDContextFields, error) {
 	switch i.Type {
@@ -168,13 +168,13 @@ func (f *PMFAddressInformationFields) UnmarshalBinary(b []byte) error {
 	if l < offset+6 {
 		return io.ErrUnexpectedEOF
 	}
-	copy(f.PMFMACAddressFor3GPPAccess, b[offset:offset+6])
+	f.PMFMACAddressFor3GPPAccess = net.HardwareAddr(b[offset : offset+6])
 	offset += 6
 
 	if l < offset+6 {
 		return io.ErrUnexpectedEOF
 	}
-	copy(f.PMFMACAddressForNon3GPPAccess, b[offset:offset+6])
+	f.PMFMACAddressForNon3GPPAccess = net.HardwareAddr(b[offset : offset+6])
 
 	return nil
 }
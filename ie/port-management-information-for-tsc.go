@@ -0,0 +1,46 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// PortManagementInformationForTSCFields is a set of fields in PortManagementInformationForTSC IE.
+//
+// Deprecated: The IE has been renamed to TSC Management Information in Rel-17.
+// Use TSCManagementInformationFields instead.
+type PortManagementInformationForTSCFields = TSCManagementInformationFields
+
+// NewPortManagementInformationForTSC creates a new PortManagementInformationForTSC IE.
+//
+// Deprecated: Use NewTSCManagementInformation instead.
+func NewPortManagementInformationForTSC(typ IEType, ies ...*IE) *IE {
+	return NewTSCManagementInformation(typ, ies...)
+}
+
+// NewPortManagementInformationForTSCWithinSessionModificationRequest creates a new PortManagementInformationForTSCWithinSessionModificationRequest IE.
+//
+// Deprecated: Use NewTSCManagementInformationWithinSessionModificationRequest instead.
+func NewPortManagementInformationForTSCWithinSessionModificationRequest(ies ...*IE) *IE {
+	return NewTSCManagementInformationWithinSessionModificationRequest(ies...)
+}
+
+// NewPortManagementInformationForTSCWithinSessionModificationResponse creates a new PortManagementInformationForTSCWithinSessionModificationResponse IE.
+//
+// Deprecated: Use NewTSCManagementInformationWithinSessionModificationResponse instead.
+func NewPortManagementInformationForTSCWithinSessionModificationResponse(ies ...*IE) *IE {
+	return NewTSCManagementInformationWithinSessionModificationResponse(ies...)
+}
+
+// NewPortManagementInformationForTSCWithinSessionReportRequest creates a new PortManagementInformationForTSCWithinSessionReportRequest IE.
+//
+// Deprecated: Use NewTSCManagementInformationWithinSessionReportRequest instead.
+func NewPortManagementInformationForTSCWithinSessionReportRequest(ies ...*IE) *IE {
+	return NewTSCManagementInformationWithinSessionReportRequest(ies...)
+}
+
+// PortManagementInformationForTSC returns the IEs above PortManagementInformationForTSC if the type of IE matches.
+//
+// Deprecated: Use TSCManagementInformation instead.
+func (i *IE) PortManagementInformationForTSC() (*PortManagementInformationForTSCFields, error) {
+	return i.TSCManagementInformation()
+}
@@ -0,0 +1,91 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewProtocolDescription creates a new ProtocolDescription IE.
+func NewProtocolDescription(ies ...*IE) *IE {
+	return newGroupedIE(ProtocolDescription, 0, ies...)
+}
+
+// NewProtocolDescriptionFromFields creates a new ProtocolDescription IE from the
+// given fields.
+func NewProtocolDescriptionFromFields(f *ProtocolDescriptionFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewMediaTransportProtocol(f.MediaTransportProtocol)}
+	for _, v := range f.RTPHeaderExtensionInformation {
+		ies = append(ies, NewRTPHeaderExtensionInformationFromFields(v))
+	}
+	for _, v := range f.RTPPayloadInformation {
+		ies = append(ies, NewRTPPayloadInformationFromFields(v))
+	}
+
+	return NewProtocolDescription(ies...)
+}
+
+// ProtocolDescription returns the IEs above ProtocolDescription if the type of IE matches.
+func (i *IE) ProtocolDescription() (*ProtocolDescriptionFields, error) {
+	if i.Type != ProtocolDescription {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return ParseProtocolDescriptionFields(i.Payload)
+}
+
+// ProtocolDescriptionFields is a set of fields in ProtocolDescription IE.
+//
+// The contained fields are of type struct, as they are too complex to handle with
+// existing (standard) types in Go.
+type ProtocolDescriptionFields struct {
+	MediaTransportProtocol        uint8
+	RTPHeaderExtensionInformation []*RTPHeaderExtensionInformationFields
+	RTPPayloadInformation         []*RTPPayloadInformationFields
+}
+
+// ParseProtocolDescriptionFields returns the IEs above ProtocolDescription.
+func ParseProtocolDescriptionFields(b []byte) (*ProtocolDescriptionFields, error) {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return nil, err
+	}
+	f := &ProtocolDescriptionFields{}
+	if err := f.ParseIEs(ies...); err != nil {
+		return f, err
+	}
+	return f, nil
+}
+
+// ParseIEs will iterator over all childs IE to avoid to use Parse or ParseMultiIEs any time we iterate in IE
+func (f *ProtocolDescriptionFields) ParseIEs(ies ...*IE) error {
+	for _, ie := range ies {
+		if ie == nil {
+			continue
+		}
+
+		switch ie.Type {
+		case MediaTransportProtocol:
+			v, err := ie.MediaTransportProtocol()
+			if err != nil {
+				return err
+			}
+			f.MediaTransportProtocol = v
+		case RTPHeaderExtensionInformation:
+			v, err := ie.RTPHeaderExtensionInformation()
+			if err != nil {
+				return err
+			}
+			f.RTPHeaderExtensionInformation = append(f.RTPHeaderExtensionInformation, v)
+		case RTPPayloadInformation:
+			v, err := ie.RTPPayloadInformation()
+			if err != nil {
+				return err
+			}
+			f.RTPPayloadInformation = append(f.RTPPayloadInformation, v)
+		}
+	}
+	return nil
+}
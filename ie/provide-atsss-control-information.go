@@ -26,6 +26,7 @@ type ProvideATSSSControlInformationFields struct {
 	MPTCPControlInformation   uint8
 	ATSSSLLControlInformation uint8
 	PMFControlInformation     uint8
+	MPQUICControlInformation  uint8
 }
 
 // ParseProvideATSSSControlInformationFields returns the IEs above ProvideATSSSControlInformation IE
@@ -59,6 +60,12 @@ func ParseProvideATSSSControlInformationFields(b []byte) (*ProvideATSSSControlIn
 				return p, err
 			}
 			p.PMFControlInformation = v
+		case MPQUICControlInformation:
+			v, err := ie.MPQUICControlInformation()
+			if err != nil {
+				return p, err
+			}
+			p.MPQUICControlInformation = v
 		}
 	}
 	return p, nil
@@ -11,6 +11,27 @@ func NewQoSInformationInGTPUPathQoSReport(ies ...*IE) *IE {
 	return newGroupedIE(QoSInformationInGTPUPathQoSReport, 0, ies...)
 }
 
+// NewQoSInformationInGTPUPathQoSReportFromFields creates a new
+// QoSInformationInGTPUPathQoSReport IE from the given fields.
+func NewQoSInformationInGTPUPathQoSReportFromFields(f *QoSInformationInGTPUPathQoSReportFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewAveragePacketDelay(f.AveragePacketDelayThreshold)}
+	if f.HasMinimumPacketDelayThreshold || f.MinimumPacketDelayThreshold != 0 {
+		ies = append(ies, NewMinimumPacketDelay(f.MinimumPacketDelayThreshold))
+	}
+	if f.HasMaximumPacketDelayThreshold || f.MaximumPacketDelayThreshold != 0 {
+		ies = append(ies, NewMaximumPacketDelay(f.MaximumPacketDelayThreshold))
+	}
+	if f.HasDSCP || f.DSCP != 0 {
+		ies = append(ies, NewTransportLevelMarking(f.DSCP))
+	}
+
+	return NewQoSInformationInGTPUPathQoSReport(ies...)
+}
+
 // QoSInformationInGTPUPathQoSReport returns the IEs above QoSInformationInGTPUPathQoSReport if the type of IE matches.
 func (i *IE) QoSInformationInGTPUPathQoSReport() (*QoSInformationInGTPUPathQoSReportFields, error) {
 	switch i.Type {
@@ -37,10 +58,13 @@ func (i *IE) QoSInformationInGTPUPathQoSReport() (*QoSInformationInGTPUPathQoSRe
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type QoSInformationInGTPUPathQoSReportFields struct {
-	AveragePacketDelayThreshold time.Duration
-	MinimumPacketDelayThreshold time.Duration
-	MaximumPacketDelayThreshold time.Duration
-	DSCP                        uint16
+	AveragePacketDelayThreshold    time.Duration
+	MinimumPacketDelayThreshold    time.Duration
+	HasMinimumPacketDelayThreshold bool
+	MaximumPacketDelayThreshold    time.Duration
+	HasMaximumPacketDelayThreshold bool
+	DSCP                           uint16
+	HasDSCP                        bool
 }
 
 // ParseQoSInformationInGTPUPathQoSReportFields returns the IEs above QoSInformationInGTPUPathQoSReport
@@ -70,6 +94,7 @@ func (far *QoSInformationInGTPUPathQoSReportFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			far.DSCP = v
+			far.HasDSCP = true
 		case AveragePacketDelay:
 			v, err := ie.AveragePacketDelay()
 			if err != nil {
@@ -82,12 +107,14 @@ func (far *QoSInformationInGTPUPathQoSReportFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			far.MinimumPacketDelayThreshold = v
+			far.HasMinimumPacketDelayThreshold = true
 		case MaximumPacketDelay:
 			v, err := ie.MaximumPacketDelay()
 			if err != nil {
 				return err
 			}
 			far.MaximumPacketDelayThreshold = v
+			far.HasMaximumPacketDelayThreshold = true
 		}
 	}
 	return nil
@@ -11,6 +11,29 @@ func NewQoSMonitoringPerQoSFlowControlInformation(ies ...*IE) *IE {
 	return newGroupedIE(QoSMonitoringPerQoSFlowControlInformation, 0, ies...)
 }
 
+// NewQoSMonitoringPerQoSFlowControlInformationFromFields creates a new
+// QoSMonitoringPerQoSFlowControlInformation IE from the given fields.
+func NewQoSMonitoringPerQoSFlowControlInformationFromFields(f *QoSMonitoringPerQoSFlowControlInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewQFI(f.QFI),
+		newUint8ValIE(RequestedQoSMonitoring, f.RequestedQoSMonitoring),
+		newUint8ValIE(ReportingFrequency, f.ReportingFrequency),
+		newFieldsIE(PacketDelayThresholds, f.PacketDelayThresholds),
+	}
+	if f.HasMinimumWaitTime || f.MinimumWaitTime != 0 {
+		ies = append(ies, NewMinimumWaitTime(f.MinimumWaitTime))
+	}
+	if f.HasMeasurementPeriod || f.MeasurementPeriod != 0 {
+		ies = append(ies, NewMeasurementPeriod(f.MeasurementPeriod))
+	}
+
+	return NewQoSMonitoringPerQoSFlowControlInformation(ies...)
+}
+
 // QoSMonitoringPerQoSFlowControlInformation returns the IEs above QoSMonitoringPerQoSFlowControlInformation if the type of IE matches.
 func (i *IE) QoSMonitoringPerQoSFlowControlInformation() (*QoSMonitoringPerQoSFlowControlInformationFields, error) {
 	switch i.Type {
@@ -49,7 +72,9 @@ type QoSMonitoringPerQoSFlowControlInformationFields struct {
 	ReportingFrequency     uint8
 	PacketDelayThresholds  *PacketDelayThresholdsFields
 	MinimumWaitTime        time.Duration
+	HasMinimumWaitTime     bool
 	MeasurementPeriod      time.Duration
+	HasMeasurementPeriod   bool
 }
 
 // ParseQoSMonitoringPerQoSFlowControlInformationFields returns the IEs above QoSMonitoringPerQoSFlowControlInformation
@@ -97,12 +122,14 @@ func ParseQoSMonitoringPerQoSFlowControlInformationFields(b []byte) (*QoSMonitor
 				return bar, err
 			}
 			bar.MinimumWaitTime = v
+			bar.HasMinimumWaitTime = true
 		case MeasurementPeriod:
 			v, err := ie.MeasurementPeriod()
 			if err != nil {
 				return bar, err
 			}
-			bar.MinimumWaitTime = v
+			bar.MeasurementPeriod = v
+			bar.HasMeasurementPeriod = true
 		}
 	}
 
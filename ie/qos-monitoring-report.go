@@ -11,6 +11,27 @@ func NewQoSMonitoringReport(ies ...*IE) *IE {
 	return newGroupedIE(QoSMonitoringReport, 0, ies...)
 }
 
+// NewQoSMonitoringReportFromFields creates a new QoSMonitoringReport IE from the
+// given fields.
+func NewQoSMonitoringReportFromFields(f *QoSMonitoringReportFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewQFI(f.QFI),
+		newFieldsIE(QoSMonitoringMeasurement, f.QoSMonitoringMeasurement),
+	}
+	if !f.Timestamp.IsZero() {
+		ies = append(ies, NewEventTimeStamp(f.Timestamp))
+	}
+	if !f.StartTime.IsZero() {
+		ies = append(ies, NewStartTime(f.StartTime))
+	}
+
+	return NewQoSMonitoringReport(ies...)
+}
+
 // QoSMonitoringReport returns the IEs above QoSMonitoringReport if the type of IE matches.
 func (i *IE) QoSMonitoringReport() (*QoSMonitoringReportFields, error) {
 	switch i.Type {
@@ -120,8 +120,13 @@ func (f *RedirectInformationFields) UnmarshalBinary(b []byte) error {
 		return io.ErrUnexpectedEOF
 	}
 	f.RedirectServerAddress = string(b[offset : offset+int(f.ServerAddrLength)])
+	offset += int(f.ServerAddrLength)
 
-	f.OtherServerAddrLength = binary.BigEndian.Uint16(b[1:3])
+	// Other Server Address Length may be absent in the IE encoded in older releases.
+	if l < offset+2 {
+		return nil
+	}
+	f.OtherServerAddrLength = binary.BigEndian.Uint16(b[offset : offset+2])
 	if f.OtherServerAddrLength != 0 {
 		offset += 2
 
@@ -9,6 +9,24 @@ func NewRedundantTransmissionForwardingParameters(ies ...*IE) *IE {
 	return newGroupedIE(RedundantTransmissionForwardingParameters, 0, ies...)
 }
 
+// NewRedundantTransmissionForwardingParametersFromFields creates a new
+// RedundantTransmissionForwardingParameters IE from the given fields.
+func NewRedundantTransmissionForwardingParametersFromFields(f *RedundantTransmissionForwardingParametersField) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		newFieldsIE(FTEID, f.LocalTeID),
+		newFieldsIE(OuterHeaderCreation, f.OuterHeaderCreation),
+	}
+	if f.NetworkInstance != "" {
+		ies = append(ies, NewNetworkInstance(f.NetworkInstance))
+	}
+
+	return NewRedundantTransmissionForwardingParameters(ies...)
+}
+
 // RedundantTransmissionForwardingParameters returns the IEs above RedundantTransmissionForwardingParameters if the type of IE matches.
 func (i *IE) RedundantTransmissionForwardingParameters() (*RedundantTransmissionForwardingParametersField, error) {
 	switch i.Type {
@@ -19,6 +19,24 @@ func NewRedundantTransmissionParametersInFAR(ohc, ni *IE) *IE {
 	return newGroupedIE(RedundantTransmissionParameters, 0, ohc, ni)
 }
 
+// NewRedundantTransmissionParametersFromFields creates a new
+// RedundantTransmissionParameters IE from the given fields.
+func NewRedundantTransmissionParametersFromFields(f *RedundantTransmissionParametersField) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		newFieldsIE(FTEID, f.LocalTeID),
+		newFieldsIE(OuterHeaderCreation, f.OuterHeaderCreation),
+	}
+	if f.NetworkInstance != "" {
+		ies = append(ies, NewNetworkInstance(f.NetworkInstance))
+	}
+
+	return NewRedundantTransmissionParameters(ies...)
+}
+
 // RedundantTransmissionParameters returns the IEs above RedundantTransmissionParameters if the type of IE matches.
 func (i *IE) RedundantTransmissionParameters() (*RedundantTransmissionParametersField, error) {
 	switch i.Type {
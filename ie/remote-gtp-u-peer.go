@@ -230,7 +230,7 @@ func (f *RemoteGTPUPeerFields) MarshalTo(b []byte) error {
 	offset := 1
 
 	if has2ndBit(f.Flags) {
-		copy(b[offset:offset+4], f.IPv4Address)
+		copy(b[offset:offset+4], f.IPv4Address.To4())
 		offset += 4
 	}
 
@@ -5,6 +5,16 @@ func NewRemoveMBSUnicastParameters(ies ...*IE) *IE {
 	return newGroupedIE(RemoveMBSUnicastParameters, 0, ies...)
 }
 
+// NewRemoveMBSUnicastParametersFromFields creates a new
+// RemoveMBSUnicastParameters IE from the given fields.
+func NewRemoveMBSUnicastParametersFromFields(f *RemoveMBSUnicastParametersFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewRemoveMBSUnicastParameters(NewMBSUnicastParametersID(f.MBSUnicastParametersID))
+}
+
 // RemoveMBSUnicastParameters returns the IEs above RemoveMBSUnicastParameters if the type of IE matches.
 func (i *IE) RemoveMBSUnicastParameters() (*RemoveMBSUnicastParametersFields, error) {
 	switch i.Type {
@@ -0,0 +1,102 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewReportingSuggestionInfo creates a new ReportingSuggestionInfo IE.
+//
+// The Reporting Time Info is included only if it is not zero.
+func NewReportingSuggestionInfo(urgency uint8, timeInfo uint32) *IE {
+	fields := NewReportingSuggestionInfoFields(urgency, timeInfo)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(ReportingSuggestionInfo, b)
+}
+
+// ReportingSuggestionInfo returns ReportingSuggestionInfo in structured format if the type of IE matches.
+func (i *IE) ReportingSuggestionInfo() (*ReportingSuggestionInfoFields, error) {
+	if i.Type != ReportingSuggestionInfo {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return ParseReportingSuggestionInfoFields(i.Payload)
+}
+
+// ReportingSuggestionInfoFields represents a fields contained in ReportingSuggestionInfo IE.
+type ReportingSuggestionInfoFields struct {
+	ReportingUrgency  uint8
+	ReportingTimeInfo uint32
+}
+
+// NewReportingSuggestionInfoFields creates a new ReportingSuggestionInfoFields.
+func NewReportingSuggestionInfoFields(urgency uint8, timeInfo uint32) *ReportingSuggestionInfoFields {
+	return &ReportingSuggestionInfoFields{
+		ReportingUrgency:  urgency & 0x0f,
+		ReportingTimeInfo: timeInfo,
+	}
+}
+
+// ParseReportingSuggestionInfoFields parses b into ReportingSuggestionInfoFields.
+func ParseReportingSuggestionInfoFields(b []byte) (*ReportingSuggestionInfoFields, error) {
+	f := &ReportingSuggestionInfoFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *ReportingSuggestionInfoFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.ReportingUrgency = b[0] & 0x0f
+	if l >= 5 {
+		f.ReportingTimeInfo = binary.BigEndian.Uint32(b[1:5])
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of ReportingSuggestionInfoFields.
+func (f *ReportingSuggestionInfoFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *ReportingSuggestionInfoFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.ReportingUrgency & 0x0f
+	if f.ReportingTimeInfo != 0 {
+		binary.BigEndian.PutUint32(b[1:5], f.ReportingTimeInfo)
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *ReportingSuggestionInfoFields) MarshalLen() int {
+	if f.ReportingTimeInfo != 0 {
+		return 5
+	}
+	return 1
+}
@@ -0,0 +1,139 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewReportingThresholds creates a new ReportingThresholds IE.
+//
+// Each threshold is the percentage of congestion, and is included only if it
+// is not zero.
+func NewReportingThresholds(dl, ul uint8) *IE {
+	fields := NewReportingThresholdsFields(dl, ul)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(ReportingThresholds, b)
+}
+
+// ReportingThresholds returns ReportingThresholds in structured format if the type of IE matches.
+func (i *IE) ReportingThresholds() (*ReportingThresholdsFields, error) {
+	if i.Type != ReportingThresholds {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return ParseReportingThresholdsFields(i.Payload)
+}
+
+// ReportingThresholdsFields represents a fields contained in ReportingThresholds IE.
+type ReportingThresholdsFields struct {
+	Flags                            uint8
+	DLCongestionInformationThreshold uint8
+	ULCongestionInformationThreshold uint8
+}
+
+// NewReportingThresholdsFields creates a new ReportingThresholdsFields.
+func NewReportingThresholdsFields(dl, ul uint8) *ReportingThresholdsFields {
+	f := &ReportingThresholdsFields{}
+	if dl != 0 {
+		f.Flags |= 0x01
+		f.DLCongestionInformationThreshold = dl
+	}
+	if ul != 0 {
+		f.Flags |= 0x02
+		f.ULCongestionInformationThreshold = ul
+	}
+	return f
+}
+
+// HasDLCI reports whether DLCI flag is set.
+func (f *ReportingThresholdsFields) HasDLCI() bool {
+	return has1stBit(f.Flags)
+}
+
+// HasULCI reports whether ULCI flag is set.
+func (f *ReportingThresholdsFields) HasULCI() bool {
+	return has2ndBit(f.Flags)
+}
+
+// ParseReportingThresholdsFields parses b into ReportingThresholdsFields.
+func ParseReportingThresholdsFields(b []byte) (*ReportingThresholdsFields, error) {
+	f := &ReportingThresholdsFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *ReportingThresholdsFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	offset := 1
+
+	if f.HasDLCI() {
+		if l < offset+1 {
+			return io.ErrUnexpectedEOF
+		}
+		f.DLCongestionInformationThreshold = b[offset]
+		offset++
+	}
+	if f.HasULCI() {
+		if l < offset+1 {
+			return io.ErrUnexpectedEOF
+		}
+		f.ULCongestionInformationThreshold = b[offset]
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of ReportingThresholdsFields.
+func (f *ReportingThresholdsFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *ReportingThresholdsFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	offset := 1
+
+	if f.HasDLCI() {
+		b[offset] = f.DLCongestionInformationThreshold
+		offset++
+	}
+	if f.HasULCI() {
+		b[offset] = f.ULCongestionInformationThreshold
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *ReportingThresholdsFields) MarshalLen() int {
+	l := 1
+	if f.HasDLCI() {
+		l++
+	}
+	if f.HasULCI() {
+		l++
+	}
+	return l
+}
@@ -0,0 +1,26 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewRTPHeaderExtensionAdditionalInformation creates a new RTPHeaderExtensionAdditionalInformation IE.
+func NewRTPHeaderExtensionAdditionalInformation(flags uint8) *IE {
+	return newUint8ValIE(RTPHeaderExtensionAdditionalInformation, flags)
+}
+
+// RTPHeaderExtensionAdditionalInformation returns RTPHeaderExtensionAdditionalInformation in uint8 if the type of IE matches.
+func (i *IE) RTPHeaderExtensionAdditionalInformation() (uint8, error) {
+	switch i.Type {
+	case RTPHeaderExtensionAdditionalInformation:
+		return i.ValueAsUint8()
+	case RTPHeaderExtensionInformation:
+		ies, err := i.RTPHeaderExtensionInformation()
+		if err != nil {
+			return 0, err
+		}
+		return ies.RTPHeaderExtensionAdditionalInformation, nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
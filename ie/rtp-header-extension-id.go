@@ -0,0 +1,26 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewRTPHeaderExtensionID creates a new RTPHeaderExtensionID IE.
+func NewRTPHeaderExtensionID(id uint8) *IE {
+	return newUint8ValIE(RTPHeaderExtensionID, id)
+}
+
+// RTPHeaderExtensionID returns RTPHeaderExtensionID in uint8 if the type of IE matches.
+func (i *IE) RTPHeaderExtensionID() (uint8, error) {
+	switch i.Type {
+	case RTPHeaderExtensionID:
+		return i.ValueAsUint8()
+	case RTPHeaderExtensionInformation:
+		ies, err := i.RTPHeaderExtensionInformation()
+		if err != nil {
+			return 0, err
+		}
+		return ies.RTPHeaderExtensionID, nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
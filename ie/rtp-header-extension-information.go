@@ -0,0 +1,93 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewRTPHeaderExtensionInformation creates a new RTPHeaderExtensionInformation IE.
+func NewRTPHeaderExtensionInformation(ies ...*IE) *IE {
+	return newGroupedIE(RTPHeaderExtensionInformation, 0, ies...)
+}
+
+// NewRTPHeaderExtensionInformationFromFields creates a new
+// RTPHeaderExtensionInformation IE from the given fields.
+func NewRTPHeaderExtensionInformationFromFields(f *RTPHeaderExtensionInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewRTPHeaderExtensionType(f.RTPHeaderExtensionType),
+		NewRTPHeaderExtensionID(f.RTPHeaderExtensionID),
+	}
+	if f.HasRTPHeaderExtensionAdditionalInformation || f.RTPHeaderExtensionAdditionalInformation != 0 {
+		ies = append(ies, NewRTPHeaderExtensionAdditionalInformation(f.RTPHeaderExtensionAdditionalInformation))
+	}
+
+	return NewRTPHeaderExtensionInformation(ies...)
+}
+
+// RTPHeaderExtensionInformation returns the IEs above RTPHeaderExtensionInformation if the type of IE matches.
+func (i *IE) RTPHeaderExtensionInformation() (*RTPHeaderExtensionInformationFields, error) {
+	if i.Type != RTPHeaderExtensionInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return ParseRTPHeaderExtensionInformationFields(i.Payload)
+}
+
+// RTPHeaderExtensionInformationFields is a set of fields in RTPHeaderExtensionInformation IE.
+//
+// The contained fields are of type struct, as they are too complex to handle with
+// existing (standard) types in Go.
+type RTPHeaderExtensionInformationFields struct {
+	RTPHeaderExtensionType                     uint8
+	RTPHeaderExtensionID                       uint8
+	RTPHeaderExtensionAdditionalInformation    uint8
+	HasRTPHeaderExtensionAdditionalInformation bool
+}
+
+// ParseRTPHeaderExtensionInformationFields returns the IEs above RTPHeaderExtensionInformation.
+func ParseRTPHeaderExtensionInformationFields(b []byte) (*RTPHeaderExtensionInformationFields, error) {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return nil, err
+	}
+	f := &RTPHeaderExtensionInformationFields{}
+	if err := f.ParseIEs(ies...); err != nil {
+		return f, err
+	}
+	return f, nil
+}
+
+// ParseIEs will iterator over all childs IE to avoid to use Parse or ParseMultiIEs any time we iterate in IE
+func (f *RTPHeaderExtensionInformationFields) ParseIEs(ies ...*IE) error {
+	for _, ie := range ies {
+		if ie == nil {
+			continue
+		}
+
+		switch ie.Type {
+		case RTPHeaderExtensionType:
+			v, err := ie.RTPHeaderExtensionType()
+			if err != nil {
+				return err
+			}
+			f.RTPHeaderExtensionType = v
+		case RTPHeaderExtensionID:
+			v, err := ie.RTPHeaderExtensionID()
+			if err != nil {
+				return err
+			}
+			f.RTPHeaderExtensionID = v
+		case RTPHeaderExtensionAdditionalInformation:
+			v, err := ie.RTPHeaderExtensionAdditionalInformation()
+			if err != nil {
+				return err
+			}
+			f.RTPHeaderExtensionAdditionalInformation = v
+			f.HasRTPHeaderExtensionAdditionalInformation = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewRTPHeaderExtensionType creates a new RTPHeaderExtensionType IE.
+func NewRTPHeaderExtensionType(typ uint8) *IE {
+	return newUint8ValIE(RTPHeaderExtensionType, typ)
+}
+
+// RTPHeaderExtensionType returns RTPHeaderExtensionType in uint8 if the type of IE matches.
+func (i *IE) RTPHeaderExtensionType() (uint8, error) {
+	switch i.Type {
+	case RTPHeaderExtensionType:
+		return i.ValueAsUint8()
+	case RTPHeaderExtensionInformation:
+		ies, err := i.RTPHeaderExtensionInformation()
+		if err != nil {
+			return 0, err
+		}
+		return ies.RTPHeaderExtensionType, nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
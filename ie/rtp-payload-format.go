@@ -0,0 +1,26 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewRTPPayloadFormat creates a new RTPPayloadFormat IE.
+func NewRTPPayloadFormat(format uint8) *IE {
+	return newUint8ValIE(RTPPayloadFormat, format)
+}
+
+// RTPPayloadFormat returns RTPPayloadFormat in uint8 if the type of IE matches.
+func (i *IE) RTPPayloadFormat() (uint8, error) {
+	switch i.Type {
+	case RTPPayloadFormat:
+		return i.ValueAsUint8()
+	case RTPPayloadInformation:
+		ies, err := i.RTPPayloadInformation()
+		if err != nil {
+			return 0, err
+		}
+		return ies.RTPPayloadFormat, nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewRTPPayloadInformation creates a new RTPPayloadInformation IE.
+func NewRTPPayloadInformation(ies ...*IE) *IE {
+	return newGroupedIE(RTPPayloadInformation, 0, ies...)
+}
+
+// NewRTPPayloadInformationFromFields creates a new RTPPayloadInformation IE from
+// the given fields.
+func NewRTPPayloadInformationFromFields(f *RTPPayloadInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewRTPPayloadType(f.RTPPayloadType)}
+	if f.HasRTPPayloadFormat || f.RTPPayloadFormat != 0 {
+		ies = append(ies, NewRTPPayloadFormat(f.RTPPayloadFormat))
+	}
+
+	return NewRTPPayloadInformation(ies...)
+}
+
+// RTPPayloadInformation returns the IEs above RTPPayloadInformation if the type of IE matches.
+func (i *IE) RTPPayloadInformation() (*RTPPayloadInformationFields, error) {
+	if i.Type != RTPPayloadInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return ParseRTPPayloadInformationFields(i.Payload)
+}
+
+// RTPPayloadInformationFields is a set of fields in RTPPayloadInformation IE.
+//
+// The contained fields are of type struct, as they are too complex to handle with
+// existing (standard) types in Go.
+type RTPPayloadInformationFields struct {
+	RTPPayloadType      uint8
+	RTPPayloadFormat    uint8
+	HasRTPPayloadFormat bool
+}
+
+// ParseRTPPayloadInformationFields returns the IEs above RTPPayloadInformation.
+func ParseRTPPayloadInformationFields(b []byte) (*RTPPayloadInformationFields, error) {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return nil, err
+	}
+	f := &RTPPayloadInformationFields{}
+	if err := f.ParseIEs(ies...); err != nil {
+		return f, err
+	}
+	return f, nil
+}
+
+// ParseIEs will iterator over all childs IE to avoid to use Parse or ParseMultiIEs any time we iterate in IE
+func (f *RTPPayloadInformationFields) ParseIEs(ies ...*IE) error {
+	for _, ie := range ies {
+		if ie == nil {
+			continue
+		}
+
+		switch ie.Type {
+		case RTPPayloadType:
+			v, err := ie.RTPPayloadType()
+			if err != nil {
+				return err
+			}
+			f.RTPPayloadType = v
+		case RTPPayloadFormat:
+			v, err := ie.RTPPayloadFormat()
+			if err != nil {
+				return err
+			}
+			f.RTPPayloadFormat = v
+			f.HasRTPPayloadFormat = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewRTPPayloadType creates a new RTPPayloadType IE.
+func NewRTPPayloadType(typ uint8) *IE {
+	return newUint8ValIE(RTPPayloadType, typ)
+}
+
+// RTPPayloadType returns RTPPayloadType in uint8 if the type of IE matches.
+func (i *IE) RTPPayloadType() (uint8, error) {
+	switch i.Type {
+	case RTPPayloadType:
+		return i.ValueAsUint8()
+	case RTPPayloadInformation:
+		ies, err := i.RTPPayloadInformation()
+		if err != nil {
+			return 0, err
+		}
+		return ies.RTPPayloadType, nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
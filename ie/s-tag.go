@@ -151,7 +151,8 @@ func (f *STAGFields) UnmarshalBinary(b []byte) error {
 	}
 
 	if f.HasVID() {
-		f.CVID = binary.BigEndian.Uint16(b[offset:offset+2]) & 0xf0ff
+		v := binary.BigEndian.Uint16(b[offset : offset+2])
+		f.CVID = (v>>4)&0x0f00 | v&0x00ff
 	}
 
 	return nil
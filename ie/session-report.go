@@ -9,6 +9,20 @@ func NewSessionReport(ies ...*IE) *IE {
 	return newGroupedIE(SessionReport, 0, ies...)
 }
 
+// NewSessionReportFromFields creates a new SessionReport IE from the given fields.
+func NewSessionReportFromFields(f *SessionReportFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewSessionReport(
+		NewSRRID(f.SRRID),
+		NewAccessAvailabilityControlInformationFromFields(f.AccessAvailabilityControlInformation),
+		NewAccessAvailabilityReportFromFields(f.AccessAvailabilityReport),
+		NewQoSMonitoringReportFromFields(f.QoSMonitoringReport),
+	)
+}
+
 // SessionReport returns the IEs above SessionReport if the type of IE matches.
 func (i *IE) SessionReport() (*SessionReportFields, error) {
 	switch i.Type {
@@ -33,9 +47,10 @@ func (i *IE) SessionReport() (*SessionReportFields, error) {
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type SessionReportFields struct {
-	SRRID                    uint8
-	AccessAvailabilityReport *AccessAvailabilityReportFields
-	QoSMonitoringReport      *QoSMonitoringReportFields
+	SRRID                                uint8
+	AccessAvailabilityControlInformation *AccessAvailabilityControlInformationFields
+	AccessAvailabilityReport             *AccessAvailabilityReportFields
+	QoSMonitoringReport                  *QoSMonitoringReportFields
 }
 
 // ParseSessionReportFields returns the IEs above SessionReport
@@ -65,6 +80,12 @@ func (s *SessionReportFields) ParseIEs(ies ...*IE) error {
 				return nil
 			}
 			s.SRRID = v
+		case AccessAvailabilityControlInformation:
+			v, err := i.AccessAvailabilityControlInformation()
+			if err != nil {
+				return nil
+			}
+			s.AccessAvailabilityControlInformation = v
 		case AccessAvailabilityReport:
 			v, err := i.AccessAvailabilityReport()
 			if err != nil {
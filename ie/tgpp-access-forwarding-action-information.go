@@ -9,6 +9,38 @@ func NewTGPPAccessForwardingActionInformation(ies ...*IE) *IE {
 	return newGroupedIE(TGPPAccessForwardingActionInformation, 0, ies...)
 }
 
+// NewTGPPAccessForwardingActionInformationFromFields creates a new TGPPAccessForwardingActionInformation IE
+// from the given fields.
+func NewTGPPAccessForwardingActionInformationFromFields(f *TGPPAccessForwardingActionInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewTGPPAccessForwardingActionInformation(accessForwardingActionInformationIEs(f)...)
+}
+
+// accessForwardingActionInformationIEs returns the IEs in the (Update) 3GPP and
+// Non-3GPP Access Forwarding Action Information IEs, which share the same fields.
+func accessForwardingActionInformationIEs(f *TGPPAccessForwardingActionInformationFields) []*IE {
+	var ies []*IE
+	if f.HasFARID || f.FARID != 0 {
+		ies = append(ies, NewFARID(f.FARID))
+	}
+	if f.HasWeight || f.Weight != 0 {
+		ies = append(ies, NewWeight(f.Weight))
+	}
+	if f.HasPriority || f.Priority != 0 {
+		ies = append(ies, NewPriority(f.Priority))
+	}
+	if f.HasURRID || f.URRID != 0 {
+		ies = append(ies, NewURRID(f.URRID))
+	}
+	if f.HasRATType || f.RATType != 0 {
+		ies = append(ies, NewRATType(f.RATType))
+	}
+	return ies
+}
+
 // TGPPAccessForwardingActionInformation returns the IEs above TGPPAccessForwardingActionInformation if the type of IE matches.
 func (i *IE) TGPPAccessForwardingActionInformation() (*TGPPAccessForwardingActionInformationFields, error) {
 	switch i.Type {
@@ -51,11 +83,16 @@ func (i *IE) TGPPAccessForwardingActionInformation() (*TGPPAccessForwardingActio
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type TGPPAccessForwardingActionInformationFields struct {
-	FARID    uint32
-	Weight   uint8
-	Priority uint8
-	URRID    uint32
-	RATType  uint8
+	FARID       uint32
+	HasFARID    bool
+	Weight      uint8
+	HasWeight   bool
+	Priority    uint8
+	HasPriority bool
+	URRID       uint32
+	HasURRID    bool
+	RATType     uint8
+	HasRATType  bool
 }
 
 // TGPPAccessForwardingActionInformation returns the IEs above TGPPAccessForwardingActionInformation.
@@ -86,30 +123,35 @@ func (t *TGPPAccessForwardingActionInformationFields) ParseIEs(ies ...*IE) error
 				return err
 			}
 			t.FARID = v
+			t.HasFARID = true
 		case Weight:
 			v, err := ie.Weight()
 			if err != nil {
 				return err
 			}
 			t.Weight = v
+			t.HasWeight = true
 		case Priority:
 			v, err := ie.Priority()
 			if err != nil {
 				return err
 			}
 			t.Priority = v
+			t.HasPriority = true
 		case URRID:
 			v, err := ie.URRID()
 			if err != nil {
 				return err
 			}
 			t.URRID = v
+			t.HasURRID = true
 		case RATType:
 			v, err := ie.RATType()
 			if err != nil {
 				return err
 			}
 			t.RATType = v
+			t.HasRATType = true
 		}
 	}
 	return nil
@@ -0,0 +1,19 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewTLContainer creates a new TLContainer IE.
+func NewTLContainer(container []byte) *IE {
+	return New(TLContainer, container)
+}
+
+// TLContainer returns TLContainer in []byte if the type of IE matches.
+func (i *IE) TLContainer() ([]byte, error) {
+	if i.Type != TLContainer {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.Payload, nil
+}
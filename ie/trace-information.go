@@ -108,7 +108,7 @@ func (f *TraceInformationFields) UnmarshalBinary(b []byte) error {
 	if l < offset+int(f.TriggeringEventsLength) {
 		return io.ErrUnexpectedEOF
 	}
-	copy(f.TriggeringEvents, b[offset:offset+int(f.TriggeringEventsLength)])
+	f.TriggeringEvents = b[offset : offset+int(f.TriggeringEventsLength)]
 	offset += int(f.TriggeringEventsLength)
 
 	if l < offset {
@@ -126,7 +126,7 @@ func (f *TraceInformationFields) UnmarshalBinary(b []byte) error {
 	if l < offset+int(f.ListOfInterfacesLength) {
 		return io.ErrUnexpectedEOF
 	}
-	copy(f.ListOfInterfaces, b[offset:offset+int(f.ListOfInterfacesLength)])
+	f.ListOfInterfaces = b[offset : offset+int(f.ListOfInterfacesLength)]
 	offset += int(f.ListOfInterfacesLength)
 
 	if l < offset {
@@ -138,7 +138,7 @@ func (f *TraceInformationFields) UnmarshalBinary(b []byte) error {
 	if l < offset+int(f.IPAddressOfTraceCollectionEntityLength) {
 		return io.ErrUnexpectedEOF
 	}
-	copy(f.IPAddressOfTraceCollectionEntity, b[offset:offset+int(f.IPAddressOfTraceCollectionEntityLength)])
+	f.IPAddressOfTraceCollectionEntity = net.IP(b[offset : offset+int(f.IPAddressOfTraceCollectionEntityLength)])
 
 	return nil
 }
@@ -206,7 +206,11 @@ func (f *TraceInformationFields) MarshalTo(b []byte) error {
 	if l < offset+int(f.IPAddressOfTraceCollectionEntityLength) {
 		return io.ErrUnexpectedEOF
 	}
-	copy(b[offset:offset+int(f.IPAddressOfTraceCollectionEntityLength)], f.IPAddressOfTraceCollectionEntity)
+	ip := f.IPAddressOfTraceCollectionEntity
+	if v4 := ip.To4(); v4 != nil && f.IPAddressOfTraceCollectionEntityLength == net.IPv4len {
+		ip = v4
+	}
+	copy(b[offset:offset+int(f.IPAddressOfTraceCollectionEntityLength)], ip)
 
 	return nil
 }
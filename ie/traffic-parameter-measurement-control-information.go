@@ -0,0 +1,95 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "time"
+
+// NewTrafficParameterMeasurementControlInformation creates a new TrafficParameterMeasurementControlInformation IE.
+func NewTrafficParameterMeasurementControlInformation(ies ...*IE) *IE {
+	return newGroupedIE(TrafficParameterMeasurementControlInformation, 0, ies...)
+}
+
+// NewTrafficParameterMeasurementControlInformationFromFields creates a new
+// TrafficParameterMeasurementControlInformation IE from the given fields.
+func NewTrafficParameterMeasurementControlInformationFromFields(f *TrafficParameterMeasurementControlInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewTrafficParameterMeasurementIndication(f.TrafficParameterMeasurementIndication),
+		newFieldsIE(TrafficParameterThreshold, f.TrafficParameterThreshold),
+	}
+	if f.HasMeasurementPeriod || f.MeasurementPeriod != 0 {
+		ies = append(ies, NewMeasurementPeriod(f.MeasurementPeriod))
+	}
+
+	return NewTrafficParameterMeasurementControlInformation(ies...)
+}
+
+// TrafficParameterMeasurementControlInformation returns the IEs above TrafficParameterMeasurementControlInformation if the type of IE matches.
+func (i *IE) TrafficParameterMeasurementControlInformation() (*TrafficParameterMeasurementControlInformationFields, error) {
+	if i.Type != TrafficParameterMeasurementControlInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return ParseTrafficParameterMeasurementControlInformationFields(i.Payload)
+}
+
+// TrafficParameterMeasurementControlInformationFields is a set of fields in TrafficParameterMeasurementControlInformation IE.
+//
+// The contained fields are of type struct, as they are too complex to handle with
+// existing (standard) types in Go.
+type TrafficParameterMeasurementControlInformationFields struct {
+	TrafficParameterMeasurementIndication uint8
+	TrafficParameterThreshold             *TrafficParameterThresholdFields
+	MeasurementPeriod                     time.Duration
+	HasMeasurementPeriod                  bool
+}
+
+// ParseTrafficParameterMeasurementControlInformationFields returns the IEs above TrafficParameterMeasurementControlInformation.
+func ParseTrafficParameterMeasurementControlInformationFields(b []byte) (*TrafficParameterMeasurementControlInformationFields, error) {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return nil, err
+	}
+	f := &TrafficParameterMeasurementControlInformationFields{}
+	if err := f.ParseIEs(ies...); err != nil {
+		return f, err
+	}
+	return f, nil
+}
+
+// ParseIEs will iterator over all childs IE to avoid to use Parse or ParseMultiIEs any time we iterate in IE
+func (f *TrafficParameterMeasurementControlInformationFields) ParseIEs(ies ...*IE) error {
+	for _, ie := range ies {
+		if ie == nil {
+			continue
+		}
+
+		switch ie.Type {
+		case TrafficParameterMeasurementIndication:
+			v, err := ie.TrafficParameterMeasurementIndication()
+			if err != nil {
+				return err
+			}
+			f.TrafficParameterMeasurementIndication = v
+		case TrafficParameterThreshold:
+			v, err := ie.TrafficParameterThreshold()
+			if err != nil {
+				return err
+			}
+			f.TrafficParameterThreshold = v
+		case MeasurementPeriod:
+			v, err := ie.MeasurementPeriod()
+			if err != nil {
+				return err
+			}
+			f.MeasurementPeriod = v
+			f.HasMeasurementPeriod = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewTrafficParameterMeasurementIndication creates a new TrafficParameterMeasurementIndication IE.
+func NewTrafficParameterMeasurementIndication(flags uint8) *IE {
+	return newUint8ValIE(TrafficParameterMeasurementIndication, flags)
+}
+
+// TrafficParameterMeasurementIndication returns TrafficParameterMeasurementIndication in uint8 if the type of IE matches.
+func (i *IE) TrafficParameterMeasurementIndication() (uint8, error) {
+	switch i.Type {
+	case TrafficParameterMeasurementIndication:
+		return i.ValueAsUint8()
+	case TrafficParameterMeasurementControlInformation:
+		ies, err := i.TrafficParameterMeasurementControlInformation()
+		if err != nil {
+			return 0, err
+		}
+		return ies.TrafficParameterMeasurementIndication, nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewTrafficParameterMeasurementReport creates a new TrafficParameterMeasurementReport IE.
+func NewTrafficParameterMeasurementReport(ies ...*IE) *IE {
+	return newGroupedIE(TrafficParameterMeasurementReport, 0, ies...)
+}
+
+// NewTrafficParameterMeasurementReportFromFields creates a new
+// TrafficParameterMeasurementReport IE from the given fields.
+func NewTrafficParameterMeasurementReportFromFields(f *TrafficParameterMeasurementReportFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewQFI(f.QFI),
+		newFieldsIE(N6JitterMeasurement, f.N6JitterMeasurement),
+	}
+	if f.HasDLPeriodicity || f.DLPeriodicity != 0 {
+		ies = append(ies, NewDLPeriodicity(f.DLPeriodicity))
+	}
+	if f.HasULPeriodicity || f.ULPeriodicity != 0 {
+		ies = append(ies, NewULPeriodicity(f.ULPeriodicity))
+	}
+
+	return NewTrafficParameterMeasurementReport(ies...)
+}
+
+// TrafficParameterMeasurementReport returns the IEs above TrafficParameterMeasurementReport if the type of IE matches.
+func (i *IE) TrafficParameterMeasurementReport() (*TrafficParameterMeasurementReportFields, error) {
+	if i.Type != TrafficParameterMeasurementReport {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return ParseTrafficParameterMeasurementReportFields(i.Payload)
+}
+
+// TrafficParameterMeasurementReportFields is a set of fields in TrafficParameterMeasurementReport IE.
+//
+// The contained fields are of type struct, as they are too complex to handle with
+// existing (standard) types in Go.
+type TrafficParameterMeasurementReportFields struct {
+	QFI                 uint8
+	N6JitterMeasurement *N6JitterMeasurementFields
+	DLPeriodicity       uint32
+	HasDLPeriodicity    bool
+	ULPeriodicity       uint32
+	HasULPeriodicity    bool
+}
+
+// ParseTrafficParameterMeasurementReportFields returns the IEs above TrafficParameterMeasurementReport.
+func ParseTrafficParameterMeasurementReportFields(b []byte) (*TrafficParameterMeasurementReportFields, error) {
+	ies, err := ParseMultiIEs(b)
+	if err != nil {
+		return nil, err
+	}
+	f := &TrafficParameterMeasurementReportFields{}
+	if err := f.ParseIEs(ies...); err != nil {
+		return f, err
+	}
+	return f, nil
+}
+
+// ParseIEs will iterator over all childs IE to avoid to use Parse or ParseMultiIEs any time we iterate in IE
+func (f *TrafficParameterMeasurementReportFields) ParseIEs(ies ...*IE) error {
+	for _, ie := range ies {
+		if ie == nil {
+			continue
+		}
+
+		switch ie.Type {
+		case QFI:
+			v, err := ie.QFI()
+			if err != nil {
+				return err
+			}
+			f.QFI = v
+		case N6JitterMeasurement:
+			v, err := ie.N6JitterMeasurement()
+			if err != nil {
+				return err
+			}
+			f.N6JitterMeasurement = v
+		case DLPeriodicity:
+			v, err := ie.DLPeriodicity()
+			if err != nil {
+				return err
+			}
+			f.DLPeriodicity = v
+			f.HasDLPeriodicity = true
+		case ULPeriodicity:
+			v, err := ie.ULPeriodicity()
+			if err != nil {
+				return err
+			}
+			f.ULPeriodicity = v
+			f.HasULPeriodicity = true
+		}
+	}
+	return nil
+}
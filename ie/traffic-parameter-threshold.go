@@ -0,0 +1,122 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewTrafficParameterThreshold creates a new TrafficParameterThreshold IE.
+//
+// The DL N6 Jitter Threshold is included only if it is not zero.
+func NewTrafficParameterThreshold(dlN6Jitter uint32) *IE {
+	fields := NewTrafficParameterThresholdFields(dlN6Jitter)
+
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(TrafficParameterThreshold, b)
+}
+
+// TrafficParameterThreshold returns TrafficParameterThreshold in structured format if the type of IE matches.
+func (i *IE) TrafficParameterThreshold() (*TrafficParameterThresholdFields, error) {
+	switch i.Type {
+	case TrafficParameterThreshold:
+		return ParseTrafficParameterThresholdFields(i.Payload)
+	case TrafficParameterMeasurementControlInformation:
+		ies, err := i.TrafficParameterMeasurementControlInformation()
+		if err != nil {
+			return nil, err
+		}
+		if ies.TrafficParameterThreshold != nil {
+			return ies.TrafficParameterThreshold, nil
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// TrafficParameterThresholdFields represents a fields contained in TrafficParameterThreshold IE.
+type TrafficParameterThresholdFields struct {
+	Flags               uint8
+	DLN6JitterThreshold uint32
+}
+
+// NewTrafficParameterThresholdFields creates a new TrafficParameterThresholdFields.
+func NewTrafficParameterThresholdFields(dlN6Jitter uint32) *TrafficParameterThresholdFields {
+	f := &TrafficParameterThresholdFields{}
+	if dlN6Jitter != 0 {
+		f.Flags |= 0x01
+		f.DLN6JitterThreshold = dlN6Jitter
+	}
+	return f
+}
+
+// HasDL reports whether DL flag is set.
+func (f *TrafficParameterThresholdFields) HasDL() bool {
+	return has1stBit(f.Flags)
+}
+
+// ParseTrafficParameterThresholdFields parses b into TrafficParameterThresholdFields.
+func ParseTrafficParameterThresholdFields(b []byte) (*TrafficParameterThresholdFields, error) {
+	f := &TrafficParameterThresholdFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary parses b into IE.
+func (f *TrafficParameterThresholdFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	if f.HasDL() {
+		if l < 5 {
+			return io.ErrUnexpectedEOF
+		}
+		f.DLN6JitterThreshold = binary.BigEndian.Uint32(b[1:5])
+	}
+
+	return nil
+}
+
+// Marshal returns the serialized bytes of TrafficParameterThresholdFields.
+func (f *TrafficParameterThresholdFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *TrafficParameterThresholdFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	if f.HasDL() {
+		binary.BigEndian.PutUint32(b[1:5], f.DLN6JitterThreshold)
+	}
+
+	return nil
+}
+
+// MarshalLen returns field length in integer.
+func (f *TrafficParameterThresholdFields) MarshalLen() int {
+	if f.HasDL() {
+		return 5
+	}
+	return 1
+}
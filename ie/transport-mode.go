@@ -0,0 +1,19 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewTransportMode creates a new TransportMode IE.
+func NewTransportMode(mode uint8) *IE {
+	return newUint8ValIE(TransportMode, mode)
+}
+
+// TransportMode returns TransportMode in uint8 if the type of IE matches.
+func (i *IE) TransportMode() (uint8, error) {
+	if i.Type != TransportMode {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.ValueAsUint8()
+}
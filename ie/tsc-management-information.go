@@ -24,6 +24,47 @@ func NewTSCManagementInformationWithinSessionReportRequest(ies ...*IE) *IE {
 	return newGroupedIE(TSCManagementInformationWithinSessionReportRequest, 0, ies...)
 }
 
+// NewTSCManagementInformationFromFields creates a new TSCManagementInformation IE
+// of the given type from the given fields.
+func NewTSCManagementInformationFromFields(typ IEType, f *TSCManagementInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	var ies []*IE
+	if f.PortManagementInformationContainer != "" {
+		ies = append(ies, NewPortManagementInformationContainer(f.PortManagementInformationContainer))
+	}
+	if f.UserPlanNodeManagementInformationContainer != "" {
+		ies = append(ies, NewBridgeManagementInformationContainer(f.UserPlanNodeManagementInformationContainer))
+	}
+	if f.NWTTPortNumber != 0 {
+		ies = append(ies, NewNWTTPortNumber(f.NWTTPortNumber))
+	}
+
+	return NewTSCManagementInformation(typ, ies...)
+}
+
+// NewTSCManagementInformationWithinSessionModificationRequestFromFields creates a
+// new TSCManagementInformation IE within Session Modification Request from the
+// given fields.
+func NewTSCManagementInformationWithinSessionModificationRequestFromFields(f *TSCManagementInformationFields) *IE {
+	return NewTSCManagementInformationFromFields(TSCManagementInformationWithinSessionModificationRequest, f)
+}
+
+// NewTSCManagementInformationWithinSessionModificationResponseFromFields creates a
+// new TSCManagementInformation IE within Session Modification Response from the
+// given fields.
+func NewTSCManagementInformationWithinSessionModificationResponseFromFields(f *TSCManagementInformationFields) *IE {
+	return NewTSCManagementInformationFromFields(TSCManagementInformationWithinSessionModificationResponse, f)
+}
+
+// NewTSCManagementInformationWithinSessionReportRequestFromFields creates a new
+// TSCManagementInformation IE within Session Report Request from the given fields.
+func NewTSCManagementInformationWithinSessionReportRequestFromFields(f *TSCManagementInformationFields) *IE {
+	return NewTSCManagementInformationFromFields(TSCManagementInformationWithinSessionReportRequest, f)
+}
+
 // TSCManagementInformation returns the IEs above TSCManagementInformation if the type of IE matches.
 func (i *IE) TSCManagementInformation() (*TSCManagementInformationFields, error) {
 	switch i.Type {
@@ -11,6 +11,32 @@ func NewUEIPAddressUsageInformation(ies ...*IE) *IE {
 	return newGroupedIE(UEIPAddressUsageInformation, 0, ies...)
 }
 
+// NewUEIPAddressUsageInformationFromFields creates a new UEIPAddressUsageInformation
+// IE from the given fields.
+func NewUEIPAddressUsageInformationFromFields(f *UEIPAddressUsageInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewSequenceNumber(f.SequenceNumber),
+		NewMetric(f.Metric),
+		NewValidityTimer(f.ValidityTimer),
+		newFieldsIE(NumberOfUEIPAddresses, f.NumberOfUEIPAddresses),
+	}
+	if f.NetworkInstance != "" {
+		ies = append(ies, NewNetworkInstance(f.NetworkInstance))
+	}
+	if len(f.UEIPAddressPoolId) > 0 {
+		ies = append(ies, New(UEIPAddressPoolIdentity, f.UEIPAddressPoolId))
+	}
+	if len(f.SNSSAI) > 0 {
+		ies = append(ies, New(SNSSAI, f.SNSSAI))
+	}
+
+	return NewUEIPAddressUsageInformation(ies...)
+}
+
 // UEIPAddressUsageInformation returns the IEs above UEIPAddressUsageInformation if the type of IE matches.
 func (i *IE) UEIPAddressUsageInformation() (*UEIPAddressUsageInformationFields, error) {
 	switch i.Type {
@@ -243,6 +243,7 @@ func (f *UEIPAddressFields) UnmarshalBinary(b []byte) error {
 			return io.ErrUnexpectedEOF
 		}
 		f.IPv6PrefixDelegationBits = b[offset]
+		offset++
 	}
 
 	if has7thBit(f.Flags) {
@@ -275,7 +276,7 @@ func (f *UEIPAddressFields) MarshalTo(b []byte) error {
 	offset := 1
 
 	if has2ndBit(f.Flags) && !has5thBit(f.Flags) {
-		copy(b[offset:offset+4], f.IPv4Address)
+		copy(b[offset:offset+4], f.IPv4Address.To4())
 		offset += 4
 	}
 
@@ -0,0 +1,19 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewUELevelMeasurementsConfiguration creates a new UELevelMeasurementsConfiguration IE.
+func NewUELevelMeasurementsConfiguration(config []byte) *IE {
+	return New(UELevelMeasurementsConfiguration, config)
+}
+
+// UELevelMeasurementsConfiguration returns UELevelMeasurementsConfiguration in []byte if the type of IE matches.
+func (i *IE) UELevelMeasurementsConfiguration() ([]byte, error) {
+	if i.Type != UELevelMeasurementsConfiguration {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.Payload, nil
+}
@@ -9,6 +9,16 @@ func NewUpdateTGPPAccessForwardingActionInformation(ies ...*IE) *IE {
 	return newGroupedIE(UpdateTGPPAccessForwardingActionInformation, 0, ies...)
 }
 
+// NewUpdateTGPPAccessForwardingActionInformationFromFields creates a new UpdateTGPPAccessForwardingActionInformation IE
+// from the given fields.
+func NewUpdateTGPPAccessForwardingActionInformationFromFields(f *UpdateTGPPAccessForwardingActionInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewUpdateTGPPAccessForwardingActionInformation(accessForwardingActionInformationIEs((*TGPPAccessForwardingActionInformationFields)(f))...)
+}
+
 // UpdateTGPPAccessForwardingActionInformation returns the IEs above UpdateTGPPAccessForwardingActionInformation if the type of IE matches.
 func (i *IE) UpdateTGPPAccessForwardingActionInformation() (*UpdateTGPPAccessForwardingActionInformationFields, error) {
 	switch i.Type {
@@ -41,11 +51,16 @@ func (i *IE) UpdateTGPPAccessForwardingActionInformation() (*UpdateTGPPAccessFor
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type UpdateTGPPAccessForwardingActionInformationFields struct {
-	FARID    uint32
-	Weight   uint8
-	Priority uint8
-	URRID    uint32
-	RATType  uint8
+	FARID       uint32
+	HasFARID    bool
+	Weight      uint8
+	HasWeight   bool
+	Priority    uint8
+	HasPriority bool
+	URRID       uint32
+	HasURRID    bool
+	RATType     uint8
+	HasRATType  bool
 }
 
 // TGPPAccessForwardingActionInformation returns the IEs above TGPPAccessForwardingActionInformation.
@@ -76,30 +91,35 @@ func (t *UpdateTGPPAccessForwardingActionInformationFields) ParseIEs(ies ...*IE)
 				return err
 			}
 			t.FARID = v
+			t.HasFARID = true
 		case Weight:
 			v, err := ie.Weight()
 			if err != nil {
 				return err
 			}
 			t.Weight = v
+			t.HasWeight = true
 		case Priority:
 			v, err := ie.Priority()
 			if err != nil {
 				return err
 			}
 			t.Priority = v
+			t.HasPriority = true
 		case URRID:
 			v, err := ie.URRID()
 			if err != nil {
 				return err
 			}
 			t.URRID = v
+			t.HasURRID = true
 		case RATType:
 			v, err := ie.RATType()
 			if err != nil {
 				return err
 			}
 			t.RATType = v
+			t.HasRATType = true
 		}
 	}
 	return nil
@@ -21,6 +21,45 @@ func NewUpdateBARWithinSessionReportResponse(ies ...*IE) *IE {
 	return NewUpdateBAR(UpdateBARWithinSessionReportResponse, ies...)
 }
 
+// NewUpdateBARFromFields creates a new UpdateBAR IE of the given type from the
+// given fields.
+func NewUpdateBARFromFields(typ IEType, f *UpdateBARFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewBARID(f.BarID)}
+	if f.DownlinkDataNotificationDelay != 0 {
+		ies = append(ies, NewDownlinkDataNotificationDelay(f.DownlinkDataNotificationDelay))
+	}
+	if f.DLBufferingDuration != 0 {
+		ies = append(ies, NewDLBufferingDuration(f.DLBufferingDuration))
+	}
+	if f.DLBufferingSuggestedPacketCount != 0 {
+		ies = append(ies, NewDLBufferingSuggestedPacketCount(f.DLBufferingSuggestedPacketCount))
+	}
+	if f.SuggestedBufferingPacketsCount != 0 {
+		ies = append(ies, NewSuggestedBufferingPacketsCount(f.SuggestedBufferingPacketsCount))
+	}
+	if f.MTEDTControlInformation != 0 {
+		ies = append(ies, NewMTEDTControlInformation(f.MTEDTControlInformation))
+	}
+
+	return NewUpdateBAR(typ, ies...)
+}
+
+// NewUpdateBARWithinSessionModificationRequestFromFields creates a new UpdateBAR IE
+// within Session Modification Request from the given fields.
+func NewUpdateBARWithinSessionModificationRequestFromFields(f *UpdateBARFields) *IE {
+	return NewUpdateBARFromFields(UpdateBARWithinSessionModificationRequest, f)
+}
+
+// NewUpdateBARWithinSessionReportResponseFromFields creates a new UpdateBAR IE
+// within Session Report Response from the given fields.
+func NewUpdateBARWithinSessionReportResponseFromFields(f *UpdateBARFields) *IE {
+	return NewUpdateBARFromFields(UpdateBARWithinSessionReportResponse, f)
+}
+
 // UpdateBAR returns the IEs above UpdateBAR if the type of IE matches.
 func (i *IE) UpdateBAR() (*UpdateBARFields, error) {
 	switch i.Type {
@@ -46,11 +85,12 @@ func (i *IE) UpdateBAR() (*UpdateBARFields, error) {
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type UpdateBARFields struct {
-	BarID                          uint8
-	DownlinkDataNotificationDelay  time.Duration
-	SuggestedBufferingPacketsCount uint8
-	MTEDTControlInformation        uint8
-	DLBufferingDuration            time.Duration
+	BarID                           uint8
+	DownlinkDataNotificationDelay   time.Duration
+	SuggestedBufferingPacketsCount  uint8
+	MTEDTControlInformation         uint8
+	DLBufferingDuration             time.Duration
+	DLBufferingSuggestedPacketCount uint16
 }
 
 // ParseUpdateBAR returns the IEs above UpdateSRR
@@ -106,6 +146,12 @@ func (bar *UpdateBARFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			bar.DLBufferingDuration = v
+		case DLBufferingSuggestedPacketCount:
+			v, err := ie.DLBufferingSuggestedPacketCount()
+			if err != nil {
+				return err
+			}
+			bar.DLBufferingSuggestedPacketCount = v
 		}
 	}
 	return nil
@@ -9,6 +9,26 @@ func NewUpdateDuplicatingParameters(ies ...*IE) *IE {
 	return newGroupedIE(UpdateDuplicatingParameters, 0, ies...)
 }
 
+// NewUpdateDuplicatingParametersFromFields creates a new
+// UpdateDuplicatingParameters IE from the given fields.
+func NewUpdateDuplicatingParametersFromFields(f *UpdateDuplicatingParametersFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	var ies []*IE
+	if f.HasDestinationInterface || f.DestinationInterface != 0 {
+		ies = append(ies, NewDestinationInterface(f.DestinationInterface))
+	}
+	ies = append(ies, newFieldsIE(OuterHeaderCreation, f.OuterHeaderCreation))
+	if f.HasTransportLevelMarking || f.TransportLevelMarking != 0 {
+		ies = append(ies, NewTransportLevelMarking(f.TransportLevelMarking))
+	}
+	ies = append(ies, newForwardingPolicyIE(f.ForwardingPolicy, f.ForwardingPolicyIdentifier))
+
+	return NewUpdateDuplicatingParameters(ies...)
+}
+
 // UpdateDuplicatingParameters returns the IEs above UpdateDuplicatingParameters if the type of IE matches.
 func (i *IE) UpdateDuplicatingParameters() (*UpdateDuplicatingParametersFields, error) {
 	switch i.Type {
@@ -38,8 +58,10 @@ func (i *IE) UpdateDuplicatingParameters() (*UpdateDuplicatingParametersFields,
 // existing (standard) types in Go.
 type UpdateDuplicatingParametersFields struct {
 	DestinationInterface       uint8
+	HasDestinationInterface    bool
 	OuterHeaderCreation        *OuterHeaderCreationFields
 	TransportLevelMarking      uint16
+	HasTransportLevelMarking   bool
 	ForwardingPolicy           []byte
 	ForwardingPolicyIdentifier string
 }
@@ -62,6 +84,7 @@ func ParseUpdateDuplicatingParametersFields(b []byte) (*UpdateDuplicatingParamet
 				return d, err
 			}
 			d.DestinationInterface = dest
+			d.HasDestinationInterface = true
 		case OuterHeaderCreation:
 			creation, err := ie.OuterHeaderCreation()
 			if err != nil {
@@ -74,6 +97,7 @@ func ParseUpdateDuplicatingParametersFields(b []byte) (*UpdateDuplicatingParamet
 				return d, err
 			}
 			d.TransportLevelMarking = transport
+			d.HasTransportLevelMarking = true
 		case ForwardingPolicy:
 			policy, err := ie.ForwardingPolicy()
 			if err != nil {
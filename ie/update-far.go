@@ -9,6 +9,37 @@ func NewUpdateFAR(ies ...*IE) *IE {
 	return newGroupedIE(UpdateFAR, 0, ies...)
 }
 
+// NewUpdateFARFromFields creates a new UpdateFAR IE from the given fields.
+func NewUpdateFARFromFields(f *UpdateFARFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewFARID(f.FARID),
+		newFieldsIE(ApplyAction, f.ApplyAction),
+		NewUpdateForwardingParametersFromFields(f.UpdateForwardingParameters),
+	}
+	for _, v := range f.UpdateDuplicatingParameters {
+		ies = append(ies, NewUpdateDuplicatingParametersFromFields(v))
+	}
+	if f.HasBARID || f.BARID != 0 {
+		ies = append(ies, NewBARID(f.BARID))
+	}
+	ies = append(ies,
+		NewRedundantTransmissionParametersFromFields(f.RedundantTransmissionParameters),
+		NewRedundantTransmissionForwardingParametersFromFields(f.RedundantTransmissionForwardingParameters),
+	)
+	for _, v := range f.AddMBSUnicastParameters {
+		ies = append(ies, NewAddMBSUnicastParametersFromFields(v))
+	}
+	for _, v := range f.RemoveMBSUnicastParameters {
+		ies = append(ies, NewRemoveMBSUnicastParametersFromFields(v))
+	}
+
+	return NewUpdateFAR(ies...)
+}
+
 // UpdateFAR returns the IEs above UpdateFAR if the type of IE matches.
 func (i *IE) UpdateFAR() (*UpdateFARFields, error) {
 	if i.Type != UpdateFAR {
@@ -36,6 +67,7 @@ type UpdateFARFields struct {
 	UpdateForwardingParameters                *UpdateForwardingParametersFields
 	UpdateDuplicatingParameters               []*UpdateDuplicatingParametersFields
 	BARID                                     uint8
+	HasBARID                                  bool
 	RedundantTransmissionParameters           *RedundantTransmissionParametersField
 	RedundantTransmissionForwardingParameters *RedundantTransmissionForwardingParametersField
 	AddMBSUnicastParameters                   []*AddMBSUnicastParametersFields
@@ -99,6 +131,7 @@ func (far *UpdateFARFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			far.BARID = barID
+			far.HasBARID = true
 		case RedundantTransmissionForwardingParameters:
 			forward, err := ie.RedundantTransmissionForwardingParameters()
 			if err != nil {
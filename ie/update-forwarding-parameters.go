@@ -9,6 +9,47 @@ func NewUpdateForwardingParameters(ies ...*IE) *IE {
 	return newGroupedIE(UpdateForwardingParameters, 0, ies...)
 }
 
+// NewUpdateForwardingParametersFromFields creates a new UpdateForwardingParameters
+// IE from the given fields.
+func NewUpdateForwardingParametersFromFields(f *UpdateForwardingParametersFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	var ies []*IE
+	if f.HasDestinationInterface || f.DestinationInterface != 0 {
+		ies = append(ies, NewDestinationInterface(f.DestinationInterface))
+	}
+	if f.NetworkInstance != "" {
+		ies = append(ies, NewNetworkInstance(f.NetworkInstance))
+	}
+	ies = append(ies,
+		newFieldsIE(RedirectInformation, f.RedirectInformation),
+		newFieldsIE(OuterHeaderCreation, f.OuterHeaderCreation),
+	)
+	if f.HasTransportLevelMarking || f.TransportLevelMarking != 0 {
+		ies = append(ies, NewTransportLevelMarking(f.TransportLevelMarking))
+	}
+	ies = append(ies,
+		newForwardingPolicyIE(f.ForwardingPolicy, f.ForwardingPolicyIdentifier),
+		newFieldsIE(HeaderEnrichment, f.HeaderEnrichment),
+	)
+	if f.HasPFCPSMReqFlags || f.PFCPSMReqFlags != 0 {
+		ies = append(ies, NewPFCPSMReqFlags(f.PFCPSMReqFlags))
+	}
+	if f.HasLinkedTrafficEndpointID || f.LinkedTrafficEndpointID != 0 {
+		ies = append(ies, NewTrafficEndpointID(f.LinkedTrafficEndpointID))
+	}
+	if f.HasDestinationInterfaceType || f.DestinationInterfaceType != 0 {
+		ies = append(ies, NewTGPPInterfaceType(f.DestinationInterfaceType))
+	}
+	if f.DataNetworkAccessIdentifier != "" {
+		ies = append(ies, NewDataNetworkAccessIdentifier(f.DataNetworkAccessIdentifier))
+	}
+
+	return NewUpdateForwardingParameters(ies...)
+}
+
 // UpdateForwardingParameters returns the IEs above UpdateForwardingParameters if the type of IE matches.
 func (i *IE) UpdateForwardingParameters() (*UpdateForwardingParametersFields, error) {
 	switch i.Type {
@@ -43,16 +84,21 @@ func (i *IE) UpdateForwardingParameters() (*UpdateForwardingParametersFields, er
 // existing (standard) types in Go.
 type UpdateForwardingParametersFields struct {
 	DestinationInterface        uint8
+	HasDestinationInterface     bool
 	NetworkInstance             string
 	RedirectInformation         *RedirectInformationFields
 	OuterHeaderCreation         *OuterHeaderCreationFields
 	TransportLevelMarking       uint16
+	HasTransportLevelMarking    bool
 	ForwardingPolicy            []byte
 	ForwardingPolicyIdentifier  string
 	HeaderEnrichment            *HeaderEnrichmentFields
 	PFCPSMReqFlags              uint8
+	HasPFCPSMReqFlags           bool
 	LinkedTrafficEndpointID     uint8
+	HasLinkedTrafficEndpointID  bool
 	DestinationInterfaceType    uint8
+	HasDestinationInterfaceType bool
 	DataNetworkAccessIdentifier string
 }
 
@@ -83,6 +129,7 @@ func (u *UpdateForwardingParametersFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.DestinationInterface = dest
+			u.HasDestinationInterface = true
 		case NetworkInstance:
 			network, err := ie.NetworkInstance()
 			if err != nil {
@@ -107,6 +154,7 @@ func (u *UpdateForwardingParametersFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.TransportLevelMarking = transport
+			u.HasTransportLevelMarking = true
 		case ForwardingPolicy:
 			policy, err := ie.ForwardingPolicy()
 			if err != nil {
@@ -124,6 +172,7 @@ func (u *UpdateForwardingParametersFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.PFCPSMReqFlags = v
+			u.HasPFCPSMReqFlags = true
 		case HeaderEnrichment:
 			header, err := ie.HeaderEnrichment()
 			if err != nil {
@@ -136,12 +185,14 @@ func (u *UpdateForwardingParametersFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.LinkedTrafficEndpointID = traficID
+			u.HasLinkedTrafficEndpointID = true
 		case TGPPInterfaceType:
 			tgppinterface, err := ie.TGPPInterfaceType()
 			if err != nil {
 				return err
 			}
 			u.DestinationInterfaceType = tgppinterface
+			u.HasDestinationInterfaceType = true
 		case DataNetworkAccessIdentifier:
 			v, err := ie.DataNetworkAccessIdentifier()
 			if err != nil {
@@ -9,6 +9,31 @@ func NewUpdateMAR(ies ...*IE) *IE {
 	return newGroupedIE(UpdateMAR, 0, ies...)
 }
 
+// NewUpdateMARFromFields creates a new UpdateMAR IE from the given fields.
+func NewUpdateMARFromFields(f *UpdateMARFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewMARID(f.ID)}
+	if f.HasSteeringFunctionality || f.SteeringFunctionality != 0 {
+		ies = append(ies, NewSteeringFunctionality(f.SteeringFunctionality))
+	}
+	if f.HasSteeringMode || f.SteeringMode != 0 {
+		ies = append(ies, NewSteeringMode(f.SteeringMode))
+	}
+	ies = append(ies,
+		NewUpdateTGPPAccessForwardingActionInformationFromFields(f.UpdateTGPPAccessForwardingActionInformation),
+		NewUpdateNonTGPPAccessForwardingActionInformationFromFields(f.UpdateNonTGPPAccessForwardingActionInformation),
+		NewTGPPAccessForwardingActionInformationFromFields(f.TGPPAccessForwardingActionInformation),
+		NewNonTGPPAccessForwardingActionInformationFromFields(f.NonTGPPAccessForwardingActionInformation),
+		newFieldsIE(Thresholds, f.Thresholds),
+		newFieldsIE(SteeringModeIndicator, f.SteeringModeIndicator),
+	)
+
+	return NewUpdateMAR(ies...)
+}
+
 // UpdateMAR returns the IEs above UpdateMAR if the type of IE matches.
 func (i *IE) UpdateMAR() (*UpdateMARFields, error) {
 	if i.Type != UpdateMAR {
@@ -35,7 +60,9 @@ type UpdateMARFields struct {
 	// MAR ID
 	ID                                             uint16
 	SteeringFunctionality                          uint8
+	HasSteeringFunctionality                       bool
 	SteeringMode                                   uint8
+	HasSteeringMode                                bool
 	UpdateTGPPAccessForwardingActionInformation    *UpdateTGPPAccessForwardingActionInformationFields
 	UpdateNonTGPPAccessForwardingActionInformation *UpdateNonTGPPAccessForwardingActionInformationFields
 	TGPPAccessForwardingActionInformation          *TGPPAccessForwardingActionInformationFields
@@ -77,6 +104,7 @@ func (c *UpdateMARFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			c.SteeringFunctionality = a
+			c.HasSteeringFunctionality = true
 
 		case SteeringMode:
 			a, err := ie.SteeringMode()
@@ -84,6 +112,7 @@ func (c *UpdateMARFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			c.SteeringMode = a
+			c.HasSteeringMode = true
 		case UpdateTGPPAccessForwardingActionInformation:
 			a, err := ie.UpdateTGPPAccessForwardingActionInformation()
 			if err != nil {
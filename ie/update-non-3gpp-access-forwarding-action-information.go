@@ -9,6 +9,16 @@ func NewUpdateNonTGPPAccessForwardingActionInformation(ies ...*IE) *IE {
 	return newGroupedIE(UpdateNonTGPPAccessForwardingActionInformation, 0, ies...)
 }
 
+// NewUpdateNonTGPPAccessForwardingActionInformationFromFields creates a new UpdateNonTGPPAccessForwardingActionInformation IE
+// from the given fields.
+func NewUpdateNonTGPPAccessForwardingActionInformationFromFields(f *UpdateNonTGPPAccessForwardingActionInformationFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewUpdateNonTGPPAccessForwardingActionInformation(accessForwardingActionInformationIEs((*TGPPAccessForwardingActionInformationFields)(f))...)
+}
+
 // UpdateNonTGPPAccessForwardingActionInformation returns the IEs above UpdateNonTGPPAccessForwardingActionInformation if the type of IE matches.
 func (i *IE) UpdateNonTGPPAccessForwardingActionInformation() (*UpdateNonTGPPAccessForwardingActionInformationFields, error) {
 	switch i.Type {
@@ -42,11 +52,16 @@ func (i *IE) UpdateNonTGPPAccessForwardingActionInformation() (*UpdateNonTGPPAcc
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type UpdateNonTGPPAccessForwardingActionInformationFields struct {
-	FARID    uint32
-	Weight   uint8
-	Priority uint8
-	URRID    uint32
-	RATType  uint8
+	FARID       uint32
+	HasFARID    bool
+	Weight      uint8
+	HasWeight   bool
+	Priority    uint8
+	HasPriority bool
+	URRID       uint32
+	HasURRID    bool
+	RATType     uint8
+	HasRATType  bool
 }
 
 // UpdateNonTGPPAccessForwardingActionInformation returns the IEs above UpdateNonTGPPAccessForwardingActionInformation.
@@ -77,30 +92,35 @@ func (t *UpdateNonTGPPAccessForwardingActionInformationFields) ParseIEs(ies ...*
 				return err
 			}
 			t.FARID = v
+			t.HasFARID = true
 		case Weight:
 			v, err := ie.Weight()
 			if err != nil {
 				return err
 			}
 			t.Weight = v
+			t.HasWeight = true
 		case Priority:
 			v, err := ie.Priority()
 			if err != nil {
 				return err
 			}
 			t.Priority = v
+			t.HasPriority = true
 		case URRID:
 			v, err := ie.URRID()
 			if err != nil {
 				return err
 			}
 			t.URRID = v
+			t.HasURRID = true
 		case RATType:
 			v, err := ie.RATType()
 			if err != nil {
 				return err
 			}
 			t.RATType = v
+			t.HasRATType = true
 		}
 	}
 	return nil
@@ -11,6 +11,52 @@ func NewUpdatePDR(ies ...*IE) *IE {
 	return newGroupedIE(UpdatePDR, 0, ies...)
 }
 
+// NewUpdatePDRFromFields creates a new UpdatePDR IE from the given fields.
+func NewUpdatePDRFromFields(f *UpdatePDRFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewPDRID(f.ID)}
+	if len(f.OuterHeaderRemoval) > 0 {
+		ies = append(ies, New(OuterHeaderRemoval, f.OuterHeaderRemoval))
+	}
+	if f.HasPrecedence || f.Precedence != 0 {
+		ies = append(ies, NewPrecedence(f.Precedence))
+	}
+	ies = append(ies, NewPDIFromFields(f.PDI))
+	if f.HasFARID || f.FARID != 0 {
+		ies = append(ies, NewFARID(f.FARID))
+	}
+	for _, v := range f.URRID {
+		ies = append(ies, NewURRID(v))
+	}
+	for _, v := range f.QERID {
+		ies = append(ies, NewQERID(v))
+	}
+	if f.ActivatePredefinedRules != "" {
+		ies = append(ies, NewActivatePredefinedRules(f.ActivatePredefinedRules))
+	}
+	if f.DeactivatePredefinedRules != "" {
+		ies = append(ies, NewDeactivatePredefinedRules(f.DeactivatePredefinedRules))
+	}
+	if !f.ActivationTime.IsZero() {
+		ies = append(ies, NewActivationTime(f.ActivationTime))
+	}
+	if !f.DeactivationTime.IsZero() {
+		ies = append(ies, NewDeactivationTime(f.DeactivationTime))
+	}
+	for _, v := range f.IPMulticastAddressingInfo {
+		ies = append(ies, NewIPMulticastAddressingInfoFromFields(v))
+	}
+	ies = append(ies, f.TransportDelayReporting)
+	if f.HasRatType || f.RatType != 0 {
+		ies = append(ies, NewRATType(f.RatType))
+	}
+
+	return NewUpdatePDR(ies...)
+}
+
 // UpdatePDR returns the IEs above UpdatePDR if the type of IE matches.
 func (i *IE) UpdatePDR() (*UpdatePDRFields, error) {
 	if i.Type != UpdatePDR {
@@ -38,10 +84,12 @@ type UpdatePDRFields struct {
 	ID                 uint16
 	OuterHeaderRemoval []byte
 	// Precedence
-	Precedence uint32
+	Precedence    uint32
+	HasPrecedence bool
 	// PDI
 	PDI                       *PDIFields
 	FARID                     uint32
+	HasFARID                  bool
 	URRID                     []uint32
 	QERID                     []uint32
 	ActivatePredefinedRules   string
@@ -51,6 +99,7 @@ type UpdatePDRFields struct {
 	IPMulticastAddressingInfo []*IPMulticastAddressingInfoField
 	TransportDelayReporting   *IE
 	RatType                   uint8
+	HasRatType                bool
 }
 
 // ParseUpdatePDRFields returns the IEs above Update PDR
@@ -86,6 +135,7 @@ func (u *UpdatePDRFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.Precedence = a
+			u.HasPrecedence = true
 		case PDI:
 			a, err := ie.PDI()
 			if err != nil {
@@ -104,6 +154,7 @@ func (u *UpdatePDRFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.FARID = a
+			u.HasFARID = true
 		case URRID:
 			a, err := ie.URRID()
 			if err != nil {
@@ -154,6 +205,7 @@ func (u *UpdatePDRFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.RatType = a
+			u.HasRatType = true
 		}
 	}
 	return nil
@@ -9,6 +9,45 @@ func NewUpdateQER(ies ...*IE) *IE {
 	return newGroupedIE(UpdateQER, 0, ies...)
 }
 
+// NewUpdateQERFromFields creates a new UpdateQER IE from the given fields.
+func NewUpdateQERFromFields(f *UpdateQERFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewQERID(f.QERID)}
+	if f.HasQERCorrelationID || f.QERCorrelationID != 0 {
+		ies = append(ies, NewQERCorrelationID(f.QERCorrelationID))
+	}
+	if f.HasGateStatus || f.GateStatus != 0 {
+		ies = append(ies, newUint8ValIE(GateStatus, uint8(f.GateStatus)))
+	}
+	ies = append(ies,
+		newFieldsIE(MBR, f.MBR),
+		newFieldsIE(GBR, f.GBR),
+		newFieldsIE(PacketRate, f.PacketRate),
+		newFieldsIE(PacketRateStatus, f.PacketRateStatus),
+		newFieldsIE(DLFlowLevelMarking, f.DLFlowLevelMarking),
+	)
+	if f.HasQFI || f.QFI != 0 {
+		ies = append(ies, NewQFI(f.QFI))
+	}
+	if f.HasReflectiveQoS || f.ReflectiveQoS != 0 {
+		ies = append(ies, NewRQI(f.ReflectiveQoS))
+	}
+	if f.HasPagingPolicyIndicator || f.PagingPolicyIndicator != 0 {
+		ies = append(ies, NewPagingPolicyIndicator(f.PagingPolicyIndicator))
+	}
+	if f.HasAveragingWindows || f.AveragingWindows != 0 {
+		ies = append(ies, NewAveragingWindow(f.AveragingWindows))
+	}
+	if f.HasQERControlIndications || f.QERControlIndications != 0 {
+		ies = append(ies, newUint8ValIE(QERControlIndications, f.QERControlIndications))
+	}
+
+	return NewUpdateQER(ies...)
+}
+
 // UpdateQER returns the IEs above UpdateQER if the type of IE matches.
 func (i *IE) UpdateQER() (*UpdateQERFields, error) {
 	if i.Type != UpdateQER {
@@ -31,19 +70,26 @@ func (i *IE) UpdateQER() (*UpdateQERFields, error) {
 // The contained fields are of type struct, as they are too complex to handle with
 // existing (standard) types in Go.
 type UpdateQERFields struct {
-	QERID                 uint32
-	QERCorrelationID      uint32
-	GateStatus            Gate
-	MBR                   *MBRFields
-	GBR                   *GBRFields
-	PacketRate            *PacketRateFields
-	PacketRateStatus      *PacketRateStatusFields
-	DLFlowLevelMarking    *DLFlowLevelMarkingFields
-	QFI                   uint8
-	ReflectiveQoS         uint8
-	PagingPolicyIndicator uint8
-	AveragingWindows      uint32
-	QERControlIndications uint8
+	QERID                    uint32
+	QERCorrelationID         uint32
+	HasQERCorrelationID      bool
+	GateStatus               Gate
+	HasGateStatus            bool
+	MBR                      *MBRFields
+	GBR                      *GBRFields
+	PacketRate               *PacketRateFields
+	PacketRateStatus         *PacketRateStatusFields
+	DLFlowLevelMarking       *DLFlowLevelMarkingFields
+	QFI                      uint8
+	HasQFI                   bool
+	ReflectiveQoS            uint8
+	HasReflectiveQoS         bool
+	PagingPolicyIndicator    uint8
+	HasPagingPolicyIndicator bool
+	AveragingWindows         uint32
+	HasAveragingWindows      bool
+	QERControlIndications    uint8
+	HasQERControlIndications bool
 }
 
 // ParseUpdateQERFields returns the IEs above UpdateURR if the type of IE matches.
@@ -78,12 +124,14 @@ func (u *UpdateQERFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.QERCorrelationID = a
+			u.HasQERCorrelationID = true
 		case GateStatus:
 			a, err := ie.GateStatus()
 			if err != nil {
 				return err
 			}
 			u.GateStatus = a
+			u.HasGateStatus = true
 		case MBR:
 			m, err := ie.MBR()
 			if err != nil {
@@ -120,30 +168,35 @@ func (u *UpdateQERFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.QFI = m
+			u.HasQFI = true
 		case RQI:
 			m, err := ie.RQI()
 			if err != nil {
 				return err
 			}
 			u.ReflectiveQoS = m
+			u.HasReflectiveQoS = true
 		case PagingPolicyIndicator:
 			m, err := ie.PagingPolicyIndicator()
 			if err != nil {
 				return err
 			}
 			u.PagingPolicyIndicator = m
+			u.HasPagingPolicyIndicator = true
 		case AveragingWindow:
 			m, err := ie.AveragingWindow()
 			if err != nil {
 				return err
 			}
 			u.AveragingWindows = m
+			u.HasAveragingWindows = true
 		case QERControlIndications:
 			m, err := ie.QERControlIndications()
 			if err != nil {
 				return err
 			}
 			u.QERControlIndications = m
+			u.HasQERControlIndications = true
 		}
 	}
 	return nil
@@ -9,6 +9,20 @@ func NewUpdateSRR(ies ...*IE) *IE {
 	return newGroupedIE(UpdateSRR, 0, ies...)
 }
 
+// NewUpdateSRRFromFields creates a new UpdateSRR IE from the given fields.
+func NewUpdateSRRFromFields(f *UpdateSRRFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewUpdateSRR(
+		NewSRRID(f.SSRID),
+		NewAccessAvailabilityControlInformationFromFields(f.AccessAvailabilityControlInformation),
+		NewQoSMonitoringPerQoSFlowControlInformationFromFields(f.QoSMonitoringPerQoSFlowControlInformation),
+		NewDirectReportingInformationFromFields(f.DirectReportingInformation),
+	)
+}
+
 // UpdateSRR returns the IEs above UpdateSRR if the type of IE matches.
 func (i *IE) UpdateSRR() (*UpdateSRRFields, error) {
 	switch i.Type {
@@ -9,6 +9,50 @@ func NewUpdateTrafficEndpoint(ies ...*IE) *IE {
 	return newGroupedIE(UpdateTrafficEndpoint, 0, ies...)
 }
 
+// NewUpdateTrafficEndpointFromFields creates a new UpdateTrafficEndpoint IE from the given fields.
+func NewUpdateTrafficEndpointFromFields(f *UpdateTrafficEndpointFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewTrafficEndpointID(f.TrafficEndpointID),
+		newFieldsIE(FTEID, f.LocalFTEID),
+	}
+	if f.NetworkInstance != "" {
+		ies = append(ies, NewNetworkInstance(f.NetworkInstance))
+	}
+	ies = append(ies, NewRedundantTransmissionParametersFromFields(f.RedundantTransmissionDetectionParameters))
+	for _, v := range f.UEIPAddress {
+		ies = append(ies, newFieldsIE(UEIPAddress, v))
+	}
+	if f.HasEthernetPDUSessionInformation || f.EthernetPDUSessionInformation != 0 {
+		ies = append(ies, NewEthernetPDUSessionInformation(f.EthernetPDUSessionInformation))
+	}
+	for _, v := range f.FramedRoute {
+		ies = append(ies, NewFramedRoute(v))
+	}
+	if f.HasFramedRouting || f.FramedRouting != 0 {
+		ies = append(ies, NewFramedRouting(f.FramedRouting))
+	}
+	if f.FramedIPv6Route != "" {
+		ies = append(ies, NewFramedIPv6Route(f.FramedIPv6Route))
+	}
+	for _, v := range f.QFI {
+		ies = append(ies, NewQFI(v))
+	}
+	if f.HasSourceInterfaceType || f.SourceInterfaceType != 0 {
+		ies = append(ies, NewTGPPInterfaceType(f.SourceInterfaceType))
+	}
+	ies = append(ies, f.IpMulticastAddressingInfo...)
+	ies = append(ies, f.MBSSession, f.AreaSessionID)
+	if f.HasRatType || f.RatType != 0 {
+		ies = append(ies, NewRATType(f.RatType))
+	}
+
+	return NewUpdateTrafficEndpoint(ies...)
+}
+
 // UpdateTrafficEndpoint returns the IEs above UpdateTrafficEndpoint if the type of IE matches.
 func (i *IE) UpdateTrafficEndpoint() (*UpdateTrafficEndpointFields, error) {
 	if i.Type != UpdateTrafficEndpoint {
@@ -38,15 +82,19 @@ type UpdateTrafficEndpointFields struct {
 	RedundantTransmissionDetectionParameters *RedundantTransmissionParametersField
 	UEIPAddress                              []*UEIPAddressFields
 	EthernetPDUSessionInformation            uint8
+	HasEthernetPDUSessionInformation         bool
 	FramedRoute                              []string
 	FramedRouting                            uint32
+	HasFramedRouting                         bool
 	FramedIPv6Route                          string
 	QFI                                      []uint8
 	SourceInterfaceType                      uint8
+	HasSourceInterfaceType                   bool
 	IpMulticastAddressingInfo                []*IE
 	MBSSession                               *IE
 	AreaSessionID                            *IE
 	RatType                                  uint8
+	HasRatType                               bool
 }
 
 // ParseTrafficEndpointFields returns the IEs above UpdateTrafficEndpoint
@@ -107,6 +155,7 @@ func (u *UpdateTrafficEndpointFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.EthernetPDUSessionInformation = v
+			u.HasEthernetPDUSessionInformation = true
 		case FramedRoute:
 			v, err := ie.FramedRoute()
 			if err != nil {
@@ -119,6 +168,7 @@ func (u *UpdateTrafficEndpointFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.FramedRouting = v
+			u.HasFramedRouting = true
 		case FramedIPv6Route:
 			v, err := ie.FramedIPv6Route()
 			if err != nil {
@@ -137,6 +187,7 @@ func (u *UpdateTrafficEndpointFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.SourceInterfaceType = v
+			u.HasSourceInterfaceType = true
 		case IPMulticastAddress:
 			u.IpMulticastAddressingInfo = append(u.IpMulticastAddressingInfo, ie)
 		case MBSSessionIdentifier:
@@ -149,6 +200,7 @@ func (u *UpdateTrafficEndpointFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.RatType = v
+			u.HasRatType = true
 		}
 	}
 	return nil
@@ -11,6 +11,96 @@ func NewUpdateURR(ies ...*IE) *IE {
 	return newGroupedIE(UpdateURR, 0, ies...)
 }
 
+// NewUpdateURRFromFields creates a new UpdateURR IE from the given fields.
+func NewUpdateURRFromFields(f *UpdateURRFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewURRID(f.URRID),
+		f.MeasurementMethod,
+		f.ReportingTrigger,
+	}
+	if f.HasMeasurementPeriod || f.MeasurementPeriod != 0 {
+		ies = append(ies, NewMeasurementPeriod(f.MeasurementPeriod))
+	}
+	ies = append(ies,
+		newFieldsIE(VolumeThreshold, f.VolumeThreshold),
+		newFieldsIE(VolumeQuota, f.VolumeQuota),
+	)
+	if f.HasEventThreshold || f.EventThreshold != 0 {
+		ies = append(ies, NewEventThreshold(f.EventThreshold))
+	}
+	if f.HasEventQuota || f.EventQuota != 0 {
+		ies = append(ies, NewEventQuota(f.EventQuota))
+	}
+	if f.HasTimeThreshold || f.TimeThreshold != 0 {
+		ies = append(ies, NewTimeThreshold(f.TimeThreshold))
+	}
+	if f.HasTimeQuota || f.TimeQuota != 0 {
+		ies = append(ies, NewTimeQuota(f.TimeQuota))
+	}
+	if f.HasQuotaHoldingTime || f.QuotaHoldingTime != 0 {
+		ies = append(ies, NewQuotaHoldingTime(f.QuotaHoldingTime))
+	}
+	ies = append(ies, newFieldsIE(DroppedDLTrafficThreshold, f.DroppedDLTrafficThreshold))
+	if f.HasQuotaValidityTime || f.QuotaValidityTime != 0 {
+		ies = append(ies, NewQuotaValidityTime(f.QuotaValidityTime))
+	}
+	if !f.MonitoringTime.IsZero() {
+		ies = append(ies, NewMonitoringTime(f.MonitoringTime))
+	}
+	ies = append(ies, newFieldsIE(SubsequentVolumeThreshold, f.SubsequentVolumeThreshold))
+	if f.HasSubsequentTimeThreshold || f.SubsequentTimeThreshold != 0 {
+		ies = append(ies, NewSubsequentTimeThreshold(f.SubsequentTimeThreshold))
+	}
+	ies = append(ies, newFieldsIE(SubsequentVolumeQuota, f.SubsequentVolumeQuota))
+	if f.HasSubsequentTimeQuota || f.SubsequentTimeQuota != 0 {
+		ies = append(ies, NewSubsequentTimeQuota(f.SubsequentTimeQuota))
+	}
+	if f.HasSubsequentEventThreshold || f.SubsequentEventThreshold != 0 {
+		ies = append(ies, NewSubsequentEventThreshold(f.SubsequentEventThreshold))
+	}
+	if f.HasSubsequentEventQuota || f.SubsequentEventQuota != 0 {
+		ies = append(ies, NewSubsequentEventQuota(f.SubsequentEventQuota))
+	}
+	if f.HasInactivityDetectionTime || f.InactivityDetectionTime != 0 {
+		ies = append(ies, NewInactivityDetectionTime(f.InactivityDetectionTime))
+	}
+	for _, v := range f.LinkedURRIDs {
+		ies = append(ies, NewLinkedURRID(v))
+	}
+	ies = append(ies, f.MeasurementInformation)
+	if len(f.TimeQuotaMechanism) > 0 {
+		ies = append(ies, New(TimeQuotaMechanism, f.TimeQuotaMechanism))
+	}
+	for _, v := range f.AggregatedURRs {
+		ies = append(ies, NewAggregatedURRsFromFields(v))
+	}
+	if f.HasFarIdForQuotaAction || f.FarIdForQuotaAction != 0 {
+		ies = append(ies, NewFARID(f.FarIdForQuotaAction))
+	}
+	if f.HasEthernetInactivityTimer || f.EthernetInactivityTimer != 0 {
+		ies = append(ies, NewEthernetInactivityTimer(f.EthernetInactivityTimer))
+	}
+	ies = append(ies, NewAdditionalMonitoringTimeFromFields(f.AdditionalMonitoringTime))
+	if f.HasNumberOfReports || f.NumberOfReports != 0 {
+		ies = append(ies, NewNumberOfReports(f.NumberOfReports))
+	}
+	if f.ExempltedApplicationIdForQuotaAction != "" {
+		ies = append(ies, NewApplicationID(f.ExempltedApplicationIdForQuotaAction))
+	}
+	for _, v := range f.ExempltedSdfFilterForQuotaAction {
+		ies = append(ies, newFieldsIE(SDFFilter, v))
+	}
+	if f.HasUserPlaneInactivityTimer || f.UserPlaneInactivityTimer != 0 {
+		ies = append(ies, NewUserPlaneInactivityTimer(f.UserPlaneInactivityTimer))
+	}
+
+	return NewUpdateURR(ies...)
+}
+
 // UpdateURR returns the IEs above UpdateURR if the type of IE matches.
 func (i *IE) UpdateURR() (*UpdateURRFields, error) {
 	if i.Type != UpdateURR {
@@ -41,34 +131,50 @@ type UpdateURRFields struct {
 	MeasurementMethod                    *IE
 	ReportingTrigger                     *IE
 	MeasurementPeriod                    time.Duration
+	HasMeasurementPeriod                 bool
 	VolumeThreshold                      *VolumeThresholdFields
 	VolumeQuota                          *VolumeQuotaFields
 	EventThreshold                       uint32
+	HasEventThreshold                    bool
 	EventQuota                           uint32
+	HasEventQuota                        bool
 	TimeThreshold                        time.Duration
+	HasTimeThreshold                     bool
 	TimeQuota                            time.Duration
+	HasTimeQuota                         bool
 	QuotaHoldingTime                     time.Duration
+	HasQuotaHoldingTime                  bool
 	DroppedDLTrafficThreshold            *DroppedDLTrafficThresholdFields
 	QuotaValidityTime                    time.Duration
+	HasQuotaValidityTime                 bool
 	MonitoringTime                       time.Time
 	SubsequentVolumeThreshold            *SubsequentVolumeThresholdFields
 	SubsequentTimeThreshold              time.Duration
+	HasSubsequentTimeThreshold           bool
 	SubsequentVolumeQuota                *SubsequentVolumeQuotaFields
 	SubsequentTimeQuota                  time.Duration
+	HasSubsequentTimeQuota               bool
 	SubsequentEventThreshold             uint32
+	HasSubsequentEventThreshold          bool
 	SubsequentEventQuota                 uint32
+	HasSubsequentEventQuota              bool
 	InactivityDetectionTime              uint32
+	HasInactivityDetectionTime           bool
 	LinkedURRIDs                         []uint32
 	MeasurementInformation               *IE
 	AggregatedURRs                       []*AggregatedURRsField
 	TimeQuotaMechanism                   []byte
 	FarIdForQuotaAction                  uint32
+	HasFarIdForQuotaAction               bool
 	EthernetInactivityTimer              time.Duration
+	HasEthernetInactivityTimer           bool
 	AdditionalMonitoringTime             *AdditionalMonitoringTimeFields
 	NumberOfReports                      uint16
+	HasNumberOfReports                   bool
 	ExempltedApplicationIdForQuotaAction string
 	ExempltedSdfFilterForQuotaAction     []*SDFFilterFields
 	UserPlaneInactivityTimer             time.Duration
+	HasUserPlaneInactivityTimer          bool
 }
 
 // ParseUpdateURRFields returns the IEs above UpdateURR if the type of IE matches.
@@ -107,6 +213,7 @@ func (u *UpdateURRFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.MeasurementPeriod = period
+			u.HasMeasurementPeriod = true
 		case VolumeThreshold:
 			volume, err := ie.VolumeThreshold()
 			if err != nil {
@@ -125,36 +232,48 @@ func (u *UpdateURRFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.EventThreshold = event
+			u.HasEventThreshold = true
 		case EventQuota:
 			event, err := ie.EventQuota()
 			if err != nil {
 				return err
 			}
 			u.EventQuota = event
+			u.HasEventQuota = true
 		case TimeThreshold:
 			threshold, err := ie.TimeThreshold()
 			if err != nil {
 				return err
 			}
 			u.TimeThreshold = threshold
+			u.HasTimeThreshold = true
 		case TimeQuota:
 			quota, err := ie.TimeQuota()
 			if err != nil {
 				return err
 			}
 			u.TimeQuota = quota
+			u.HasTimeQuota = true
 		case QuotaHoldingTime:
 			quota, err := ie.QuotaHoldingTime()
 			if err != nil {
 				return err
 			}
 			u.QuotaHoldingTime = quota
+			u.HasQuotaHoldingTime = true
+		case DroppedDLTrafficThreshold:
+			threshold, err := ie.DroppedDLTrafficThreshold()
+			if err != nil {
+				return err
+			}
+			u.DroppedDLTrafficThreshold = threshold
 		case QuotaValidityTime:
 			quota, err := ie.QuotaValidityTime()
 			if err != nil {
 				return err
 			}
 			u.QuotaValidityTime = quota
+			u.HasQuotaValidityTime = true
 		case MonitoringTime:
 			monitoringTime, err := ie.MonitoringTime()
 			if err != nil {
@@ -173,6 +292,7 @@ func (u *UpdateURRFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.SubsequentTimeThreshold = duration
+			u.HasSubsequentTimeThreshold = true
 		case SubsequentVolumeQuota:
 			quota, err := ie.SubsequentVolumeQuota()
 			if err != nil {
@@ -185,24 +305,28 @@ func (u *UpdateURRFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.SubsequentTimeQuota = quota
+			u.HasSubsequentTimeQuota = true
 		case SubsequentEventThreshold:
 			event, err := ie.SubsequentEventThreshold()
 			if err != nil {
 				return err
 			}
 			u.SubsequentEventThreshold = event
+			u.HasSubsequentEventThreshold = true
 		case SubsequentEventQuota:
 			event, err := ie.SubsequentEventQuota()
 			if err != nil {
 				return err
 			}
 			u.SubsequentEventQuota = event
+			u.HasSubsequentEventQuota = true
 		case InactivityDetectionTime:
 			event, err := ie.InactivityDetectionTime()
 			if err != nil {
 				return err
 			}
 			u.InactivityDetectionTime = event
+			u.HasInactivityDetectionTime = true
 		case LinkedURRID:
 			id, err := ie.LinkedURRID()
 			if err != nil {
@@ -229,12 +353,14 @@ func (u *UpdateURRFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.FarIdForQuotaAction = id
+			u.HasFarIdForQuotaAction = true
 		case EthernetInactivityTimer:
 			timer, err := ie.EthernetInactivityTimer()
 			if err != nil {
 				return err
 			}
 			u.EthernetInactivityTimer = timer
+			u.HasEthernetInactivityTimer = true
 		case AdditionalMonitoringTime:
 			timer, err := ie.AdditionalMonitoringTime()
 			if err != nil {
@@ -247,6 +373,7 @@ func (u *UpdateURRFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.NumberOfReports = reports
+			u.HasNumberOfReports = true
 		case ApplicationID:
 			applicationId, err := ie.ApplicationID()
 			if err != nil {
@@ -265,6 +392,7 @@ func (u *UpdateURRFields) ParseIEs(ies ...*IE) error {
 				return err
 			}
 			u.UserPlaneInactivityTimer = timer
+			u.HasUserPlaneInactivityTimer = true
 		}
 	}
 	return nil
@@ -9,6 +9,23 @@ func NewUpdatedPDR(ies ...*IE) *IE {
 	return newGroupedIE(UpdatedPDR, 0, ies...)
 }
 
+// NewUpdatedPDRFromFields creates a new UpdatedPDR IE from the given fields.
+func NewUpdatedPDRFromFields(f *UpdatedPDRFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{NewPDRID(f.PDRID)}
+	for _, v := range f.LocalFTEID {
+		ies = append(ies, newFieldsIE(FTEID, v))
+	}
+	for _, v := range f.UEIPAddress {
+		ies = append(ies, newFieldsIE(UEIPAddress, v))
+	}
+
+	return NewUpdatedPDR(ies...)
+}
+
 // UpdatedPDR returns the IEs above UpdatedPDR if the type of IE matches.
 func (i *IE) UpdatedPDR() (*UpdatedPDRFields, error) {
 	if i.Type != UpdatedPDR {
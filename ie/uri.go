@@ -0,0 +1,19 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewURI creates a new URI IE.
+func NewURI(uri string) *IE {
+	return newStringIE(URI, uri)
+}
+
+// URI returns URI in string if the type of IE matches.
+func (i *IE) URI() (string, error) {
+	if i.Type != URI {
+		return "", &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.ValueAsString()
+}
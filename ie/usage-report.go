@@ -26,6 +26,82 @@ func NewUsageReportWithinSessionReportRequest(ies ...*IE) *IE {
 	return NewUsageReport(UsageReportWithinSessionReportRequest, ies...)
 }
 
+// NewUsageReportFromFields creates a new UsageReport IE of the given type from the
+// given fields.
+func NewUsageReportFromFields(typ IEType, f *UsageReportFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := []*IE{
+		NewURRID(f.URRID),
+		NewURSEQN(f.URSEQN),
+	}
+	if len(f.UsageReportTrigger) > 0 {
+		ies = append(ies, New(UsageReportTrigger, f.UsageReportTrigger))
+	}
+	if !f.StartTime.IsZero() {
+		ies = append(ies, NewStartTime(f.StartTime))
+	}
+	if !f.EndTime.IsZero() {
+		ies = append(ies, NewEndTime(f.EndTime))
+	}
+	ies = append(ies, newFieldsIE(VolumeMeasurement, f.VolumeMeasurement))
+	if f.DurationMeasurement != 0 {
+		ies = append(ies, NewDurationMeasurement(f.DurationMeasurement))
+	}
+	ies = append(ies,
+		NewApplicationDetectionInformationFromFields(f.ApplicationDetectionInformation),
+		newFieldsIE(UEIPAddress, f.UEIPAddress),
+	)
+	if f.NetworkInstance != "" {
+		ies = append(ies, NewNetworkInstance(f.NetworkInstance))
+	}
+	if !f.TimeOfFirstPacket.IsZero() {
+		ies = append(ies, NewTimeOfFirstPacket(f.TimeOfFirstPacket))
+	}
+	if !f.TimeOfLastPacket.IsZero() {
+		ies = append(ies, NewTimeOfLastPacket(f.TimeOfLastPacket))
+	}
+	if f.UsageInformation != 0 {
+		ies = append(ies, newUint8ValIE(UsageInformation, f.UsageInformation))
+	}
+	if f.QueryURRReference != 0 {
+		ies = append(ies, NewQueryURRReference(f.QueryURRReference))
+	}
+	if !f.EventTimeStamp.IsZero() {
+		ies = append(ies, NewEventTimeStamp(f.EventTimeStamp))
+	}
+	ies = append(ies,
+		NewEthernetTrafficInformationFromFields(f.EthernetTrafficInformation),
+		NewJoinIPMulticastInformationWithinUsageReportFromFields(f.JoinIPMulticastInformation),
+		NewLeaveIPMulticastInformationWithinUsageReportFromFields(f.LeaveIPMulticastInformation),
+	)
+	if f.PredefinedRulesName != "" {
+		ies = append(ies, NewPredefinedRulesName(f.PredefinedRulesName))
+	}
+
+	return NewUsageReport(typ, ies...)
+}
+
+// NewUsageReportWithinSessionModificationResponseFromFields creates a new
+// UsageReport IE within Session Modification Response from the given fields.
+func NewUsageReportWithinSessionModificationResponseFromFields(f *UsageReportFields) *IE {
+	return NewUsageReportFromFields(UsageReportWithinSessionModificationResponse, f)
+}
+
+// NewUsageReportWithinSessionDeletionResponseFromFields creates a new UsageReport
+// IE within Session Deletion Response from the given fields.
+func NewUsageReportWithinSessionDeletionResponseFromFields(f *UsageReportFields) *IE {
+	return NewUsageReportFromFields(UsageReportWithinSessionDeletionResponse, f)
+}
+
+// NewUsageReportWithinSessionReportRequestFromFields creates a new UsageReport IE
+// within Session Report Request from the given fields.
+func NewUsageReportWithinSessionReportRequestFromFields(f *UsageReportFields) *IE {
+	return NewUsageReportFromFields(UsageReportWithinSessionReportRequest, f)
+}
+
 // UsageReport returns the IEs above UsageReport if the type of IE matches.
 func (i *IE) UsageReport() (*UsageReportFields, error) {
 	switch i.Type {
@@ -150,11 +150,11 @@ func (f *UserPlaneIPResourceInformationFields) UnmarshalBinary(b []byte) error {
 	if has6thBit(f.Flags) {
 		n := l
 		if has7thBit(f.Flags) {
-			f.SourceInterface = b[n] & 0x0f
 			n--
+			f.SourceInterface = b[n] & 0x0f
 		}
 
-		if l < offset+n {
+		if n < offset {
 			return io.ErrUnexpectedEOF
 		}
 		f.NetworkInstance = string(b[offset:n])
@@ -193,7 +193,7 @@ func (f *UserPlaneIPResourceInformationFields) MarshalTo(b []byte) error {
 	}
 
 	if has1stBit(f.Flags) {
-		copy(b[offset:offset+4], f.IPv4Address)
+		copy(b[offset:offset+4], f.IPv4Address.To4())
 		offset += 4
 	}
 
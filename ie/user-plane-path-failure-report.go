@@ -9,6 +9,21 @@ func NewUserPlanePathFailureReport(peer *IE) *IE {
 	return newGroupedIE(UserPlanePathFailureReport, 0, peer)
 }
 
+// NewUserPlanePathFailureReportFromFields creates a new UserPlanePathFailureReport
+// IE from the given fields.
+func NewUserPlanePathFailureReportFromFields(f *UserPlanePathFailureReportFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	ies := make([]*IE, 0, len(f.RemoteGTPUPeer))
+	for _, v := range f.RemoteGTPUPeer {
+		ies = append(ies, newFieldsIE(RemoteGTPUPeer, v))
+	}
+
+	return newGroupedIE(UserPlanePathFailureReport, 0, ies...)
+}
+
 // UserPlanePathFailureReport returns the IEs above UserPlanePathFailureReport if the type of IE matches.
 func (i *IE) UserPlanePathFailureReport() (*UserPlanePathFailureReportFields, error) {
 	if i.Type != UserPlanePathFailureReport {
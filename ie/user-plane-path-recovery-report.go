@@ -9,6 +9,16 @@ func NewUserPlanePathRecoveryReport(peer *IE) *IE {
 	return newGroupedIE(UserPlanePathRecoveryReport, 0, peer)
 }
 
+// NewUserPlanePathRecoveryReportFromFields creates a new UserPlanePathRecoveryReport
+// IE from the given fields.
+func NewUserPlanePathRecoveryReportFromFields(f *UserPlanePathRecoveryReportFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	return NewUserPlanePathRecoveryReport(newFieldsIE(RemoteGTPUPeer, f.RemoteGTPUPeer))
+}
+
 // UserPlanePathRecoveryReport returns the IEs above UserPlanePathRecoveryReport if the type of IE matches.
 func (i *IE) UserPlanePathRecoveryReport() (*UserPlanePathRecoveryReportFields, error) {
 	if i.Type != UserPlanePathRecoveryReport {
@@ -6,6 +6,9 @@
 package testutil
 
 import (
+	"encoding/json"
+	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -102,6 +105,60 @@ func Run(t *testing.T, cases []TestCase, decode ParseFunc) {
 					t.Fatalf("got %v want %v", got, want)
 				}
 			})
+
+			t.Run("Validate", func(t *testing.T) {
+				v, ok := c.Structured.(interface{ Validate() error })
+				if !ok {
+					return
+				}
+
+				// the test vectors do not always have all the mandatory and
+				// conditional IEs, but all the IEs in them should be allowed.
+				if err := v.Validate(); errors.Is(err, message.ErrUnexpectedIE) {
+					t.Error(err)
+				}
+			})
+
+			t.Run("JSON", func(t *testing.T) {
+				// Ignore *Header in this tests.
+				m, ok := c.Structured.(message.Message)
+				if !ok {
+					return
+				}
+
+				j, err := json.Marshal(m)
+				if err != nil {
+					t.Fatal(err)
+				}
+				want, err := c.Structured.Marshal()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				decoded, err := message.ParseJSON(j)
+				if err != nil {
+					t.Fatal(err)
+				}
+				b, err := decoded.(Serializable).Marshal()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(b, want); diff != "" {
+					t.Error(diff)
+				}
+
+				typed := reflect.New(reflect.TypeOf(m).Elem()).Interface().(Serializable)
+				if err := json.Unmarshal(j, typed); err != nil {
+					t.Fatal(err)
+				}
+				b, err = typed.Marshal()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(b, want); diff != "" {
+					t.Error(diff)
+				}
+			})
 		})
 	}
 }
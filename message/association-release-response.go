@@ -45,20 +45,20 @@ func (m *AssociationReleaseResponse) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *AssociationReleaseResponse) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.NodeID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.Cause; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -68,14 +68,13 @@ func (m *AssociationReleaseResponse) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseAssociationReleaseResponse decodes a given byte sequence as a AssociationReleaseResponse.
@@ -104,19 +103,24 @@ func (m *AssociationReleaseResponse) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.NodeID:
-			m.NodeID = i
-		case ie.Cause:
-			m.Cause = i
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *AssociationReleaseResponse) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.NodeID:
+		m.NodeID = i
+	case ie.Cause:
+		m.Cause = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *AssociationReleaseResponse) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -157,3 +161,24 @@ func (m *AssociationReleaseResponse) SEID() uint64 {
 func (m *AssociationReleaseResponse) IsRequest() bool {
 	return false
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *AssociationReleaseResponse) Validate() error {
+	return validate(MsgTypeAssociationReleaseResponse, m)
+}
+
+// MarshalJSON returns the JSON encoding of a AssociationReleaseResponse.
+func (m *AssociationReleaseResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a AssociationReleaseResponse.
+func (m *AssociationReleaseResponse) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeAssociationReleaseResponse, m)
+}
+
+// Clone returns a deep copy of an AssociationReleaseResponse.
+func (m *AssociationReleaseResponse) Clone() *AssociationReleaseResponse {
+	return Clone(m).(*AssociationReleaseResponse)
+}
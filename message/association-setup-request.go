@@ -86,86 +86,86 @@ func (m *AssociationSetupRequest) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *AssociationSetupRequest) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.NodeID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.RecoveryTimeStamp; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.UPFunctionFeatures; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.CPFunctionFeatures; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UserPlaneIPResourceInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.AlternativeSMFIPAddress {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.SMFSetID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.PFCPSessionRetentionInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UEIPAddressPoolInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.GTPUPathQoSControlInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.ClockDriftControlInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.UPFInstanceID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.PFCPASReqFlags; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -175,14 +175,13 @@ func (m *AssociationSetupRequest) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseAssociationSetupRequest decodes a given byte sequence as a AssociationSetupRequest.
@@ -211,41 +210,46 @@ func (m *AssociationSetupRequest) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.NodeID:
-			m.NodeID = i
-		case ie.RecoveryTimeStamp:
-			m.RecoveryTimeStamp = i
-		case ie.UPFunctionFeatures:
-			m.UPFunctionFeatures = i
-		case ie.CPFunctionFeatures:
-			m.CPFunctionFeatures = i
-		case ie.UserPlaneIPResourceInformation:
-			m.UserPlaneIPResourceInformation = append(m.UserPlaneIPResourceInformation, i)
-		case ie.AlternativeSMFIPAddress:
-			m.AlternativeSMFIPAddress = append(m.AlternativeSMFIPAddress, i)
-		case ie.SMFSetID:
-			m.SMFSetID = i
-		case ie.PFCPSessionRetentionInformation:
-			m.PFCPSessionRetentionInformation = i
-		case ie.UEIPAddressPoolInformation:
-			m.UEIPAddressPoolInformation = append(m.UEIPAddressPoolInformation, i)
-		case ie.GTPUPathQoSControlInformation:
-			m.GTPUPathQoSControlInformation = append(m.GTPUPathQoSControlInformation, i)
-		case ie.ClockDriftControlInformation:
-			m.ClockDriftControlInformation = append(m.ClockDriftControlInformation, i)
-		case ie.NFInstanceID:
-			m.UPFInstanceID = i
-		case ie.PFCPASReqFlags:
-			m.PFCPASReqFlags = i
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *AssociationSetupRequest) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.NodeID:
+		m.NodeID = i
+	case ie.RecoveryTimeStamp:
+		m.RecoveryTimeStamp = i
+	case ie.UPFunctionFeatures:
+		m.UPFunctionFeatures = i
+	case ie.CPFunctionFeatures:
+		m.CPFunctionFeatures = i
+	case ie.UserPlaneIPResourceInformation:
+		m.UserPlaneIPResourceInformation = append(m.UserPlaneIPResourceInformation, i)
+	case ie.AlternativeSMFIPAddress:
+		m.AlternativeSMFIPAddress = append(m.AlternativeSMFIPAddress, i)
+	case ie.SMFSetID:
+		m.SMFSetID = i
+	case ie.PFCPSessionRetentionInformation:
+		m.PFCPSessionRetentionInformation = i
+	case ie.UEIPAddressPoolInformation:
+		m.UEIPAddressPoolInformation = append(m.UEIPAddressPoolInformation, i)
+	case ie.GTPUPathQoSControlInformation:
+		m.GTPUPathQoSControlInformation = append(m.GTPUPathQoSControlInformation, i)
+	case ie.ClockDriftControlInformation:
+		m.ClockDriftControlInformation = append(m.ClockDriftControlInformation, i)
+	case ie.NFInstanceID:
+		m.UPFInstanceID = i
+	case ie.PFCPASReqFlags:
+		m.PFCPASReqFlags = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *AssociationSetupRequest) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -319,3 +323,24 @@ func (m *AssociationSetupRequest) SEID() uint64 {
 func (m *AssociationSetupRequest) IsRequest() bool {
 	return true
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *AssociationSetupRequest) Validate() error {
+	return validate(MsgTypeAssociationSetupRequest, m)
+}
+
+// MarshalJSON returns the JSON encoding of a AssociationSetupRequest.
+func (m *AssociationSetupRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a AssociationSetupRequest.
+func (m *AssociationSetupRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeAssociationSetupRequest, m)
+}
+
+// Clone returns a deep copy of an AssociationSetupRequest.
+func (m *AssociationSetupRequest) Clone() *AssociationSetupRequest {
+	return Clone(m).(*AssociationSetupRequest)
+}
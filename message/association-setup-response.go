@@ -83,80 +83,80 @@ func (m *AssociationSetupResponse) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *AssociationSetupResponse) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.NodeID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.Cause; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.RecoveryTimeStamp; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.UPFunctionFeatures; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.CPFunctionFeatures; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UserPlaneIPResourceInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.AlternativeSMFIPAddress {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.PFCPASRspFlags; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UEIPAddressPoolInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.GTPUPathQoSControlInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.ClockDriftControlInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.UPFInstanceID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -166,14 +166,13 @@ func (m *AssociationSetupResponse) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseAssociationSetupResponse decodes a given byte sequence as a AssociationSetupResponse.
@@ -202,39 +201,44 @@ func (m *AssociationSetupResponse) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.NodeID:
-			m.NodeID = i
-		case ie.Cause:
-			m.Cause = i
-		case ie.RecoveryTimeStamp:
-			m.RecoveryTimeStamp = i
-		case ie.UPFunctionFeatures:
-			m.UPFunctionFeatures = i
-		case ie.CPFunctionFeatures:
-			m.CPFunctionFeatures = i
-		case ie.UserPlaneIPResourceInformation:
-			m.UserPlaneIPResourceInformation = append(m.UserPlaneIPResourceInformation, i)
-		case ie.AlternativeSMFIPAddress:
-			m.AlternativeSMFIPAddress = append(m.AlternativeSMFIPAddress, i)
-		case ie.PFCPASRspFlags:
-			m.PFCPASRspFlags = i
-		case ie.UEIPAddressPoolInformation:
-			m.UEIPAddressPoolInformation = append(m.UEIPAddressPoolInformation, i)
-		case ie.GTPUPathQoSControlInformation:
-			m.GTPUPathQoSControlInformation = append(m.GTPUPathQoSControlInformation, i)
-		case ie.ClockDriftControlInformation:
-			m.ClockDriftControlInformation = append(m.ClockDriftControlInformation, i)
-		case ie.NFInstanceID:
-			m.UPFInstanceID = i
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *AssociationSetupResponse) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.NodeID:
+		m.NodeID = i
+	case ie.Cause:
+		m.Cause = i
+	case ie.RecoveryTimeStamp:
+		m.RecoveryTimeStamp = i
+	case ie.UPFunctionFeatures:
+		m.UPFunctionFeatures = i
+	case ie.CPFunctionFeatures:
+		m.CPFunctionFeatures = i
+	case ie.UserPlaneIPResourceInformation:
+		m.UserPlaneIPResourceInformation = append(m.UserPlaneIPResourceInformation, i)
+	case ie.AlternativeSMFIPAddress:
+		m.AlternativeSMFIPAddress = append(m.AlternativeSMFIPAddress, i)
+	case ie.PFCPASRspFlags:
+		m.PFCPASRspFlags = i
+	case ie.UEIPAddressPoolInformation:
+		m.UEIPAddressPoolInformation = append(m.UEIPAddressPoolInformation, i)
+	case ie.GTPUPathQoSControlInformation:
+		m.GTPUPathQoSControlInformation = append(m.GTPUPathQoSControlInformation, i)
+	case ie.ClockDriftControlInformation:
+		m.ClockDriftControlInformation = append(m.ClockDriftControlInformation, i)
+	case ie.NFInstanceID:
+		m.UPFInstanceID = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *AssociationSetupResponse) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -305,3 +309,24 @@ func (m *AssociationSetupResponse) SEID() uint64 {
 func (m *AssociationSetupResponse) IsRequest() bool {
 	return false
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *AssociationSetupResponse) Validate() error {
+	return validate(MsgTypeAssociationSetupResponse, m)
+}
+
+// MarshalJSON returns the JSON encoding of a AssociationSetupResponse.
+func (m *AssociationSetupResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a AssociationSetupResponse.
+func (m *AssociationSetupResponse) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeAssociationSetupResponse, m)
+}
+
+// Clone returns a deep copy of an AssociationSetupResponse.
+func (m *AssociationSetupResponse) Clone() *AssociationSetupResponse {
+	return Clone(m).(*AssociationSetupResponse)
+}
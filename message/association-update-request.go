@@ -11,18 +11,19 @@ import (
 // AssociationUpdateRequest is a AssociationUpdateRequest formed PFCP Header and its IEs above.
 type AssociationUpdateRequest struct {
 	*Header
-	NodeID                        *ie.IE
-	UPFunctionFeatures            *ie.IE
-	CPFunctionFeatures            *ie.IE
-	PFCPAssociationReleaseRequest *ie.IE
-	GracefulReleasePeriod         *ie.IE
-	PFCPAUReqFlags                *ie.IE
-	AlternativeSMFIPAddress       []*ie.IE
-	ClockDriftControlInformation  []*ie.IE
-	UEIPAddressPoolInformation    []*ie.IE
-	GTPUPathQoSControlInformation []*ie.IE
-	UEIPAddressUsageInformation   []*ie.IE
-	IEs                           []*ie.IE
+	NodeID                         *ie.IE
+	UPFunctionFeatures             *ie.IE
+	CPFunctionFeatures             *ie.IE
+	PFCPAssociationReleaseRequest  *ie.IE
+	GracefulReleasePeriod          *ie.IE
+	PFCPAUReqFlags                 *ie.IE
+	AlternativeSMFIPAddress        []*ie.IE
+	ClockDriftControlInformation   []*ie.IE
+	UEIPAddressPoolInformation     []*ie.IE
+	GTPUPathQoSControlInformation  []*ie.IE
+	UEIPAddressUsageInformation    []*ie.IE
+	UserPlaneIPResourceInformation []*ie.IE
+	IEs                            []*ie.IE
 }
 
 // NewAssociationUpdateRequest creates a new AssociationUpdateRequest.
@@ -59,6 +60,8 @@ func NewAssociationUpdateRequest(seq uint32, ies ...*ie.IE) *AssociationUpdateRe
 			m.GTPUPathQoSControlInformation = append(m.GTPUPathQoSControlInformation, i)
 		case ie.UEIPAddressUsageInformation:
 			m.UEIPAddressUsageInformation = append(m.UEIPAddressUsageInformation, i)
+		case ie.UserPlaneIPResourceInformation:
+			m.UserPlaneIPResourceInformation = append(m.UserPlaneIPResourceInformation, i)
 		default:
 			m.IEs = append(m.IEs, i)
 		}
@@ -80,74 +83,80 @@ func (m *AssociationUpdateRequest) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *AssociationUpdateRequest) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.NodeID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.UPFunctionFeatures; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.CPFunctionFeatures; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.PFCPAssociationReleaseRequest; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.GracefulReleasePeriod; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.PFCPAUReqFlags; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.AlternativeSMFIPAddress {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.ClockDriftControlInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UEIPAddressPoolInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.GTPUPathQoSControlInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UEIPAddressUsageInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+	for _, i := range m.UserPlaneIPResourceInformation {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -157,14 +166,13 @@ func (m *AssociationUpdateRequest) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseAssociationUpdateRequest decodes a given byte sequence as a AssociationUpdateRequest.
@@ -193,37 +201,44 @@ func (m *AssociationUpdateRequest) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.NodeID:
-			m.NodeID = i
-		case ie.UPFunctionFeatures:
-			m.UPFunctionFeatures = i
-		case ie.CPFunctionFeatures:
-			m.CPFunctionFeatures = i
-		case ie.PFCPAssociationReleaseRequest:
-			m.PFCPAssociationReleaseRequest = i
-		case ie.GracefulReleasePeriod:
-			m.GracefulReleasePeriod = i
-		case ie.PFCPAUReqFlags:
-			m.PFCPAUReqFlags = i
-		case ie.AlternativeSMFIPAddress:
-			m.AlternativeSMFIPAddress = append(m.AlternativeSMFIPAddress, i)
-		case ie.ClockDriftControlInformation:
-			m.ClockDriftControlInformation = append(m.ClockDriftControlInformation, i)
-		case ie.UEIPAddressPoolInformation:
-			m.UEIPAddressPoolInformation = append(m.UEIPAddressPoolInformation, i)
-		case ie.GTPUPathQoSControlInformation:
-			m.GTPUPathQoSControlInformation = append(m.GTPUPathQoSControlInformation, i)
-		case ie.UEIPAddressUsageInformation:
-			m.UEIPAddressUsageInformation = append(m.UEIPAddressUsageInformation, i)
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *AssociationUpdateRequest) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.NodeID:
+		m.NodeID = i
+	case ie.UPFunctionFeatures:
+		m.UPFunctionFeatures = i
+	case ie.CPFunctionFeatures:
+		m.CPFunctionFeatures = i
+	case ie.PFCPAssociationReleaseRequest:
+		m.PFCPAssociationReleaseRequest = i
+	case ie.GracefulReleasePeriod:
+		m.GracefulReleasePeriod = i
+	case ie.PFCPAUReqFlags:
+		m.PFCPAUReqFlags = i
+	case ie.AlternativeSMFIPAddress:
+		m.AlternativeSMFIPAddress = append(m.AlternativeSMFIPAddress, i)
+	case ie.ClockDriftControlInformation:
+		m.ClockDriftControlInformation = append(m.ClockDriftControlInformation, i)
+	case ie.UEIPAddressPoolInformation:
+		m.UEIPAddressPoolInformation = append(m.UEIPAddressPoolInformation, i)
+	case ie.GTPUPathQoSControlInformation:
+		m.GTPUPathQoSControlInformation = append(m.GTPUPathQoSControlInformation, i)
+	case ie.UEIPAddressUsageInformation:
+		m.UEIPAddressUsageInformation = append(m.UEIPAddressUsageInformation, i)
+	case ie.UserPlaneIPResourceInformation:
+		m.UserPlaneIPResourceInformation = append(m.UserPlaneIPResourceInformation, i)
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *AssociationUpdateRequest) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -261,6 +276,9 @@ func (m *AssociationUpdateRequest) MarshalLen() int {
 	for _, i := range m.UEIPAddressUsageInformation {
 		l += i.MarshalLen()
 	}
+	for _, i := range m.UserPlaneIPResourceInformation {
+		l += i.MarshalLen()
+	}
 
 	for _, ie := range m.IEs {
 		if ie == nil {
@@ -291,3 +309,24 @@ func (m *AssociationUpdateRequest) SEID() uint64 {
 func (m *AssociationUpdateRequest) IsRequest() bool {
 	return true
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *AssociationUpdateRequest) Validate() error {
+	return validate(MsgTypeAssociationUpdateRequest, m)
+}
+
+// MarshalJSON returns the JSON encoding of a AssociationUpdateRequest.
+func (m *AssociationUpdateRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a AssociationUpdateRequest.
+func (m *AssociationUpdateRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeAssociationUpdateRequest, m)
+}
+
+// Clone returns a deep copy of an AssociationUpdateRequest.
+func (m *AssociationUpdateRequest) Clone() *AssociationUpdateRequest {
+	return Clone(m).(*AssociationUpdateRequest)
+}
@@ -59,32 +59,32 @@ func (m *AssociationUpdateResponse) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *AssociationUpdateResponse) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.NodeID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.Cause; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.UPFunctionFeatures; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.CPFunctionFeatures; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -94,14 +94,13 @@ func (m *AssociationUpdateResponse) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseAssociationUpdateResponse decodes a given byte sequence as a AssociationUpdateResponse.
@@ -130,23 +129,28 @@ func (m *AssociationUpdateResponse) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.NodeID:
-			m.NodeID = i
-		case ie.Cause:
-			m.Cause = i
-		case ie.UPFunctionFeatures:
-			m.UPFunctionFeatures = i
-		case ie.CPFunctionFeatures:
-			m.CPFunctionFeatures = i
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *AssociationUpdateResponse) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.NodeID:
+		m.NodeID = i
+	case ie.Cause:
+		m.Cause = i
+	case ie.UPFunctionFeatures:
+		m.UPFunctionFeatures = i
+	case ie.CPFunctionFeatures:
+		m.CPFunctionFeatures = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *AssociationUpdateResponse) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -193,3 +197,24 @@ func (m *AssociationUpdateResponse) SEID() uint64 {
 func (m *AssociationUpdateResponse) IsRequest() bool {
 	return false
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *AssociationUpdateResponse) Validate() error {
+	return validate(MsgTypeAssociationUpdateResponse, m)
+}
+
+// MarshalJSON returns the JSON encoding of a AssociationUpdateResponse.
+func (m *AssociationUpdateResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a AssociationUpdateResponse.
+func (m *AssociationUpdateResponse) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeAssociationUpdateResponse, m)
+}
+
+// Clone returns a deep copy of an AssociationUpdateResponse.
+func (m *AssociationUpdateResponse) Clone() *AssociationUpdateResponse {
+	return Clone(m).(*AssociationUpdateResponse)
+}
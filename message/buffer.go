@@ -0,0 +1,51 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import "sync"
+
+// Buffer is a buffer that holds a message marshalled by MarshalBuffer. The
+// buffers are managed in a pool to be reused, so a Buffer should be returned
+// to the pool with Release once its bytes are no longer used.
+type Buffer struct {
+	b []byte
+}
+
+var buffers = sync.Pool{
+	New: func() any {
+		return &Buffer{b: make([]byte, 0, 1500)}
+	},
+}
+
+// MarshalBuffer marshals m into a Buffer taken from the pool, which should be
+// released with Release after the bytes are sent.
+func MarshalBuffer(m Message) (*Buffer, error) {
+	buf := buffers.Get().(*Buffer)
+
+	l := m.MarshalLen()
+	if cap(buf.b) < l {
+		buf.b = make([]byte, l)
+	}
+	buf.b = buf.b[:l]
+
+	if err := m.MarshalTo(buf.b); err != nil {
+		buf.Release()
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Bytes returns the marshalled message. It must not be used after the Buffer
+// is released.
+func (b *Buffer) Bytes() []byte {
+	return b.b
+}
+
+// Release returns the Buffer to the pool. The Buffer and the bytes returned
+// by Bytes must not be used after calling this.
+func (b *Buffer) Release() {
+	b.b = b.b[:0]
+	buffers.Put(b)
+}
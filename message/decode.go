@@ -0,0 +1,108 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// ieAdder is implemented by the messages to set a decoded IE in the field.
+type ieAdder interface {
+	Message
+	addIE(i *ie.IE)
+}
+
+// recycledIEs holds the slices to keep the IEs taken from a message in
+// DecodeInto until they are reused.
+var recycledIEs = sync.Pool{
+	New: func() any {
+		ies := make([]*ie.IE, 0, 64)
+		return &ies
+	},
+}
+
+// DecodeInto decodes b into m like UnmarshalBinary, but reuses the Header and
+// the IEs that m holds, the capacity of the slices of IEs in m, and the
+// ChildIEs of the IEs, instead of allocating new ones. Decoding messages into
+// the same m repeatedly does not allocate once m has grown enough to hold them.
+//
+// m must be a non-nil pointer to one of the message structs in this package,
+// and the type in the header of b is not checked against it, as with
+// UnmarshalBinary.
+//
+// Note that the IEs m held before the call are overwritten, so they must not
+// be retained by the caller nor shared between the fields of m. Like Parse, m
+// refers to b after the call.
+//
+// transport.Conn does not use DecodeInto, as the messages it receives are
+// passed to the handlers running concurrently. It is meant for the callers
+// that handle the messages one by one in their own receive loop.
+func DecodeInto(m Message, b []byte) error {
+	a, ok := m.(ieAdder)
+	if !ok || reflect.ValueOf(m).IsNil() {
+		return fmt.Errorf("%w: cannot decode into %T", ErrInvalidMessageType, m)
+	}
+
+	p := recycledIEs.Get().(*[]*ie.IE)
+	defer func() {
+		clear((*p)[:cap(*p)])
+		*p = (*p)[:0]
+		recycledIEs.Put(p)
+	}()
+
+	var h *Header
+	v := reflect.ValueOf(m).Elem()
+	for n := 0; n < v.NumField(); n++ {
+		f := v.Field(n)
+		switch f.Type() {
+		case headerType:
+			if f.IsNil() {
+				f.Set(reflect.ValueOf(&Header{}))
+			}
+			h = f.Interface().(*Header)
+		case ieType:
+			if !f.IsNil() {
+				*p = append(*p, f.Interface().(*ie.IE))
+				f.SetZero()
+			}
+		case ieSliceType:
+			for i := 0; i < f.Len(); i++ {
+				if e := f.Index(i); !e.IsNil() {
+					*p = append(*p, e.Interface().(*ie.IE))
+				}
+			}
+			f.SetLen(0)
+		}
+	}
+
+	if err := h.UnmarshalBinary(b); err != nil {
+		return err
+	}
+	if len(h.Payload) < 2 {
+		return nil
+	}
+
+	for b := h.Payload; len(b) > 0; {
+		var i *ie.IE
+		if n := len(*p); n > 0 {
+			i = (*p)[n-1]
+			*p = (*p)[:n-1]
+		} else {
+			i = &ie.IE{}
+		}
+
+		if err := ie.DecodeInto(i, b); err != nil {
+			return err
+		}
+		a.addIE(i)
+		b = b[i.MarshalLen():]
+	}
+
+	return nil
+}
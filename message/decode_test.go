@@ -0,0 +1,179 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+func TestDecodeInto(t *testing.T) {
+	m := &message.SessionEstablishmentRequest{}
+	for _, teids := range [][]uint32{
+		{0x11111111, 0x22222222, 0x33333333},
+		{0x44444444},
+		{0x55555555, 0x66666666},
+	} {
+		b, err := newTestSessionEstablishmentRequest(teids...).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := message.ParseSessionEstablishmentRequest(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := message.DecodeInto(m, b); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(m, want, cmpopts.EquateEmpty()); diff != "" {
+			t.Error(diff)
+		}
+
+		allocs := testing.AllocsPerRun(100, func() {
+			if err := message.DecodeInto(m, b); err != nil {
+				t.Fatal(err)
+			}
+		})
+		if allocs != 0 {
+			t.Errorf("got %v allocs/op, want 0", allocs)
+		}
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		var m *message.HeartbeatRequest
+		if err := message.DecodeInto(m, []byte{0x20, 0x01, 0x00, 0x04, 0x00, 0x00, 0x01, 0x00}); !errors.Is(err, message.ErrInvalidMessageType) {
+			t.Errorf("got %v, want %v", err, message.ErrInvalidMessageType)
+		}
+	})
+}
+
+func TestMarshalBuffer(t *testing.T) {
+	m := newTestSessionEstablishmentRequest(0x11111111, 0x22222222)
+	want, err := m.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for range 2 {
+		buf, err := message.MarshalBuffer(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(buf.Bytes(), want); diff != "" {
+			t.Error(diff)
+		}
+		buf.Release()
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, err := message.MarshalBuffer(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf.Release()
+	})
+	if allocs != 0 {
+		t.Errorf("got %v allocs/op, want 0", allocs)
+	}
+
+	t.Run("Payload", func(t *testing.T) {
+		parsed, err := message.Parse(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		before := slices.Clone(parsed.(*message.SessionEstablishmentRequest).Payload)
+
+		b := make([]byte, parsed.MarshalLen())
+		if err := parsed.MarshalTo(b); err != nil {
+			t.Fatal(err)
+		}
+		clear(b)
+
+		// the Payload must not refer to the buffer given to MarshalTo.
+		if diff := cmp.Diff(parsed.(*message.SessionEstablishmentRequest).Payload, before); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("ShortBuffer", func(t *testing.T) {
+		if err := m.MarshalTo(make([]byte, m.MarshalLen()-1)); !errors.Is(err, io.ErrShortBuffer) {
+			t.Errorf("got %v, want %v", err, io.ErrShortBuffer)
+		}
+	})
+}
+
+func BenchmarkParseSessionEstablishmentRequest(b *testing.B) {
+	s, err := newTestSessionEstablishmentRequest(0x11111111, 0x22222222).Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := message.Parse(s); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeIntoSessionEstablishmentRequest(b *testing.B) {
+	s, err := newTestSessionEstablishmentRequest(0x11111111, 0x22222222).Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	m := &message.SessionEstablishmentRequest{}
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := message.DecodeInto(m, s); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() { _ = message.DecodeInto(m, s) }); allocs != 0 {
+		b.Errorf("got %v allocs/op, want 0", allocs)
+	}
+}
+
+func BenchmarkMarshalSessionEstablishmentRequest(b *testing.B) {
+	m := newTestSessionEstablishmentRequest(0x11111111, 0x22222222)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := m.Marshal(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshalBufferSessionEstablishmentRequest(b *testing.B) {
+	m := newTestSessionEstablishmentRequest(0x11111111, 0x22222222)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		buf, err := message.MarshalBuffer(m)
+		if err != nil {
+			b.Fatal(err)
+		}
+		buf.Release()
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		if buf, err := message.MarshalBuffer(m); err == nil {
+			buf.Release()
+		}
+	})
+	if allocs != 0 {
+		b.Errorf("got %v allocs/op, want 0", allocs)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+var headerType = reflect.TypeOf((*Header)(nil))
+
+// Clone returns a deep copy of m, which shares no memory with the original
+// one, e.g., the buffer given to Parse.
+func Clone(m Message) Message {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return m
+	}
+
+	c := reflect.New(v.Elem().Type())
+	c.Elem().Set(v.Elem())
+	for n := 0; n < c.Elem().NumField(); n++ {
+		f := c.Elem().Field(n)
+		if !f.CanSet() {
+			continue
+		}
+
+		switch f.Type() {
+		case headerType:
+			f.Set(reflect.ValueOf(f.Interface().(*Header).clone()))
+		case ieType:
+			f.Set(reflect.ValueOf(f.Interface().(*ie.IE).Clone()))
+		case ieSliceType:
+			ies := f.Interface().([]*ie.IE)
+			if ies == nil {
+				continue
+			}
+			cloned := make([]*ie.IE, len(ies))
+			for n, i := range ies {
+				cloned[n] = i.Clone()
+			}
+			f.Set(reflect.ValueOf(cloned))
+		}
+	}
+
+	return c.Interface().(Message)
+}
+
+func (h *Header) clone() *Header {
+	if h == nil {
+		return nil
+	}
+
+	c := *h
+	c.Payload = bytes.Clone(h.Payload)
+	return &c
+}
+
+// Difference is a difference between two messages found by Diff.
+type Difference struct {
+	// Path is "Header/<field>" for the fields in the header, or the path to
+	// the IEs in the format of ie.Difference.
+	Path string
+	// X and Y are the values of the field in the header, or the IEs that
+	// differ. Either of them is nil if the IE is missing on the side.
+	X, Y any
+}
+
+// String returns the difference in a human-readable format.
+func (d Difference) String() string {
+	x, xok := d.X.(*ie.IE)
+	y, yok := d.Y.(*ie.IE)
+	if xok || yok || (d.X == nil && d.Y == nil) {
+		return ie.Difference{Path: d.Path, X: x, Y: y}.String()
+	}
+	return fmt.Sprintf("%s: %v != %v", d.Path, d.X, d.Y)
+}
+
+// Equal reports whether x and y are semantically equal, i.e., Diff finds no
+// difference between them.
+func Equal(x, y Message) bool {
+	return len(Diff(x, y)) == 0
+}
+
+// Diff returns the semantic differences between x and y.
+//
+// The header is compared field by field except the Length, and the IEs are
+// compared in the way described in ie.Diff, regardless of the fields of the
+// message structs holding them.
+func Diff(x, y Message) []Difference {
+	hx, hy := headerOf(x), headerOf(y)
+	if hx == nil || hy == nil {
+		if hx == hy {
+			return nil
+		}
+		return []Difference{{Path: "Header", X: hx, Y: hy}}
+	}
+
+	var diffs []Difference
+	for _, f := range []struct {
+		name string
+		x, y any
+	}{
+		{"Version", hx.Version(), hy.Version()},
+		{"FO", hx.HasFO(), hy.HasFO()},
+		{"MP", hx.HasMP(), hy.HasMP()},
+		{"S", hx.HasSEID(), hy.HasSEID()},
+		{"Type", hx.Type, hy.Type},
+		{"SEID", hx.seid(), hy.seid()},
+		{"SequenceNumber", hx.SequenceNumber, hy.SequenceNumber},
+		{"MessagePriority", priorityOf(hx), priorityOf(hy)},
+	} {
+		if f.x != f.y {
+			diffs = append(diffs, Difference{Path: "Header/" + f.name, X: f.x, Y: f.y})
+		}
+	}
+
+	for _, d := range ie.DiffIEs(collectIEs(x), collectIEs(y)) {
+		diff := Difference{Path: d.Path}
+		if d.X != nil {
+			diff.X = d.X
+		}
+		if d.Y != nil {
+			diff.Y = d.Y
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs
+}
+
+// headerOf returns the header of m, or nil if m or its header is nil.
+func headerOf(m Message) *Header {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return nil
+	}
+
+	v = v.Elem()
+	for n := 0; n < v.NumField(); n++ {
+		if f := v.Field(n); f.Type() == headerType {
+			h, _ := f.Interface().(*Header)
+			return h
+		}
+	}
+	return nil
+}
+
+// priorityOf returns the message priority if the MP flag is set, or 0.
+func priorityOf(h *Header) uint8 {
+	if !h.HasMP() {
+		return 0
+	}
+	return h.MP()
+}
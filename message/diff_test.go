@@ -0,0 +1,97 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+func newTestSessionEstablishmentRequest(teids ...uint32) *message.SessionEstablishmentRequest {
+	pdrs := make([]*ie.IE, len(teids))
+	for n, teid := range teids {
+		pdrs[n] = ie.NewCreatePDR(
+			ie.NewPDRID(uint16(n+1)),
+			ie.NewPDI(
+				ie.NewSourceInterface(ie.SrcInterfaceAccess),
+				ie.NewFTEID(0x01, teid, net.ParseIP("127.0.0.1"), nil, 0),
+			),
+		)
+	}
+
+	return message.NewSessionEstablishmentRequest(
+		mp, fo, seid, seq, pri,
+		append([]*ie.IE{
+			ie.NewNodeID("127.0.0.1", "", ""),
+			ie.NewFSEID(0x1111111122222222, net.ParseIP("127.0.0.1"), nil),
+		}, pdrs...)...,
+	)
+}
+
+func TestClone(t *testing.T) {
+	b, err := newTestSessionEstablishmentRequest(0x11111111, 0x22222222).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := message.ParseSessionEstablishmentRequest(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := m.Clone()
+	if diff := cmp.Diff(c, m); diff != "" {
+		t.Fatal(diff)
+	}
+
+	// the clone should not be affected by the changes in the buffer.
+	want, err := message.ParseSessionEstablishmentRequest(append([]byte(nil), b...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n := range b {
+		b[n] = 0
+	}
+	if diff := cmp.Diff(c, want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("Equal", func(t *testing.T) {
+		x := newTestSessionEstablishmentRequest(0x11111111, 0x22222222)
+		b, err := x.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		y, err := message.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !message.Equal(x, y) {
+			t.Errorf("should be equal: %v", message.Diff(x, y))
+		}
+	})
+
+	t.Run("Paths", func(t *testing.T) {
+		x := newTestSessionEstablishmentRequest(0x11111111, 0x22222222, 0x33333333)
+		y := newTestSessionEstablishmentRequest(0x11111111, 0x22222222, 0x44444444)
+		y.SetSequenceNumber(0x445566)
+
+		var got []string
+		for _, d := range message.Diff(x, y) {
+			got = append(got, d.Path)
+		}
+		want := []string{"Header/SequenceNumber", "CreatePDR[2]/PDI/FTEID"}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
@@ -0,0 +1,147 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"errors"
+	"io"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// NewErrorResponse creates a response to req that rejects it with the Cause
+// and the Offending IE derived from err. The sequence number is copied from
+// req.
+//
+// seid is the SEID of the peer set in the header of the session-related
+// responses, i.e., the one in the CP F-SEID of a Session Establishment
+// Request, or the remote SEID of the session the request is for. It should be
+// 0 if it is not known, e.g., the CP F-SEID could not be decoded or no session
+// is found. Note that the SEID in the header of req cannot be used, as it is
+// the one allocated by the local node. seid is ignored for the node-related
+// responses.
+//
+// ies are added to the response as they are. They should contain the IEs
+// mandatory in the response other than Cause, e.g., Node ID for the node
+// related messages and Recovery Time Stamp for Association Setup Response.
+//
+// err is mapped onto Cause as follows:
+//
+//   - *ValidationError and any error that has Cause() and OffendingIE()
+//     methods: the values they return.
+//   - ErrVersionNotSupported: a Version Not Supported Response is created
+//     instead.
+//   - io.ErrUnexpectedEOF, ie.ErrTooShortToParse, ie.ErrInvalidLength:
+//     CauseInvalidLength.
+//   - ie.ErrMalformed, *ie.InvalidTypeError, *ie.InvalidNodeIDError:
+//     CauseMandatoryIEIncorrect.
+//   - others: CauseRequestRejected.
+//
+// It returns ErrNoErrorResponse if req is not a request or its response
+// cannot carry Cause.
+func NewErrorResponse(req Message, seid uint64, err error, ies ...*ie.IE) (Message, error) {
+	return newErrorResponse(req.MessageType(), seid, req.Sequence(), err, ies...)
+}
+
+// NewErrorResponseFromHeader creates a response in the same way as
+// NewErrorResponse, from the header of a request whose body could not be
+// decoded.
+func NewErrorResponseFromHeader(h *Header, seid uint64, err error, ies ...*ie.IE) (Message, error) {
+	return newErrorResponse(h.Type, seid, h.SequenceNumber, err, ies...)
+}
+
+func newErrorResponse(reqType uint8, seid uint64, seq uint32, err error, ies ...*ie.IE) (Message, error) {
+	if errors.Is(err, ErrVersionNotSupported) {
+		return NewVersionNotSupportedResponse(seq), nil
+	}
+
+	c, o := causeFromError(err)
+	cause := ie.NewCause(c)
+
+	switch reqType {
+	case MsgTypePFDManagementRequest:
+		return NewPFDManagementResponse(seq, cause, o, ies...), nil
+	case MsgTypeAssociationSetupRequest:
+		return NewAssociationSetupResponse(seq, append([]*ie.IE{cause}, ies...)...), nil
+	case MsgTypeAssociationUpdateRequest:
+		return NewAssociationUpdateResponse(seq, append([]*ie.IE{cause}, ies...)...), nil
+	case MsgTypeAssociationReleaseRequest:
+		id, rest := splitNodeID(ies)
+		return NewAssociationReleaseResponse(seq, id, cause, rest...), nil
+	case MsgTypeNodeReportRequest:
+		id, rest := splitNodeID(ies)
+		return NewNodeReportResponse(seq, id, cause, o, rest...), nil
+	case MsgTypeSessionSetDeletionRequest:
+		id, rest := splitNodeID(ies)
+		return NewSessionSetDeletionResponse(seq, id, cause, o, rest...), nil
+	case MsgTypeSessionSetModificationRequest:
+		id, rest := splitNodeID(ies)
+		return NewSessionSetModificationResponse(seq, id, cause, o, rest...), nil
+	case MsgTypeSessionEstablishmentRequest:
+		return NewSessionEstablishmentResponse(0, 0, seid, seq, 0, withCause(cause, o, ies)...), nil
+	case MsgTypeSessionModificationRequest:
+		return NewSessionModificationResponse(0, 0, seid, seq, 0, withCause(cause, o, ies)...), nil
+	case MsgTypeSessionDeletionRequest:
+		return NewSessionDeletionResponse(0, 0, seid, seq, 0, withCause(cause, o, ies)...), nil
+	case MsgTypeSessionReportRequest:
+		return NewSessionReportResponse(0, 0, seid, seq, 0, withCause(cause, o, ies)...), nil
+	default:
+		return nil, ErrNoErrorResponse
+	}
+}
+
+// causeFromError returns the value of Cause IE and the Offending IE that
+// describe err. The Offending IE is nil if it is unknown or not applicable.
+func causeFromError(err error) (uint8, *ie.IE) {
+	var ce interface {
+		Cause() uint8
+		OffendingIE() *ie.IE
+	}
+	if errors.As(err, &ce) {
+		return ce.Cause(), ce.OffendingIE()
+	}
+
+	var te *ie.InvalidTypeError
+	if errors.As(err, &te) {
+		return ie.CauseMandatoryIEIncorrect, ie.NewOffendingIE(te.Type)
+	}
+
+	var ne *ie.InvalidNodeIDError
+	if errors.As(err, &ne) {
+		return ie.CauseMandatoryIEIncorrect, ie.NewOffendingIE(ie.NodeID)
+	}
+
+	switch {
+	case errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, ie.ErrTooShortToParse),
+		errors.Is(err, ie.ErrInvalidLength):
+		return ie.CauseInvalidLength, nil
+	case errors.Is(err, ie.ErrMalformed):
+		return ie.CauseMandatoryIEIncorrect, nil
+	default:
+		return ie.CauseRequestRejected, nil
+	}
+}
+
+// splitNodeID returns the first Node ID IE in ies and the rest of them.
+func splitNodeID(ies []*ie.IE) (*ie.IE, []*ie.IE) {
+	for n, i := range ies {
+		if i != nil && i.Type == ie.NodeID {
+			var rest []*ie.IE
+			rest = append(rest, ies[:n]...)
+			return i, append(rest, ies[n+1:]...)
+		}
+	}
+	return nil, ies
+}
+
+func withCause(cause, offending *ie.IE, ies []*ie.IE) []*ie.IE {
+	l := make([]*ie.IE, 0, len(ies)+2)
+	l = append(l, cause)
+	if offending != nil {
+		l = append(l, offending)
+	}
+	return append(l, ies...)
+}
@@ -0,0 +1,133 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+func TestNewErrorResponse(t *testing.T) {
+	nodeID := ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org")
+
+	t.Run("ValidationError", func(t *testing.T) {
+		req := message.NewSessionEstablishmentRequest(
+			mp, fo, seid, seq, pri,
+			ie.NewFSEID(0x1111111122222222, net.ParseIP("127.0.0.1"), nil),
+			ie.NewCreatePDR(ie.NewPDRID(0xffff)),
+			ie.NewCreateFAR(ie.NewFARID(0xffffffff)),
+		)
+		verr := req.Validate()
+		if verr == nil {
+			t.Fatal("Validate() should fail")
+		}
+
+		got, err := message.NewErrorResponse(req, 0x1111111122222222, verr, nodeID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := message.NewSessionEstablishmentResponse(
+			0, 0, 0x1111111122222222, seq, 0,
+			nodeID,
+			ie.NewCause(ie.CauseMandatoryIEMissing),
+			ie.NewOffendingIE(ie.NodeID),
+		)
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("NodeRelated", func(t *testing.T) {
+		req := message.NewNodeReportRequest(seq, nodeID, ie.NewNodeReportType(0x01))
+
+		got, err := message.NewErrorResponse(req, 0, req.Validate(), nodeID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := message.NewNodeReportResponse(
+			seq, nodeID,
+			ie.NewCause(ie.CauseConditionalIEMissing),
+			ie.NewOffendingIE(ie.UserPlanePathFailureReport),
+		)
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("DecodeError", func(t *testing.T) {
+		b, err := message.NewSessionModificationRequest(
+			mp, fo, seid, seq, pri,
+			ie.NewRemovePDR(ie.NewPDRID(0xffff)),
+		).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		// make the length of the Remove PDR exceed the payload.
+		b[19] = 0xff
+
+		_, perr := message.Parse(b)
+		if perr == nil {
+			t.Fatal("Parse() should fail")
+		}
+		h, err := message.ParseHeader(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the SEID of the peer, which is not in the header of the request.
+		got, err := message.NewErrorResponseFromHeader(h, 0x3333333344444444, perr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := message.NewSessionModificationResponse(
+			0, 0, 0x3333333344444444, seq, 0,
+			ie.NewCause(ie.CauseInvalidLength),
+		)
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("VersionNotSupported", func(t *testing.T) {
+		b, err := message.NewHeader(2, 0, 0, 0, message.MsgTypeAssociationSetupRequest, 0, seq, 0, nil).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, perr := message.ParseStrict(b)
+		var verr *message.VersionNotSupportedError
+		if !errors.As(perr, &verr) {
+			t.Fatalf("got unexpected error: %v", perr)
+		}
+
+		got, err := message.NewErrorResponseFromHeader(verr.Header, 0, perr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := message.NewVersionNotSupportedResponse(seq)
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("NoCause", func(t *testing.T) {
+		req := message.NewHeartbeatRequest(seq, nil, nil)
+
+		_, err := message.NewErrorResponse(req, 0, req.Validate())
+		if !errors.Is(err, message.ErrNoErrorResponse) {
+			t.Errorf("got %v, want %v", err, message.ErrNoErrorResponse)
+		}
+	})
+}
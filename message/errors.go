@@ -0,0 +1,83 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// Error definitions.
+var (
+	ErrVersionNotSupported  = errors.New("version not supported")
+	ErrMandatoryIEMissing   = errors.New("mandatory IE missing")
+	ErrConditionalIEMissing = errors.New("conditional IE missing")
+	ErrUnexpectedIE         = errors.New("unexpected IE")
+	ErrNoErrorResponse      = errors.New("no response with Cause for the message")
+	ErrInvalidMessageType   = errors.New("invalid message type")
+)
+
+// VersionNotSupportedError indicates that a message is encoded in a version of
+// PFCP other than SupportedVersion. It matches ErrVersionNotSupported with
+// errors.Is.
+type VersionNotSupportedError struct {
+	// Header is the header of the message. Its Payload is not decoded.
+	Header *Header
+}
+
+// Error returns message with the version and the type of the message.
+func (e *VersionNotSupportedError) Error() string {
+	return fmt.Sprintf("%s: message(Type=%d, Seq=%d) has version %d",
+		ErrVersionNotSupported, e.Header.Type, e.Header.SequenceNumber, e.Header.Version(),
+	)
+}
+
+// Is reports whether target is ErrVersionNotSupported.
+func (e *VersionNotSupportedError) Is(target error) bool {
+	return target == ErrVersionNotSupported
+}
+
+// ValidationError indicates that an IE required in a message is missing, or
+// that an IE not defined for the message is present. Err is one of
+// ErrMandatoryIEMissing, ErrConditionalIEMissing and ErrUnexpectedIE, which
+// can be matched with errors.Is.
+type ValidationError struct {
+	MessageType uint8
+	IEType      ie.IEType
+	Err         error
+}
+
+// Error returns message with the type of the IE and the type of the message.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s(%d) in message(Type=%d)", e.Err, e.IEType, e.IEType, e.MessageType)
+}
+
+// Unwrap returns the underlying error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Cause returns the value of Cause IE to be sent back to the peer.
+//
+// As TS 29.244 7.6.2 requires the receiver to ignore an unexpected IE, it
+// returns CauseRequestRejected for ErrUnexpectedIE in case the caller chooses
+// to reject the message anyway.
+func (e *ValidationError) Cause() uint8 {
+	switch {
+	case errors.Is(e.Err, ErrMandatoryIEMissing):
+		return ie.CauseMandatoryIEMissing
+	case errors.Is(e.Err, ErrConditionalIEMissing):
+		return ie.CauseConditionalIEMissing
+	default:
+		return ie.CauseRequestRejected
+	}
+}
+
+// OffendingIE returns the Offending IE to be sent back to the peer.
+func (e *ValidationError) OffendingIE() *ie.IE {
+	return ie.NewOffendingIE(e.IEType)
+}
@@ -58,24 +58,23 @@ func (m *Generic) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *Generic) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	for _, ie := range m.IEs {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseGeneric decodes a given byte sequence as a Generic.
@@ -105,6 +104,11 @@ func (m *Generic) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+// addIE appends i to IEs.
+func (m *Generic) addIE(i *ie.IE) {
+	m.IEs = append(m.IEs, i)
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *Generic) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -148,3 +152,29 @@ func (m *Generic) AddIE(ies ...*ie.IE) {
 func (m *Generic) IsRequest() bool {
 	return false
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244 for the type in the header, and returns
+// *ValidationError if not. It always returns nil for an unknown type.
+func (m *Generic) Validate() error {
+	return validate(m.Header.Type, m)
+}
+
+// MarshalJSON returns the JSON encoding of a Generic.
+func (m *Generic) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a Generic.
+func (m *Generic) UnmarshalJSON(b []byte) error {
+	raw, err := decodeJSON(b)
+	if err != nil {
+		return err
+	}
+	return m.UnmarshalBinary(raw)
+}
+
+// Clone returns a deep copy of a Generic.
+func (m *Generic) Clone() *Generic {
+	return Clone(m).(*Generic)
+}
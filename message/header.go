@@ -73,6 +73,15 @@ func (h *Header) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (h *Header) MarshalTo(b []byte) error {
+	offset := h.marshalFieldsTo(b)
+	copy(b[offset:h.MarshalLen()], h.Payload)
+
+	return nil
+}
+
+// marshalFieldsTo puts the fields other than Payload in b, and returns the
+// offset where the Payload starts.
+func (h *Header) marshalFieldsTo(b []byte) int {
 	b[0] = h.Flags
 	b[1] = h.Type
 	binary.BigEndian.PutUint16(b[2:4], h.Length)
@@ -85,9 +94,8 @@ func (h *Header) MarshalTo(b []byte) error {
 
 	copy(b[offset:offset+3], uint32To24(h.SequenceNumber))
 	b[offset+3] = h.MessagePriority
-	copy(b[offset+4:h.MarshalLen()], h.Payload)
 
-	return nil
+	return offset + 4
 }
 
 // ParseHeader decodes given byte sequence as a PFCP header.
@@ -137,6 +145,27 @@ func (h *Header) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+// payloadIn returns the part of b after the header, so that the IEs of a
+// message of l bytes are marshalled directly into b.
+func (h *Header) payloadIn(b []byte, l int) ([]byte, error) {
+	if len(b) < l {
+		return nil, io.ErrShortBuffer
+	}
+	return b[h.MarshalLen()-len(h.Payload) : l], nil
+}
+
+// marshalWithPayloadTo sets the Length for the payload of n bytes that is
+// already in b, and puts the other fields in b. The Payload is not changed.
+func (h *Header) marshalWithPayloadTo(b []byte, n int) error {
+	h.Length = uint16(4 + n)
+	if h.HasSEID() {
+		h.Length += 8
+	}
+	h.marshalFieldsTo(b)
+
+	return nil
+}
+
 // MarshalLen returns field length in integer.
 func (h *Header) MarshalLen() int {
 	l := 8 + len(h.Payload)
@@ -157,7 +186,7 @@ func (h *Header) SetLength() {
 
 // Version returns the PFCP version.
 func (h *Header) Version() int {
-	return 1
+	return int(h.Flags >> 5)
 }
 
 // MessageType returns the type of messagg.
@@ -191,7 +220,7 @@ func (h *Header) SetSequenceNumber(seq uint32) {
 // SetMP sets the M Flag to 1 and puts the MessagePriority
 // given into MessagePriority field.
 func (h *Header) SetMP(mp uint8) {
-	h.Flags |= (1 << 2)
+	h.Flags |= (1 << 1)
 	h.MessagePriority = (mp << 4) & 0xf0
 }
 
@@ -58,3 +58,18 @@ func TestHeader(t *testing.T) {
 		return v, nil
 	})
 }
+
+func TestHeaderMP(t *testing.T) {
+	h := message.NewHeader(1, 0, 0, 1, 50, 0, 0, 0, nil)
+	h.SetMP(3)
+
+	if !h.HasMP() {
+		t.Error("MP flag is not set")
+	}
+	if h.HasFO() {
+		t.Error("FO flag is set")
+	}
+	if got, want := h.MP(), uint8(3); got != want {
+		t.Errorf("got %d want %d", got, want)
+	}
+}
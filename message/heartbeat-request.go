@@ -45,20 +45,20 @@ func (m *HeartbeatRequest) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *HeartbeatRequest) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.RecoveryTimeStamp; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.SourceIPAddress; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -68,14 +68,13 @@ func (m *HeartbeatRequest) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseHeartbeatRequest decodes a given byte sequence as a HeartbeatRequest.
@@ -104,19 +103,24 @@ func (m *HeartbeatRequest) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.RecoveryTimeStamp:
-			m.RecoveryTimeStamp = i
-		case ie.SourceIPAddress:
-			m.SourceIPAddress = i
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *HeartbeatRequest) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.RecoveryTimeStamp:
+		m.RecoveryTimeStamp = i
+	case ie.SourceIPAddress:
+		m.SourceIPAddress = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *HeartbeatRequest) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -169,3 +173,24 @@ func (m *HeartbeatRequest) SEID() uint64 {
 func (m *HeartbeatRequest) IsRequest() bool {
 	return true
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *HeartbeatRequest) Validate() error {
+	return validate(MsgTypeHeartbeatRequest, m)
+}
+
+// MarshalJSON returns the JSON encoding of a HeartbeatRequest.
+func (m *HeartbeatRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a HeartbeatRequest.
+func (m *HeartbeatRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeHeartbeatRequest, m)
+}
+
+// Clone returns a deep copy of a HeartbeatRequest.
+func (m *HeartbeatRequest) Clone() *HeartbeatRequest {
+	return Clone(m).(*HeartbeatRequest)
+}
@@ -43,14 +43,14 @@ func (m *HeartbeatResponse) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *HeartbeatResponse) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.RecoveryTimeStamp; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -60,14 +60,13 @@ func (m *HeartbeatResponse) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseHeartbeatResponse decodes a given byte sequence as a HeartbeatResponse.
@@ -96,17 +95,22 @@ func (m *HeartbeatResponse) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.RecoveryTimeStamp:
-			m.RecoveryTimeStamp = i
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *HeartbeatResponse) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.RecoveryTimeStamp:
+		m.RecoveryTimeStamp = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *HeartbeatResponse) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -153,3 +157,24 @@ func (m *HeartbeatResponse) SEID() uint64 {
 func (m *HeartbeatResponse) IsRequest() bool {
 	return false
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *HeartbeatResponse) Validate() error {
+	return validate(MsgTypeHeartbeatResponse, m)
+}
+
+// MarshalJSON returns the JSON encoding of a HeartbeatResponse.
+func (m *HeartbeatResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a HeartbeatResponse.
+func (m *HeartbeatResponse) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeHeartbeatResponse, m)
+}
+
+// Clone returns a deep copy of a HeartbeatResponse.
+func (m *HeartbeatResponse) Clone() *HeartbeatResponse {
+	return Clone(m).(*HeartbeatResponse)
+}
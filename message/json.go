@@ -0,0 +1,120 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// messageJSON is the representation of Message in JSON.
+type messageJSON struct {
+	Header headerJSON `json:"header"`
+	IEs    []*ie.IE   `json:"ies,omitempty"`
+}
+
+// headerJSON is the representation of Header in JSON.
+//
+// The spare bits in the first octet are not represented, as they are always
+// zero in the messages sent by the compliant nodes.
+type headerJSON struct {
+	Version         uint8  `json:"version"`
+	FO              bool   `json:"fo"`
+	MP              bool   `json:"mp"`
+	S               bool   `json:"s"`
+	Type            uint8  `json:"type"`
+	TypeName        string `json:"typeName,omitempty"`
+	SEID            uint64 `json:"seid,omitempty"`
+	SequenceNumber  uint32 `json:"sequenceNumber"`
+	MessagePriority uint8  `json:"messagePriority"`
+}
+
+// ParseJSON decodes the JSON encoding of a message generated by
+// json.Marshal, in the same way as Parse.
+func ParseJSON(b []byte) (Message, error) {
+	raw, err := decodeJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(raw)
+}
+
+// marshalJSON returns the JSON encoding of m.
+//
+// The IEs are listed in the order they appear on the wire, so that the
+// message decoded from the JSON is serialized into the same bytes.
+func marshalJSON(m Message) ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	h, err := ParseHeader(b)
+	if err != nil {
+		return nil, err
+	}
+	ies, err := ie.ParseMultiIEs(h.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&messageJSON{
+		Header: headerJSON{
+			Version:         uint8(h.Version()),
+			FO:              h.HasFO(),
+			MP:              h.HasMP(),
+			S:               h.HasSEID(),
+			Type:            h.Type,
+			TypeName:        m.MessageTypeName(),
+			SEID:            h.seid(),
+			SequenceNumber:  h.SequenceNumber,
+			MessagePriority: h.MessagePriority,
+		},
+		IEs: ies,
+	})
+}
+
+// unmarshalJSON decodes the JSON encoding of a message into m, which should
+// be the type of message specified by msgType.
+func unmarshalJSON(b []byte, msgType uint8, m Message) error {
+	raw, err := decodeJSON(b)
+	if err != nil {
+		return err
+	}
+	if raw[1] != msgType {
+		return fmt.Errorf("%w: got %d, want %d", ErrInvalidMessageType, raw[1], msgType)
+	}
+	return m.UnmarshalBinary(raw)
+}
+
+// decodeJSON returns the byte sequence of the message encoded in JSON.
+func decodeJSON(b []byte) ([]byte, error) {
+	j := &messageJSON{}
+	if err := json.Unmarshal(b, j); err != nil {
+		return nil, err
+	}
+
+	var payload []byte
+	for _, i := range j.IEs {
+		if i == nil {
+			continue
+		}
+
+		serialized, err := i.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		payload = append(payload, serialized...)
+	}
+
+	h := NewHeader(
+		j.Header.Version, boolToUint8(j.Header.FO), boolToUint8(j.Header.MP), boolToUint8(j.Header.S),
+		j.Header.Type, j.Header.SEID, j.Header.SequenceNumber, j.Header.MessagePriority,
+		payload,
+	)
+	return h.Marshal()
+}
@@ -0,0 +1,65 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+func TestMessageJSON(t *testing.T) {
+	m := message.NewHeartbeatRequest(
+		seq,
+		ie.NewRecoveryTimeStamp(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		nil,
+	)
+	j := `{"header":{"version":1,"fo":false,"mp":false,"s":false,"type":1,"typeName":"Heartbeat Request","sequenceNumber":1122867,"messagePriority":0},` +
+		`"ies":[{"type":"RecoveryTimeStamp","value":"2019-01-01T00:00:00Z"}]}`
+
+	t.Run("Marshal", func(t *testing.T) {
+		got, err := json.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(string(got), j); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("ParseJSON", func(t *testing.T) {
+		decoded, err := message.ParseJSON([]byte(j))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := decoded.(*message.HeartbeatRequest).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := m.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("InvalidMessageType", func(t *testing.T) {
+		err := json.Unmarshal([]byte(j), &message.HeartbeatResponse{})
+		if !errors.Is(err, message.ErrInvalidMessageType) {
+			t.Errorf("got %v, want %v", err, message.ErrInvalidMessageType)
+		}
+	})
+}
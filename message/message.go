@@ -12,23 +12,25 @@ import (
 
 // MessageType definitions.
 const (
-	MsgTypeHeartbeatRequest            uint8 = 1
-	MsgTypeHeartbeatResponse           uint8 = 2
-	MsgTypePFDManagementRequest        uint8 = 3
-	MsgTypePFDManagementResponse       uint8 = 4
-	MsgTypeAssociationSetupRequest     uint8 = 5
-	MsgTypeAssociationSetupResponse    uint8 = 6
-	MsgTypeAssociationUpdateRequest    uint8 = 7
-	MsgTypeAssociationUpdateResponse   uint8 = 8
-	MsgTypeAssociationReleaseRequest   uint8 = 9
-	MsgTypeAssociationReleaseResponse  uint8 = 10
-	MsgTypeVersionNotSupportedResponse uint8 = 11
-	MsgTypeNodeReportRequest           uint8 = 12
-	MsgTypeNodeReportResponse          uint8 = 13
-	MsgTypeSessionSetDeletionRequest   uint8 = 14
-	MsgTypeSessionSetDeletionResponse  uint8 = 15
+	MsgTypeHeartbeatRequest               uint8 = 1
+	MsgTypeHeartbeatResponse              uint8 = 2
+	MsgTypePFDManagementRequest           uint8 = 3
+	MsgTypePFDManagementResponse          uint8 = 4
+	MsgTypeAssociationSetupRequest        uint8 = 5
+	MsgTypeAssociationSetupResponse       uint8 = 6
+	MsgTypeAssociationUpdateRequest       uint8 = 7
+	MsgTypeAssociationUpdateResponse      uint8 = 8
+	MsgTypeAssociationReleaseRequest      uint8 = 9
+	MsgTypeAssociationReleaseResponse     uint8 = 10
+	MsgTypeVersionNotSupportedResponse    uint8 = 11
+	MsgTypeNodeReportRequest              uint8 = 12
+	MsgTypeNodeReportResponse             uint8 = 13
+	MsgTypeSessionSetDeletionRequest      uint8 = 14
+	MsgTypeSessionSetDeletionResponse     uint8 = 15
+	MsgTypeSessionSetModificationRequest  uint8 = 16
+	MsgTypeSessionSetModificationResponse uint8 = 17
 
-	// 16 to 49: For future use
+	// 18 to 49: For future use
 
 	MsgTypeSessionEstablishmentRequest  uint8 = 50
 	MsgTypeSessionEstablishmentResponse uint8 = 51
@@ -42,6 +44,9 @@ const (
 	// 58 to 99: for future use
 )
 
+// SupportedVersion is the version of PFCP supported by this package.
+const SupportedVersion = 1
+
 // Message is an interface that defines PFCP messages.
 type Message interface {
 	MarshalTo([]byte) error
@@ -94,6 +99,10 @@ func Parse(b []byte) (Message, error) {
 		m = &SessionSetDeletionRequest{}
 	case MsgTypeSessionSetDeletionResponse:
 		m = &SessionSetDeletionResponse{}
+	case MsgTypeSessionSetModificationRequest:
+		m = &SessionSetModificationRequest{}
+	case MsgTypeSessionSetModificationResponse:
+		m = &SessionSetModificationResponse{}
 	case MsgTypeSessionEstablishmentRequest:
 		m = &SessionEstablishmentRequest{}
 	case MsgTypeSessionEstablishmentResponse:
@@ -120,3 +129,38 @@ func Parse(b []byte) (Message, error) {
 	}
 	return m, nil
 }
+
+// ParseStrict parses the given bytes as Message in the same way as Parse,
+// but it returns *VersionNotSupportedError without decoding the body if the
+// version in the header is not SupportedVersion.
+func ParseStrict(b []byte) (Message, error) {
+	h, err := ParseHeader(b)
+	if err != nil {
+		return nil, err
+	}
+	if h.Version() != SupportedVersion {
+		return nil, &VersionNotSupportedError{Header: h}
+	}
+	return Parse(b)
+}
+
+// IsRequestType reports whether the given message type is a request.
+func IsRequestType(msgType uint8) bool {
+	switch msgType {
+	case MsgTypeHeartbeatRequest,
+		MsgTypePFDManagementRequest,
+		MsgTypeAssociationSetupRequest,
+		MsgTypeAssociationUpdateRequest,
+		MsgTypeAssociationReleaseRequest,
+		MsgTypeNodeReportRequest,
+		MsgTypeSessionSetDeletionRequest,
+		MsgTypeSessionSetModificationRequest,
+		MsgTypeSessionEstablishmentRequest,
+		MsgTypeSessionModificationRequest,
+		MsgTypeSessionDeletionRequest,
+		MsgTypeSessionReportRequest:
+		return true
+	default:
+		return false
+	}
+}
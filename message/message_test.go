@@ -4,7 +4,13 @@
 
 package message_test
 
-import "net"
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/wmnsk/go-pfcp/message"
+)
 
 var (
 	mac1, _         = net.ParseMAC("12:34:56:78:90:01")
@@ -19,3 +25,33 @@ var (
 	seq  uint32 = 0x112233           // Sequence Number
 	pri  uint8  = 0                  // Message Priority
 )
+
+func TestParseStrict(t *testing.T) {
+	b, err := message.NewHeader(2, 0, 0, 0, message.MsgTypeHeartbeatRequest, 0, seq, 0, []byte{0xde, 0xad}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = message.ParseStrict(b)
+	if !errors.Is(err, message.ErrVersionNotSupported) {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+	var verr *message.VersionNotSupportedError
+	if !errors.As(err, &verr) {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+	if got, want := verr.Header.Version(), 2; got != want {
+		t.Errorf("got %d want %d", got, want)
+	}
+	if got, want := verr.Header.Sequence(), seq; got != want {
+		t.Errorf("got %#x want %#x", got, want)
+	}
+
+	b, err = message.NewHeartbeatRequest(seq, nil, nil).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := message.ParseStrict(b); err != nil {
+		t.Errorf("got unexpected error: %v", err)
+	}
+}
@@ -57,3 +57,10 @@ func has2ndBit(f uint8) bool {
 func has1stBit(f uint8) bool {
 	return (f & 0x01) == 1
 }
+
+func boolToUint8(b bool) uint8 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -65,44 +65,44 @@ func (m *NodeReportRequest) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *NodeReportRequest) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.NodeID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.NodeReportType; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.UserPlanePathFailureReport; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.UserPlanePathRecoveryReport; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.ClockDriftReport {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.GTPUPathQoSReport {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -112,14 +112,13 @@ func (m *NodeReportRequest) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseNodeReportRequest decodes a given byte sequence as a NodeReportRequest.
@@ -148,27 +147,32 @@ func (m *NodeReportRequest) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.NodeID:
-			m.NodeID = i
-		case ie.NodeReportType:
-			m.NodeReportType = i
-		case ie.UserPlanePathFailureReport:
-			m.UserPlanePathFailureReport = i
-		case ie.UserPlanePathRecoveryReport:
-			m.UserPlanePathRecoveryReport = i
-		case ie.ClockDriftReport:
-			m.ClockDriftReport = append(m.ClockDriftReport, i)
-		case ie.GTPUPathQoSReport:
-			m.GTPUPathQoSReport = append(m.GTPUPathQoSReport, i)
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *NodeReportRequest) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.NodeID:
+		m.NodeID = i
+	case ie.NodeReportType:
+		m.NodeReportType = i
+	case ie.UserPlanePathFailureReport:
+		m.UserPlanePathFailureReport = i
+	case ie.UserPlanePathRecoveryReport:
+		m.UserPlanePathRecoveryReport = i
+	case ie.ClockDriftReport:
+		m.ClockDriftReport = append(m.ClockDriftReport, i)
+	case ie.GTPUPathQoSReport:
+		m.GTPUPathQoSReport = append(m.GTPUPathQoSReport, i)
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *NodeReportRequest) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -221,3 +225,24 @@ func (m *NodeReportRequest) SEID() uint64 {
 func (m *NodeReportRequest) IsRequest() bool {
 	return true
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *NodeReportRequest) Validate() error {
+	return validate(MsgTypeNodeReportRequest, m)
+}
+
+// MarshalJSON returns the JSON encoding of a NodeReportRequest.
+func (m *NodeReportRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a NodeReportRequest.
+func (m *NodeReportRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeNodeReportRequest, m)
+}
+
+// Clone returns a deep copy of a NodeReportRequest.
+func (m *NodeReportRequest) Clone() *NodeReportRequest {
+	return Clone(m).(*NodeReportRequest)
+}
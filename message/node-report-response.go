@@ -47,26 +47,26 @@ func (m *NodeReportResponse) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *NodeReportResponse) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.NodeID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.Cause; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.OffendingIE; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -76,14 +76,13 @@ func (m *NodeReportResponse) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseNodeReportResponse decodes a given byte sequence as a NodeReportResponse.
@@ -112,21 +111,26 @@ func (m *NodeReportResponse) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.NodeID:
-			m.NodeID = i
-		case ie.Cause:
-			m.Cause = i
-		case ie.OffendingIE:
-			m.OffendingIE = i
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *NodeReportResponse) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.NodeID:
+		m.NodeID = i
+	case ie.Cause:
+		m.Cause = i
+	case ie.OffendingIE:
+		m.OffendingIE = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *NodeReportResponse) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -170,3 +174,24 @@ func (m *NodeReportResponse) SEID() uint64 {
 func (m *NodeReportResponse) IsRequest() bool {
 	return false
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *NodeReportResponse) Validate() error {
+	return validate(MsgTypeNodeReportResponse, m)
+}
+
+// MarshalJSON returns the JSON encoding of a NodeReportResponse.
+func (m *NodeReportResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a NodeReportResponse.
+func (m *NodeReportResponse) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeNodeReportResponse, m)
+}
+
+// Clone returns a deep copy of a NodeReportResponse.
+func (m *NodeReportResponse) Clone() *NodeReportResponse {
+	return Clone(m).(*NodeReportResponse)
+}
@@ -50,14 +50,14 @@ func (m *PFDManagementRequest) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *PFDManagementRequest) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	for _, i := range m.ApplicationIDsPFDs {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -67,14 +67,13 @@ func (m *PFDManagementRequest) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParsePFDManagementRequest decodes a given byte sequence as a PFDManagementRequest.
@@ -103,17 +102,22 @@ func (m *PFDManagementRequest) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.ApplicationIDsPFDs:
-			m.ApplicationIDsPFDs = append(m.ApplicationIDsPFDs, i)
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *PFDManagementRequest) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.ApplicationIDsPFDs:
+		m.ApplicationIDsPFDs = append(m.ApplicationIDsPFDs, i)
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *PFDManagementRequest) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -151,3 +155,24 @@ func (m *PFDManagementRequest) SEID() uint64 {
 func (m *PFDManagementRequest) IsRequest() bool {
 	return true
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *PFDManagementRequest) Validate() error {
+	return validate(MsgTypePFDManagementRequest, m)
+}
+
+// MarshalJSON returns the JSON encoding of a PFDManagementRequest.
+func (m *PFDManagementRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a PFDManagementRequest.
+func (m *PFDManagementRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypePFDManagementRequest, m)
+}
+
+// Clone returns a deep copy of a PFDManagementRequest.
+func (m *PFDManagementRequest) Clone() *PFDManagementRequest {
+	return Clone(m).(*PFDManagementRequest)
+}
@@ -47,20 +47,20 @@ func (m *PFDManagementResponse) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *PFDManagementResponse) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.Cause; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.OffendingIE; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -70,14 +70,13 @@ func (m *PFDManagementResponse) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParsePFDManagementResponse decodes a given byte sequence as a PFDManagementResponse.
@@ -106,19 +105,24 @@ func (m *PFDManagementResponse) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.Cause:
-			m.Cause = i
-		case ie.OffendingIE:
-			m.OffendingIE = i
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *PFDManagementResponse) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.Cause:
+		m.Cause = i
+	case ie.OffendingIE:
+		m.OffendingIE = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *PFDManagementResponse) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -171,3 +175,24 @@ func (m *PFDManagementResponse) SEID() uint64 {
 func (m *PFDManagementResponse) IsRequest() bool {
 	return false
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *PFDManagementResponse) Validate() error {
+	return validate(MsgTypePFDManagementResponse, m)
+}
+
+// MarshalJSON returns the JSON encoding of a PFDManagementResponse.
+func (m *PFDManagementResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a PFDManagementResponse.
+func (m *PFDManagementResponse) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypePFDManagementResponse, m)
+}
+
+// Clone returns a deep copy of a PFDManagementResponse.
+func (m *PFDManagementResponse) Clone() *PFDManagementResponse {
+	return Clone(m).(*PFDManagementResponse)
+}
@@ -41,24 +41,23 @@ func (m *SessionDeletionRequest) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *SessionDeletionRequest) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	for _, ie := range m.IEs {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseSessionDeletionRequest decodes a given byte sequence as a SessionDeletionRequest.
@@ -91,6 +90,11 @@ func (m *SessionDeletionRequest) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+// addIE appends i to IEs.
+func (m *SessionDeletionRequest) addIE(i *ie.IE) {
+	m.IEs = append(m.IEs, i)
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *SessionDeletionRequest) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -124,3 +128,24 @@ func (m *SessionDeletionRequest) SEID() uint64 {
 func (m *SessionDeletionRequest) IsRequest() bool {
 	return true
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *SessionDeletionRequest) Validate() error {
+	return validate(MsgTypeSessionDeletionRequest, m)
+}
+
+// MarshalJSON returns the JSON encoding of a SessionDeletionRequest.
+func (m *SessionDeletionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a SessionDeletionRequest.
+func (m *SessionDeletionRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeSessionDeletionRequest, m)
+}
+
+// Clone returns a deep copy of a SessionDeletionRequest.
+func (m *SessionDeletionRequest) Clone() *SessionDeletionRequest {
+	return Clone(m).(*SessionDeletionRequest)
+}
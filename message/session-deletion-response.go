@@ -67,45 +67,45 @@ func (m *SessionDeletionResponse) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *SessionDeletionResponse) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 
 	if i := m.Cause; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.OffendingIE; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.LoadControlInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.OverloadControlInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UsageReport {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.AdditionalUsageReportsInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -115,14 +115,13 @@ func (m *SessionDeletionResponse) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseSessionDeletionResponse decodes a given byte sequence as a SessionDeletionResponse.
@@ -151,27 +150,32 @@ func (m *SessionDeletionResponse) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.Cause:
-			m.Cause = i
-		case ie.OffendingIE:
-			m.OffendingIE = i
-		case ie.LoadControlInformation:
-			m.LoadControlInformation = i
-		case ie.OverloadControlInformation:
-			m.OverloadControlInformation = i
-		case ie.UsageReportWithinSessionDeletionResponse:
-			m.UsageReport = append(m.UsageReport, i)
-		case ie.AdditionalUsageReportsInformation:
-			m.AdditionalUsageReportsInformation = i
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *SessionDeletionResponse) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.Cause:
+		m.Cause = i
+	case ie.OffendingIE:
+		m.OffendingIE = i
+	case ie.LoadControlInformation:
+		m.LoadControlInformation = i
+	case ie.OverloadControlInformation:
+		m.OverloadControlInformation = i
+	case ie.UsageReportWithinSessionDeletionResponse:
+		m.UsageReport = append(m.UsageReport, i)
+	case ie.AdditionalUsageReportsInformation:
+		m.AdditionalUsageReportsInformation = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *SessionDeletionResponse) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -224,3 +228,24 @@ func (m *SessionDeletionResponse) SEID() uint64 {
 func (m *SessionDeletionResponse) IsRequest() bool {
 	return false
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *SessionDeletionResponse) Validate() error {
+	return validate(MsgTypeSessionDeletionResponse, m)
+}
+
+// MarshalJSON returns the JSON encoding of a SessionDeletionResponse.
+func (m *SessionDeletionResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a SessionDeletionResponse.
+func (m *SessionDeletionResponse) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeSessionDeletionResponse, m)
+}
+
+// Clone returns a deep copy of a SessionDeletionResponse.
+func (m *SessionDeletionResponse) Clone() *SessionDeletionResponse {
+	return Clone(m).(*SessionDeletionResponse)
+}
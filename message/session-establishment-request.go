@@ -22,7 +22,7 @@ type SessionEstablishmentRequest struct {
 	CreateBAR                          *ie.IE
 	CreateTrafficEndpoint              []*ie.IE
 	PDNType                            *ie.IE
-	FQCSID                             *ie.IE
+	FQCSID                             []*ie.IE
 	UserPlaneInactivityTimer           *ie.IE
 	UserID                             *ie.IE
 	TraceInformation                   *ie.IE
@@ -35,6 +35,8 @@ type SessionEstablishmentRequest struct {
 	RecoveryTimeStamp                  *ie.IE
 	SNSSAI                             *ie.IE
 	ProvideRDSConfigurationInformation *ie.IE
+	HPLMNSNSSAI                        *ie.IE
+	UELevelMeasurementsConfiguration   *ie.IE
 	IEs                                []*ie.IE
 }
 
@@ -69,7 +71,7 @@ func NewSessionEstablishmentRequest(mp, fo uint8, seid uint64, seq uint32, pri u
 		case ie.PDNType:
 			m.PDNType = i
 		case ie.FQCSID:
-			m.FQCSID = i
+			m.FQCSID = append(m.FQCSID, i)
 		case ie.UserPlaneInactivityTimer:
 			m.UserPlaneInactivityTimer = i
 		case ie.UserID:
@@ -94,6 +96,10 @@ func NewSessionEstablishmentRequest(mp, fo uint8, seid uint64, seq uint32, pri u
 			m.SNSSAI = i
 		case ie.ProvideRDSConfigurationInformation:
 			m.ProvideRDSConfigurationInformation = i
+		case ie.HPLMNSNSSAI:
+			m.HPLMNSNSSAI = i
+		case ie.UELevelMeasurementsConfiguration:
+			m.UELevelMeasurementsConfiguration = i
 		default:
 			m.IEs = append(m.IEs, i)
 		}
@@ -115,140 +121,152 @@ func (m *SessionEstablishmentRequest) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *SessionEstablishmentRequest) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.NodeID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.CPFSEID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreatePDR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreateFAR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreateURR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreateQER {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.CreateBAR; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreateTrafficEndpoint {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.PDNType; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
-	if i := m.FQCSID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+	for _, i := range m.FQCSID {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.UserPlaneInactivityTimer; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.UserID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.TraceInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.APNDNN; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreateMAR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.PFCPSEReqFlags; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.CreateBridgeInfoForTSC; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreateSRR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.ProvideATSSSControlInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.RecoveryTimeStamp; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.SNSSAI; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.ProvideRDSConfigurationInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+	if i := m.HPLMNSNSSAI; i != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+	if i := m.UELevelMeasurementsConfiguration; i != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -258,14 +276,13 @@ func (m *SessionEstablishmentRequest) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseSessionEstablishmentRequest decodes a given byte sequence as a SessionEstablishmentRequest.
@@ -294,59 +311,68 @@ func (m *SessionEstablishmentRequest) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.NodeID:
-			m.NodeID = i
-		case ie.FSEID:
-			m.CPFSEID = i
-		case ie.CreatePDR:
-			m.CreatePDR = append(m.CreatePDR, i)
-		case ie.CreateFAR:
-			m.CreateFAR = append(m.CreateFAR, i)
-		case ie.CreateURR:
-			m.CreateURR = append(m.CreateURR, i)
-		case ie.CreateQER:
-			m.CreateQER = append(m.CreateQER, i)
-		case ie.CreateBAR:
-			m.CreateBAR = i
-		case ie.CreateTrafficEndpoint:
-			m.CreateTrafficEndpoint = append(m.CreateTrafficEndpoint, i)
-		case ie.PDNType:
-			m.PDNType = i
-		case ie.FQCSID:
-			m.FQCSID = i
-		case ie.UserPlaneInactivityTimer:
-			m.UserPlaneInactivityTimer = i
-		case ie.UserID:
-			m.UserID = i
-		case ie.TraceInformation:
-			m.TraceInformation = i
-		case ie.APNDNN:
-			m.APNDNN = i
-		case ie.CreateMAR:
-			m.CreateMAR = append(m.CreateMAR, i)
-		case ie.PFCPSEReqFlags:
-			m.PFCPSEReqFlags = i
-		case ie.CreateBridgeInfoForTSC:
-			m.CreateBridgeInfoForTSC = i
-		case ie.CreateSRR:
-			m.CreateSRR = append(m.CreateSRR, i)
-		case ie.ProvideATSSSControlInformation:
-			m.ProvideATSSSControlInformation = i
-		case ie.RecoveryTimeStamp:
-			m.RecoveryTimeStamp = i
-		case ie.SNSSAI:
-			m.SNSSAI = i
-		case ie.ProvideRDSConfigurationInformation:
-			m.ProvideRDSConfigurationInformation = i
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *SessionEstablishmentRequest) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.NodeID:
+		m.NodeID = i
+	case ie.FSEID:
+		m.CPFSEID = i
+	case ie.CreatePDR:
+		m.CreatePDR = append(m.CreatePDR, i)
+	case ie.CreateFAR:
+		m.CreateFAR = append(m.CreateFAR, i)
+	case ie.CreateURR:
+		m.CreateURR = append(m.CreateURR, i)
+	case ie.CreateQER:
+		m.CreateQER = append(m.CreateQER, i)
+	case ie.CreateBAR:
+		m.CreateBAR = i
+	case ie.CreateTrafficEndpoint:
+		m.CreateTrafficEndpoint = append(m.CreateTrafficEndpoint, i)
+	case ie.PDNType:
+		m.PDNType = i
+	case ie.FQCSID:
+		m.FQCSID = append(m.FQCSID, i)
+	case ie.UserPlaneInactivityTimer:
+		m.UserPlaneInactivityTimer = i
+	case ie.UserID:
+		m.UserID = i
+	case ie.TraceInformation:
+		m.TraceInformation = i
+	case ie.APNDNN:
+		m.APNDNN = i
+	case ie.CreateMAR:
+		m.CreateMAR = append(m.CreateMAR, i)
+	case ie.PFCPSEReqFlags:
+		m.PFCPSEReqFlags = i
+	case ie.CreateBridgeInfoForTSC:
+		m.CreateBridgeInfoForTSC = i
+	case ie.CreateSRR:
+		m.CreateSRR = append(m.CreateSRR, i)
+	case ie.ProvideATSSSControlInformation:
+		m.ProvideATSSSControlInformation = i
+	case ie.RecoveryTimeStamp:
+		m.RecoveryTimeStamp = i
+	case ie.SNSSAI:
+		m.SNSSAI = i
+	case ie.ProvideRDSConfigurationInformation:
+		m.ProvideRDSConfigurationInformation = i
+	case ie.HPLMNSNSSAI:
+		m.HPLMNSNSSAI = i
+	case ie.UELevelMeasurementsConfiguration:
+		m.UELevelMeasurementsConfiguration = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *SessionEstablishmentRequest) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -378,7 +404,7 @@ func (m *SessionEstablishmentRequest) MarshalLen() int {
 	if i := m.PDNType; i != nil {
 		l += i.MarshalLen()
 	}
-	if i := m.FQCSID; i != nil {
+	for _, i := range m.FQCSID {
 		l += i.MarshalLen()
 	}
 	if i := m.UserPlaneInactivityTimer; i != nil {
@@ -417,6 +443,12 @@ func (m *SessionEstablishmentRequest) MarshalLen() int {
 	if i := m.ProvideRDSConfigurationInformation; i != nil {
 		l += i.MarshalLen()
 	}
+	if i := m.HPLMNSNSSAI; i != nil {
+		l += i.MarshalLen()
+	}
+	if i := m.UELevelMeasurementsConfiguration; i != nil {
+		l += i.MarshalLen()
+	}
 
 	for _, ie := range m.IEs {
 		if ie == nil {
@@ -447,3 +479,24 @@ func (m *SessionEstablishmentRequest) SEID() uint64 {
 func (m *SessionEstablishmentRequest) IsRequest() bool {
 	return true
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *SessionEstablishmentRequest) Validate() error {
+	return validate(MsgTypeSessionEstablishmentRequest, m)
+}
+
+// MarshalJSON returns the JSON encoding of a SessionEstablishmentRequest.
+func (m *SessionEstablishmentRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a SessionEstablishmentRequest.
+func (m *SessionEstablishmentRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeSessionEstablishmentRequest, m)
+}
+
+// Clone returns a deep copy of a SessionEstablishmentRequest.
+func (m *SessionEstablishmentRequest) Clone() *SessionEstablishmentRequest {
+	return Clone(m).(*SessionEstablishmentRequest)
+}
@@ -219,9 +219,11 @@ func TestSessionEstablishmentRequest(t *testing.T) {
 				ie.NewProvideRDSConfigurationInformation(
 					ie.NewRDSConfigurationInformation(0x01),
 				),
+				ie.NewHPLMNSNSSAI(0x11, 0x223344),
+				ie.NewUELevelMeasurementsConfiguration([]byte{0x01, 0x02}),
 			),
 			Serialized: []byte{
-				0x21, 0x32, 0x06, 0xb0, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
+				0x21, 0x32, 0x06, 0xbe, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
 				0x00, 0x3c, 0x00, 0x1d, 0x02, 0x07, 0x67, 0x6f, 0x2d, 0x70, 0x66, 0x63, 0x70, 0x03, 0x65, 0x70, 0x63, 0x0b, 0x33, 0x67, 0x70, 0x70, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x03, 0x6f, 0x72, 0x67,
 				0x00, 0x39, 0x00, 0x0d, 0x02, 0x11, 0x11, 0x11, 0x11, 0x22, 0x22, 0x22, 0x22, 0x7f, 0x00, 0x00, 0x01,
 				// CreatePDR
@@ -442,6 +444,8 @@ func TestSessionEstablishmentRequest(t *testing.T) {
 				0x00, 0x60, 0x00, 0x04, 0xdf, 0xd5, 0x2c, 0x00,
 				0x01, 0x01, 0x00, 0x04, 0x11, 0x22, 0x33, 0x44,
 				0x01, 0x05, 0x00, 0x05, 0x01, 0x06, 0x00, 0x01, 0x01,
+				0x01, 0x52, 0x00, 0x04, 0x11, 0x22, 0x33, 0x44,
+				0x01, 0x61, 0x00, 0x02, 0x01, 0x02,
 			},
 		}, {
 			Description: "Multiple IEs",
@@ -686,9 +690,11 @@ func TestSessionEstablishmentRequest(t *testing.T) {
 				ie.NewProvideRDSConfigurationInformation(
 					ie.NewRDSConfigurationInformation(0x01),
 				),
+				ie.NewHPLMNSNSSAI(0x11, 0x223344),
+				ie.NewUELevelMeasurementsConfiguration([]byte{0x01, 0x02}),
 			),
 			Serialized: []byte{
-				0x21, 0x32, 0x08, 0x3a, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
+				0x21, 0x32, 0x08, 0x48, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
 				0x00, 0x3c, 0x00, 0x1d, 0x02, 0x07, 0x67, 0x6f, 0x2d, 0x70, 0x66, 0x63, 0x70, 0x03, 0x65, 0x70, 0x63, 0x0b, 0x33, 0x67, 0x70, 0x70, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x03, 0x6f, 0x72, 0x67,
 				0x00, 0x39, 0x00, 0x0d, 0x02, 0x11, 0x11, 0x11, 0x11, 0x22, 0x22, 0x22, 0x22, 0x7f, 0x00, 0x00, 0x01,
 				// CreatePDR 1
@@ -967,6 +973,8 @@ func TestSessionEstablishmentRequest(t *testing.T) {
 				0x00, 0x60, 0x00, 0x04, 0xdf, 0xd5, 0x2c, 0x00,
 				0x01, 0x01, 0x00, 0x04, 0x11, 0x22, 0x33, 0x44,
 				0x01, 0x05, 0x00, 0x05, 0x01, 0x06, 0x00, 0x01, 0x01,
+				0x01, 0x52, 0x00, 0x04, 0x11, 0x22, 0x33, 0x44,
+				0x01, 0x61, 0x00, 0x02, 0x01, 0x02,
 			},
 		},
 	}
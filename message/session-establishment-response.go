@@ -20,7 +20,7 @@ type SessionEstablishmentResponse struct {
 	CreatedPDR                 []*ie.IE
 	LoadControlInformation     *ie.IE
 	OverloadControlInformation *ie.IE
-	FQCSID                     *ie.IE
+	FQCSID                     []*ie.IE
 	FailedRuleID               *ie.IE
 	CreatedTrafficEndpoint     []*ie.IE
 	CreatedBridgeInfoForTSC    *ie.IE
@@ -55,7 +55,7 @@ func NewSessionEstablishmentResponse(mp, fo uint8, seid uint64, seq uint32, pri
 		case ie.OverloadControlInformation:
 			m.OverloadControlInformation = i
 		case ie.FQCSID:
-			m.FQCSID = i
+			m.FQCSID = append(m.FQCSID, i)
 		case ie.FailedRuleID:
 			m.FailedRuleID = i
 		case ie.CreatedTrafficEndpoint:
@@ -85,80 +85,80 @@ func (m *SessionEstablishmentResponse) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *SessionEstablishmentResponse) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.NodeID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.Cause; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.OffendingIE; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.UPFSEID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreatedPDR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.LoadControlInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.OverloadControlInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
-	if i := m.FQCSID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+	for _, i := range m.FQCSID {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.FailedRuleID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreatedTrafficEndpoint {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.CreatedBridgeInfoForTSC; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.ATSSSControlParameters; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -168,14 +168,13 @@ func (m *SessionEstablishmentResponse) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseSessionEstablishmentResponse decodes a given byte sequence as a SessionEstablishmentResponse.
@@ -204,39 +203,44 @@ func (m *SessionEstablishmentResponse) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.NodeID:
-			m.NodeID = i
-		case ie.Cause:
-			m.Cause = i
-		case ie.OffendingIE:
-			m.OffendingIE = i
-		case ie.FSEID:
-			m.UPFSEID = i
-		case ie.CreatedPDR:
-			m.CreatedPDR = append(m.CreatedPDR, i)
-		case ie.LoadControlInformation:
-			m.LoadControlInformation = i
-		case ie.OverloadControlInformation:
-			m.OverloadControlInformation = i
-		case ie.FQCSID:
-			m.FQCSID = i
-		case ie.FailedRuleID:
-			m.FailedRuleID = i
-		case ie.CreatedTrafficEndpoint:
-			m.CreatedTrafficEndpoint = append(m.CreatedTrafficEndpoint, i)
-		case ie.CreatedBridgeInfoForTSC:
-			m.CreatedBridgeInfoForTSC = i
-		case ie.ATSSSControlParameters:
-			m.ATSSSControlParameters = i
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *SessionEstablishmentResponse) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.NodeID:
+		m.NodeID = i
+	case ie.Cause:
+		m.Cause = i
+	case ie.OffendingIE:
+		m.OffendingIE = i
+	case ie.FSEID:
+		m.UPFSEID = i
+	case ie.CreatedPDR:
+		m.CreatedPDR = append(m.CreatedPDR, i)
+	case ie.LoadControlInformation:
+		m.LoadControlInformation = i
+	case ie.OverloadControlInformation:
+		m.OverloadControlInformation = i
+	case ie.FQCSID:
+		m.FQCSID = append(m.FQCSID, i)
+	case ie.FailedRuleID:
+		m.FailedRuleID = i
+	case ie.CreatedTrafficEndpoint:
+		m.CreatedTrafficEndpoint = append(m.CreatedTrafficEndpoint, i)
+	case ie.CreatedBridgeInfoForTSC:
+		m.CreatedBridgeInfoForTSC = i
+	case ie.ATSSSControlParameters:
+		m.ATSSSControlParameters = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *SessionEstablishmentResponse) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -262,7 +266,7 @@ func (m *SessionEstablishmentResponse) MarshalLen() int {
 	if i := m.OverloadControlInformation; i != nil {
 		l += i.MarshalLen()
 	}
-	if i := m.FQCSID; i != nil {
+	for _, i := range m.FQCSID {
 		l += i.MarshalLen()
 	}
 	if i := m.FailedRuleID; i != nil {
@@ -307,3 +311,24 @@ func (m *SessionEstablishmentResponse) SEID() uint64 {
 func (m *SessionEstablishmentResponse) IsRequest() bool {
 	return false
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *SessionEstablishmentResponse) Validate() error {
+	return validate(MsgTypeSessionEstablishmentResponse, m)
+}
+
+// MarshalJSON returns the JSON encoding of a SessionEstablishmentResponse.
+func (m *SessionEstablishmentResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a SessionEstablishmentResponse.
+func (m *SessionEstablishmentResponse) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeSessionEstablishmentResponse, m)
+}
+
+// Clone returns a deep copy of a SessionEstablishmentResponse.
+func (m *SessionEstablishmentResponse) Clone() *SessionEstablishmentResponse {
+	return Clone(m).(*SessionEstablishmentResponse)
+}
@@ -51,14 +51,12 @@ func TestSessionEstablishmentResponse(t *testing.T) {
 					ie.NewNWTTPortNumber(0xffffffff),
 					ie.NewTSNBridgeID(mac1),
 				),
-				ie.NewProvideATSSSControlInformation(
-					ie.NewMPTCPControlInformation(1),
-					ie.NewATSSSLLControlInformation(1),
-					ie.NewPMFControlInformation(1),
+				ie.NewATSSSControlParameters(
+					ie.NewATSSSLLParameters(ie.NewATSSSLLInformation(1)),
 				),
 			),
 			Serialized: []byte{
-				0x21, 0x33, 0x01, 0x12, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
+				0x21, 0x33, 0x01, 0x0c, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
 				0x00, 0x3c, 0x00, 0x1d, 0x02, 0x07, 0x67, 0x6f, 0x2d, 0x70, 0x66, 0x63, 0x70, 0x03, 0x65, 0x70, 0x63, 0x0b, 0x33, 0x67, 0x70, 0x70, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x03, 0x6f, 0x72, 0x67,
 				0x00, 0x13, 0x00, 0x01, 0x01,
 				0x00, 0x28, 0x00, 0x02, 0x00, 0x13,
@@ -85,10 +83,8 @@ func TestSessionEstablishmentResponse(t *testing.T) {
 				0x00, 0xc4, 0x00, 0x04, 0xff, 0xff, 0xff, 0xff,
 				0x00, 0xc5, 0x00, 0x04, 0xff, 0xff, 0xff, 0xff,
 				0x00, 0xc6, 0x00, 0x07, 0x01, 0x12, 0x34, 0x56, 0x78, 0x90, 0x01,
-				0x00, 0xdc, 0x00, 0x0f,
-				0x00, 0xde, 0x00, 0x01, 0x01,
-				0x00, 0xdf, 0x00, 0x01, 0x01,
-				0x00, 0xe0, 0x00, 0x01, 0x01,
+				0x00, 0xdd, 0x00, 0x09,
+				0x00, 0xe2, 0x00, 0x05, 0x00, 0xe7, 0x00, 0x01, 0x01,
 			},
 		}, {
 			Description: "Multiple IEs",
@@ -130,14 +126,12 @@ func TestSessionEstablishmentResponse(t *testing.T) {
 					ie.NewNWTTPortNumber(0xffffffff),
 					ie.NewTSNBridgeID(tsnBridgeMac),
 				),
-				ie.NewProvideATSSSControlInformation(
-					ie.NewMPTCPControlInformation(1),
-					ie.NewATSSSLLControlInformation(1),
-					ie.NewPMFControlInformation(1),
+				ie.NewATSSSControlParameters(
+					ie.NewATSSSLLParameters(ie.NewATSSSLLInformation(1)),
 				),
 			),
 			Serialized: []byte{
-				0x21, 0x33, 0x01, 0x41, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
+				0x21, 0x33, 0x01, 0x3b, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
 				0x00, 0x3c, 0x00, 0x1d, 0x02, 0x07, 0x67, 0x6f, 0x2d, 0x70, 0x66, 0x63, 0x70, 0x03, 0x65, 0x70, 0x63, 0x0b, 0x33, 0x67, 0x70, 0x70, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x03, 0x6f, 0x72, 0x67,
 				0x00, 0x13, 0x00, 0x01, 0x01,
 				0x00, 0x28, 0x00, 0x02, 0x00, 0x13,
@@ -169,10 +163,8 @@ func TestSessionEstablishmentResponse(t *testing.T) {
 				0x00, 0xc4, 0x00, 0x04, 0xff, 0xff, 0xff, 0xff,
 				0x00, 0xc5, 0x00, 0x04, 0xff, 0xff, 0xff, 0xff,
 				0x00, 0xc6, 0x00, 0x09, 0x01, 0x12, 0x34, 0x56, 0x78, 0x90, 0x05, 0x67, 0x89,
-				0x00, 0xdc, 0x00, 0x0f,
-				0x00, 0xde, 0x00, 0x01, 0x01,
-				0x00, 0xdf, 0x00, 0x01, 0x01,
-				0x00, 0xe0, 0x00, 0x01, 0x01,
+				0x00, 0xdd, 0x00, 0x09,
+				0x00, 0xe2, 0x00, 0x05, 0x00, 0xe7, 0x00, 0x01, 0x01,
 			},
 		},
 	}
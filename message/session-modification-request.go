@@ -11,45 +11,47 @@ import (
 // SessionModificationRequest is a SessionModificationRequest formed PFCP Header and its IEs above.
 type SessionModificationRequest struct {
 	*Header
-	CPFSEID                        *ie.IE
-	RemovePDR                      []*ie.IE
-	RemoveFAR                      []*ie.IE
-	RemoveURR                      []*ie.IE
-	RemoveQER                      []*ie.IE
-	RemoveBAR                      *ie.IE
-	RemoveTrafficEndpoint          []*ie.IE
-	CreatePDR                      []*ie.IE
-	CreateFAR                      []*ie.IE
-	CreateURR                      []*ie.IE
-	CreateQER                      []*ie.IE
-	CreateBAR                      *ie.IE
-	CreateTrafficEndpoint          []*ie.IE
-	UpdatePDR                      []*ie.IE
-	UpdateFAR                      []*ie.IE
-	UpdateURR                      []*ie.IE
-	UpdateQER                      []*ie.IE
-	UpdateBAR                      *ie.IE
-	UpdateTrafficEndpoint          []*ie.IE
-	PFCPSMReqFlags                 *ie.IE
-	QueryURR                       []*ie.IE
-	FQCSID                         *ie.IE
-	UserPlaneInactivityTimer       *ie.IE
-	QueryURRReference              *ie.IE
-	TraceInformation               *ie.IE
-	RemoveMAR                      []*ie.IE
-	UpdateMAR                      []*ie.IE
-	CreateMAR                      []*ie.IE
-	NodeID                         *ie.IE
-	TSCManagementInformation       *ie.IE
-	RemoveSRR                      []*ie.IE
-	CreateSRR                      []*ie.IE
-	UpdateSRR                      []*ie.IE
-	ProvideATSSSControlInformation *ie.IE
-	EthernetContextInformation     *ie.IE
-	AccessAvailabilityInformation  []*ie.IE
-	QueryPacketRateStatus          []*ie.IE
-	SNSSAI                         *ie.IE
-	IEs                            []*ie.IE
+	CPFSEID                          *ie.IE
+	RemovePDR                        []*ie.IE
+	RemoveFAR                        []*ie.IE
+	RemoveURR                        []*ie.IE
+	RemoveQER                        []*ie.IE
+	RemoveBAR                        *ie.IE
+	RemoveTrafficEndpoint            []*ie.IE
+	CreatePDR                        []*ie.IE
+	CreateFAR                        []*ie.IE
+	CreateURR                        []*ie.IE
+	CreateQER                        []*ie.IE
+	CreateBAR                        *ie.IE
+	CreateTrafficEndpoint            []*ie.IE
+	UpdatePDR                        []*ie.IE
+	UpdateFAR                        []*ie.IE
+	UpdateURR                        []*ie.IE
+	UpdateQER                        []*ie.IE
+	UpdateBAR                        *ie.IE
+	UpdateTrafficEndpoint            []*ie.IE
+	PFCPSMReqFlags                   *ie.IE
+	QueryURR                         []*ie.IE
+	FQCSID                           []*ie.IE
+	UserPlaneInactivityTimer         *ie.IE
+	QueryURRReference                *ie.IE
+	TraceInformation                 *ie.IE
+	RemoveMAR                        []*ie.IE
+	UpdateMAR                        []*ie.IE
+	CreateMAR                        []*ie.IE
+	NodeID                           *ie.IE
+	TSCManagementInformation         *ie.IE
+	RemoveSRR                        []*ie.IE
+	CreateSRR                        []*ie.IE
+	UpdateSRR                        []*ie.IE
+	ProvideATSSSControlInformation   *ie.IE
+	EthernetContextInformation       *ie.IE
+	AccessAvailabilityInformation    []*ie.IE
+	QueryPacketRateStatus            []*ie.IE
+	SNSSAI                           *ie.IE
+	TLContainer                      *ie.IE
+	UELevelMeasurementsConfiguration *ie.IE
+	IEs                              []*ie.IE
 }
 
 // NewSessionModificationRequest creates a new SessionModificationRequest.
@@ -107,7 +109,7 @@ func NewSessionModificationRequest(mp, fo uint8, seid uint64, seq uint32, pri ui
 		case ie.QueryURR:
 			m.QueryURR = append(m.QueryURR, i)
 		case ie.FQCSID:
-			m.FQCSID = i
+			m.FQCSID = append(m.FQCSID, i)
 		case ie.UserPlaneInactivityTimer:
 			m.UserPlaneInactivityTimer = i
 		case ie.QueryURRReference:
@@ -140,6 +142,10 @@ func NewSessionModificationRequest(mp, fo uint8, seid uint64, seq uint32, pri ui
 			m.QueryPacketRateStatus = append(m.QueryPacketRateStatus, i)
 		case ie.SNSSAI:
 			m.SNSSAI = i
+		case ie.TLContainer:
+			m.TLContainer = i
+		case ie.UELevelMeasurementsConfiguration:
+			m.UELevelMeasurementsConfiguration = i
 		default:
 			m.IEs = append(m.IEs, i)
 		}
@@ -161,236 +167,248 @@ func (m *SessionModificationRequest) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *SessionModificationRequest) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.CPFSEID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.RemovePDR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.RemoveFAR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.RemoveURR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.RemoveQER {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.RemoveBAR; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.RemoveTrafficEndpoint {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreatePDR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreateFAR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreateURR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreateQER {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.CreateBAR; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreateTrafficEndpoint {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UpdatePDR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UpdateFAR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UpdateURR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UpdateQER {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.UpdateBAR; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UpdateTrafficEndpoint {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.PFCPSMReqFlags; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.QueryURR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
-	if i := m.FQCSID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+	for _, i := range m.FQCSID {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.UserPlaneInactivityTimer; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.QueryURRReference; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.TraceInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.RemoveMAR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UpdateMAR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreateMAR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.NodeID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.TSCManagementInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.RemoveSRR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreateSRR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UpdateSRR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.ProvideATSSSControlInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.EthernetContextInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.AccessAvailabilityInformation {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.QueryPacketRateStatus {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.SNSSAI; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+	if i := m.TLContainer; i != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+	if i := m.UELevelMeasurementsConfiguration; i != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -400,14 +418,13 @@ func (m *SessionModificationRequest) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseSessionModificationRequest decodes a given byte sequence as a SessionModificationRequest.
@@ -436,91 +453,100 @@ func (m *SessionModificationRequest) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.FSEID:
-			m.CPFSEID = i
-		case ie.RemovePDR:
-			m.RemovePDR = append(m.RemovePDR, i)
-		case ie.RemoveFAR:
-			m.RemoveFAR = append(m.RemoveFAR, i)
-		case ie.RemoveURR:
-			m.RemoveURR = append(m.RemoveURR, i)
-		case ie.RemoveQER:
-			m.RemoveQER = append(m.RemoveQER, i)
-		case ie.RemoveBAR:
-			m.RemoveBAR = i
-		case ie.RemoveTrafficEndpoint:
-			m.RemoveTrafficEndpoint = append(m.RemoveTrafficEndpoint, i)
-		case ie.CreatePDR:
-			m.CreatePDR = append(m.CreatePDR, i)
-		case ie.CreateFAR:
-			m.CreateFAR = append(m.CreateFAR, i)
-		case ie.CreateURR:
-			m.CreateURR = append(m.CreateURR, i)
-		case ie.CreateQER:
-			m.CreateQER = append(m.CreateQER, i)
-		case ie.CreateBAR:
-			m.CreateBAR = i
-		case ie.CreateTrafficEndpoint:
-			m.CreateTrafficEndpoint = append(m.CreateTrafficEndpoint, i)
-		case ie.UpdatePDR:
-			m.UpdatePDR = append(m.UpdatePDR, i)
-		case ie.UpdateFAR:
-			m.UpdateFAR = append(m.UpdateFAR, i)
-		case ie.UpdateURR:
-			m.UpdateURR = append(m.UpdateURR, i)
-		case ie.UpdateQER:
-			m.UpdateQER = append(m.UpdateQER, i)
-		case ie.UpdateBARWithinSessionModificationRequest:
-			m.UpdateBAR = i
-		case ie.UpdateTrafficEndpoint:
-			m.UpdateTrafficEndpoint = append(m.UpdateTrafficEndpoint, i)
-		case ie.PFCPSMReqFlags:
-			m.PFCPSMReqFlags = i
-		case ie.QueryURR:
-			m.QueryURR = append(m.QueryURR, i)
-		case ie.FQCSID:
-			m.FQCSID = i
-		case ie.UserPlaneInactivityTimer:
-			m.UserPlaneInactivityTimer = i
-		case ie.QueryURRReference:
-			m.QueryURRReference = i
-		case ie.TraceInformation:
-			m.TraceInformation = i
-		case ie.RemoveMAR:
-			m.RemoveMAR = append(m.RemoveMAR, i)
-		case ie.UpdateMAR:
-			m.UpdateMAR = append(m.UpdateMAR, i)
-		case ie.CreateMAR:
-			m.CreateMAR = append(m.CreateMAR, i)
-		case ie.NodeID:
-			m.NodeID = i
-		case ie.TSCManagementInformationWithinSessionModificationRequest:
-			m.TSCManagementInformation = i
-		case ie.RemoveSRR:
-			m.RemoveSRR = append(m.RemoveSRR, i)
-		case ie.CreateSRR:
-			m.CreateSRR = append(m.CreateSRR, i)
-		case ie.UpdateSRR:
-			m.UpdateSRR = append(m.UpdateSRR, i)
-		case ie.ProvideATSSSControlInformation:
-			m.ProvideATSSSControlInformation = i
-		case ie.EthernetContextInformation:
-			m.EthernetContextInformation = i
-		case ie.AccessAvailabilityInformation:
-			m.AccessAvailabilityInformation = append(m.AccessAvailabilityInformation, i)
-		case ie.QueryPacketRateStatusWithinSessionModificationRequest:
-			m.QueryPacketRateStatus = append(m.QueryPacketRateStatus, i)
-		case ie.SNSSAI:
-			m.SNSSAI = i
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *SessionModificationRequest) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.FSEID:
+		m.CPFSEID = i
+	case ie.RemovePDR:
+		m.RemovePDR = append(m.RemovePDR, i)
+	case ie.RemoveFAR:
+		m.RemoveFAR = append(m.RemoveFAR, i)
+	case ie.RemoveURR:
+		m.RemoveURR = append(m.RemoveURR, i)
+	case ie.RemoveQER:
+		m.RemoveQER = append(m.RemoveQER, i)
+	case ie.RemoveBAR:
+		m.RemoveBAR = i
+	case ie.RemoveTrafficEndpoint:
+		m.RemoveTrafficEndpoint = append(m.RemoveTrafficEndpoint, i)
+	case ie.CreatePDR:
+		m.CreatePDR = append(m.CreatePDR, i)
+	case ie.CreateFAR:
+		m.CreateFAR = append(m.CreateFAR, i)
+	case ie.CreateURR:
+		m.CreateURR = append(m.CreateURR, i)
+	case ie.CreateQER:
+		m.CreateQER = append(m.CreateQER, i)
+	case ie.CreateBAR:
+		m.CreateBAR = i
+	case ie.CreateTrafficEndpoint:
+		m.CreateTrafficEndpoint = append(m.CreateTrafficEndpoint, i)
+	case ie.UpdatePDR:
+		m.UpdatePDR = append(m.UpdatePDR, i)
+	case ie.UpdateFAR:
+		m.UpdateFAR = append(m.UpdateFAR, i)
+	case ie.UpdateURR:
+		m.UpdateURR = append(m.UpdateURR, i)
+	case ie.UpdateQER:
+		m.UpdateQER = append(m.UpdateQER, i)
+	case ie.UpdateBARWithinSessionModificationRequest:
+		m.UpdateBAR = i
+	case ie.UpdateTrafficEndpoint:
+		m.UpdateTrafficEndpoint = append(m.UpdateTrafficEndpoint, i)
+	case ie.PFCPSMReqFlags:
+		m.PFCPSMReqFlags = i
+	case ie.QueryURR:
+		m.QueryURR = append(m.QueryURR, i)
+	case ie.FQCSID:
+		m.FQCSID = append(m.FQCSID, i)
+	case ie.UserPlaneInactivityTimer:
+		m.UserPlaneInactivityTimer = i
+	case ie.QueryURRReference:
+		m.QueryURRReference = i
+	case ie.TraceInformation:
+		m.TraceInformation = i
+	case ie.RemoveMAR:
+		m.RemoveMAR = append(m.RemoveMAR, i)
+	case ie.UpdateMAR:
+		m.UpdateMAR = append(m.UpdateMAR, i)
+	case ie.CreateMAR:
+		m.CreateMAR = append(m.CreateMAR, i)
+	case ie.NodeID:
+		m.NodeID = i
+	case ie.TSCManagementInformationWithinSessionModificationRequest:
+		m.TSCManagementInformation = i
+	case ie.RemoveSRR:
+		m.RemoveSRR = append(m.RemoveSRR, i)
+	case ie.CreateSRR:
+		m.CreateSRR = append(m.CreateSRR, i)
+	case ie.UpdateSRR:
+		m.UpdateSRR = append(m.UpdateSRR, i)
+	case ie.ProvideATSSSControlInformation:
+		m.ProvideATSSSControlInformation = i
+	case ie.EthernetContextInformation:
+		m.EthernetContextInformation = i
+	case ie.AccessAvailabilityInformation:
+		m.AccessAvailabilityInformation = append(m.AccessAvailabilityInformation, i)
+	case ie.QueryPacketRateStatusWithinSessionModificationRequest:
+		m.QueryPacketRateStatus = append(m.QueryPacketRateStatus, i)
+	case ie.SNSSAI:
+		m.SNSSAI = i
+	case ie.TLContainer:
+		m.TLContainer = i
+	case ie.UELevelMeasurementsConfiguration:
+		m.UELevelMeasurementsConfiguration = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *SessionModificationRequest) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -588,7 +614,7 @@ func (m *SessionModificationRequest) MarshalLen() int {
 	for _, i := range m.QueryURR {
 		l += i.MarshalLen()
 	}
-	if i := m.FQCSID; i != nil {
+	for _, i := range m.FQCSID {
 		l += i.MarshalLen()
 	}
 	if i := m.UserPlaneInactivityTimer; i != nil {
@@ -639,6 +665,12 @@ func (m *SessionModificationRequest) MarshalLen() int {
 	if i := m.SNSSAI; i != nil {
 		l += i.MarshalLen()
 	}
+	if i := m.TLContainer; i != nil {
+		l += i.MarshalLen()
+	}
+	if i := m.UELevelMeasurementsConfiguration; i != nil {
+		l += i.MarshalLen()
+	}
 
 	for _, ie := range m.IEs {
 		if ie == nil {
@@ -669,3 +701,24 @@ func (m *SessionModificationRequest) SEID() uint64 {
 func (m *SessionModificationRequest) IsRequest() bool {
 	return true
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *SessionModificationRequest) Validate() error {
+	return validate(MsgTypeSessionModificationRequest, m)
+}
+
+// MarshalJSON returns the JSON encoding of a SessionModificationRequest.
+func (m *SessionModificationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a SessionModificationRequest.
+func (m *SessionModificationRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeSessionModificationRequest, m)
+}
+
+// Clone returns a deep copy of a SessionModificationRequest.
+func (m *SessionModificationRequest) Clone() *SessionModificationRequest {
+	return Clone(m).(*SessionModificationRequest)
+}
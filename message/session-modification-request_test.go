@@ -440,9 +440,11 @@ func TestSessionModificationRequest(t *testing.T) {
 					ie.NewQERID(0xffffffff),
 				),
 				ie.NewSNSSAI(0x11, 0x223344),
+				ie.NewTLContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+				ie.NewUELevelMeasurementsConfiguration([]byte{0x01, 0x02}),
 			),
 			Serialized: []byte{
-				0x21, 0x34, 0x0c, 0xea, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
+				0x21, 0x34, 0x0c, 0xf8, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
 				0x00, 0x39, 0x00, 0x0d, 0x02, 0x11, 0x11, 0x11, 0x11, 0x22, 0x22, 0x22, 0x22, 0x7f, 0x00, 0x00, 0x01,
 				0x00, 0x0f, 0x00, 0x06,
 				0x00, 0x38, 0x00, 0x02, 0xff, 0xff,
@@ -899,6 +901,8 @@ func TestSessionModificationRequest(t *testing.T) {
 				0x01, 0x07, 0x00, 0x08, 0x00, 0x6d, 0x00, 0x04, 0xff, 0xff, 0xff, 0xff,
 				// SNSSAI
 				0x01, 0x01, 0x00, 0x04, 0x11, 0x22, 0x33, 0x44,
+				0x01, 0x50, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef,
+				0x01, 0x61, 0x00, 0x02, 0x01, 0x02,
 			},
 		},
 		{
@@ -1365,9 +1369,11 @@ func TestSessionModificationRequest(t *testing.T) {
 					ie.NewQERID(0xffffffff),
 				),
 				ie.NewSNSSAI(0x11, 0x223344),
+				ie.NewTLContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+				ie.NewUELevelMeasurementsConfiguration([]byte{0x01, 0x02}),
 			),
 			Serialized: []byte{
-				0x21, 0x34, 0x0e, 0x74, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
+				0x21, 0x34, 0x0e, 0x82, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
 				0x00, 0x39, 0x00, 0x0d, 0x02, 0x11, 0x11, 0x11, 0x11, 0x22, 0x22, 0x22, 0x22, 0x7f, 0x00, 0x00, 0x01,
 				0x00, 0x0f, 0x00, 0x06,
 				0x00, 0x38, 0x00, 0x02, 0xff, 0xff,
@@ -1882,6 +1888,8 @@ func TestSessionModificationRequest(t *testing.T) {
 				0x01, 0x07, 0x00, 0x08, 0x00, 0x6d, 0x00, 0x04, 0xff, 0xff, 0xff, 0xff,
 				// SNSSAI
 				0x01, 0x01, 0x00, 0x04, 0x11, 0x22, 0x33, 0x44,
+				0x01, 0x50, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef,
+				0x01, 0x61, 0x00, 0x02, 0x01, 0x02,
 			},
 		},
 	}
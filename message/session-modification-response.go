@@ -28,6 +28,7 @@ type SessionModificationResponse struct {
 	ATSSSControlParameters            *ie.IE
 	UpdatedPDR                        []*ie.IE
 	PacketRateStatusReport            []*ie.IE
+	TLContainer                       *ie.IE
 	IEs                               []*ie.IE
 }
 
@@ -69,6 +70,8 @@ func NewSessionModificationResponse(mp, fo uint8, seid uint64, seq uint32, pri u
 			m.UpdatedPDR = append(m.UpdatedPDR, i)
 		case ie.PacketRateStatusReport:
 			m.PacketRateStatusReport = append(m.PacketRateStatusReport, i)
+		case ie.TLContainer:
+			m.TLContainer = i
 		default:
 			m.IEs = append(m.IEs, i)
 		}
@@ -90,86 +93,92 @@ func (m *SessionModificationResponse) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *SessionModificationResponse) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.Cause; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.OffendingIE; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreatedPDR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.LoadControlInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.OverloadControlInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UsageReport {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.FailedRuleID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.AdditionalUsageReportsInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.CreatedUpdatedTrafficEndpoint {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.CreatedBridgeInfoForTSC; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.ATSSSControlParameters; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UpdatedPDR {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.PacketRateStatusReport {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+	if i := m.TLContainer; i != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -179,14 +188,13 @@ func (m *SessionModificationResponse) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseSessionModificationResponse decodes a given byte sequence as a SessionModificationResponse.
@@ -215,41 +223,48 @@ func (m *SessionModificationResponse) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.Cause:
-			m.Cause = i
-		case ie.OffendingIE:
-			m.OffendingIE = i
-		case ie.CreatedPDR:
-			m.CreatedPDR = append(m.CreatedPDR, i)
-		case ie.LoadControlInformation:
-			m.LoadControlInformation = i
-		case ie.OverloadControlInformation:
-			m.OverloadControlInformation = i
-		case ie.UsageReportWithinSessionModificationResponse:
-			m.UsageReport = append(m.UsageReport, i)
-		case ie.FailedRuleID:
-			m.FailedRuleID = i
-		case ie.AdditionalUsageReportsInformation:
-			m.AdditionalUsageReportsInformation = i
-		case ie.CreatedTrafficEndpoint:
-			m.CreatedUpdatedTrafficEndpoint = append(m.CreatedUpdatedTrafficEndpoint, i)
-		case ie.CreatedBridgeInfoForTSC:
-			m.CreatedBridgeInfoForTSC = i
-		case ie.ATSSSControlParameters:
-			m.ATSSSControlParameters = i
-		case ie.UpdatedPDR:
-			m.UpdatedPDR = append(m.UpdatedPDR, i)
-		case ie.PacketRateStatusReport:
-			m.PacketRateStatusReport = append(m.PacketRateStatusReport, i)
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *SessionModificationResponse) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.Cause:
+		m.Cause = i
+	case ie.OffendingIE:
+		m.OffendingIE = i
+	case ie.CreatedPDR:
+		m.CreatedPDR = append(m.CreatedPDR, i)
+	case ie.LoadControlInformation:
+		m.LoadControlInformation = i
+	case ie.OverloadControlInformation:
+		m.OverloadControlInformation = i
+	case ie.UsageReportWithinSessionModificationResponse:
+		m.UsageReport = append(m.UsageReport, i)
+	case ie.FailedRuleID:
+		m.FailedRuleID = i
+	case ie.AdditionalUsageReportsInformation:
+		m.AdditionalUsageReportsInformation = i
+	case ie.CreatedTrafficEndpoint:
+		m.CreatedUpdatedTrafficEndpoint = append(m.CreatedUpdatedTrafficEndpoint, i)
+	case ie.CreatedBridgeInfoForTSC:
+		m.CreatedBridgeInfoForTSC = i
+	case ie.ATSSSControlParameters:
+		m.ATSSSControlParameters = i
+	case ie.UpdatedPDR:
+		m.UpdatedPDR = append(m.UpdatedPDR, i)
+	case ie.PacketRateStatusReport:
+		m.PacketRateStatusReport = append(m.PacketRateStatusReport, i)
+	case ie.TLContainer:
+		m.TLContainer = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *SessionModificationResponse) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -293,6 +308,9 @@ func (m *SessionModificationResponse) MarshalLen() int {
 	for _, i := range m.PacketRateStatusReport {
 		l += i.MarshalLen()
 	}
+	if i := m.TLContainer; i != nil {
+		l += i.MarshalLen()
+	}
 
 	for _, ie := range m.IEs {
 		if ie == nil {
@@ -323,3 +341,24 @@ func (m *SessionModificationResponse) SEID() uint64 {
 func (m *SessionModificationResponse) IsRequest() bool {
 	return false
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *SessionModificationResponse) Validate() error {
+	return validate(MsgTypeSessionModificationResponse, m)
+}
+
+// MarshalJSON returns the JSON encoding of a SessionModificationResponse.
+func (m *SessionModificationResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a SessionModificationResponse.
+func (m *SessionModificationResponse) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeSessionModificationResponse, m)
+}
+
+// Clone returns a deep copy of a SessionModificationResponse.
+func (m *SessionModificationResponse) Clone() *SessionModificationResponse {
+	return Clone(m).(*SessionModificationResponse)
+}
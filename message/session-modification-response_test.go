@@ -90,13 +90,14 @@ func TestSessionModificationResponse(t *testing.T) {
 					ie.NewPDRID(0xffff),
 					ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
 				),
+				ie.NewTLContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
 				ie.NewPacketRateStatusReportWithinSessionModificationResponse(
 					ie.NewQERID(0xffffffff),
 					ie.NewPacketRateStatus(0x07, 0x1111, 0x2222, 0x3333, 0x4444, time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)),
 				),
 			),
 			Serialized: []byte{
-				0x21, 0x35, 0x02, 0x5a, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
+				0x21, 0x35, 0x02, 0x62, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
 				0x00, 0x13, 0x00, 0x01, 0x01,
 				0x00, 0x28, 0x00, 0x02, 0x00, 0x13,
 				0x00, 0x08, 0x00, 0x29,
@@ -178,6 +179,7 @@ func TestSessionModificationResponse(t *testing.T) {
 				0x01, 0x00, 0x00, 0x13,
 				0x00, 0x38, 0x00, 0x02, 0xff, 0xff,
 				0x00, 0x15, 0x00, 0x09, 0x01, 0x11, 0x11, 0x11, 0x11, 0x7f, 0x00, 0x00, 0x01,
+				0x01, 0x50, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef,
 				0x01, 0x08, 0x00, 0x1d,
 				0x00, 0x6d, 0x00, 0x04, 0xff, 0xff, 0xff, 0xff,
 				0x00, 0xc1, 0x00, 0x11,
@@ -268,13 +270,14 @@ func TestSessionModificationResponse(t *testing.T) {
 					ie.NewPDRID(0xffff),
 					ie.NewFTEID(0x01, 0x11111111, net.ParseIP("127.0.0.1"), nil, 0),
 				),
+				ie.NewTLContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
 				ie.NewPacketRateStatusReportWithinSessionModificationResponse(
 					ie.NewQERID(0xffffffff),
 					ie.NewPacketRateStatus(0x07, 0x1111, 0x2222, 0x3333, 0x4444, time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)),
 				),
 			),
 			Serialized: []byte{
-				0x21, 0x35, 0x02, 0x89, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
+				0x21, 0x35, 0x02, 0x91, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
 				0x00, 0x13, 0x00, 0x01, 0x01,
 				0x00, 0x28, 0x00, 0x02, 0x00, 0x13,
 				0x00, 0x08, 0x00, 0x29,
@@ -361,6 +364,7 @@ func TestSessionModificationResponse(t *testing.T) {
 				0x01, 0x00, 0x00, 0x13,
 				0x00, 0x38, 0x00, 0x02, 0xff, 0xff,
 				0x00, 0x15, 0x00, 0x09, 0x01, 0x11, 0x11, 0x11, 0x11, 0x7f, 0x00, 0x00, 0x01,
+				0x01, 0x50, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef,
 				0x01, 0x08, 0x00, 0x1d,
 				0x00, 0x6d, 0x00, 0x04, 0xff, 0xff, 0xff, 0xff,
 				0x00, 0xc1, 0x00, 0x11,
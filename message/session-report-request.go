@@ -25,6 +25,7 @@ type SessionReportRequest struct {
 	PacketRateStatusReport            *ie.IE
 	PortManagementInformationForTSC   *ie.IE
 	SessionReport                     []*ie.IE
+	TLContainer                       *ie.IE
 	IEs                               []*ie.IE
 }
 
@@ -64,6 +65,8 @@ func NewSessionReportRequest(mp, fo uint8, seid uint64, seq uint32, pri uint8, i
 			m.PortManagementInformationForTSC = i
 		case ie.SessionReport:
 			m.SessionReport = append(m.SessionReport, i)
+		case ie.TLContainer:
+			m.TLContainer = i
 		default:
 			m.IEs = append(m.IEs, i)
 		}
@@ -85,80 +88,86 @@ func (m *SessionReportRequest) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *SessionReportRequest) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.ReportType; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.DownlinkDataReport; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.UsageReport {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.ErrorIndicationReport; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.LoadControlInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.OverloadControlInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.AdditionalUsageReportsInformation; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.PFCPSRReqFlags; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.OldCPFSEID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.PacketRateStatusReport; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.PortManagementInformationForTSC; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	for _, i := range m.SessionReport {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+	if i := m.TLContainer; i != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -168,14 +177,13 @@ func (m *SessionReportRequest) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseSessionReportRequest decodes a given byte sequence as a SessionReportRequest.
@@ -204,39 +212,46 @@ func (m *SessionReportRequest) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.ReportType:
-			m.ReportType = i
-		case ie.DownlinkDataReport:
-			m.DownlinkDataReport = i
-		case ie.UsageReportWithinSessionReportRequest:
-			m.UsageReport = append(m.UsageReport, i)
-		case ie.ErrorIndicationReport:
-			m.ErrorIndicationReport = i
-		case ie.LoadControlInformation:
-			m.LoadControlInformation = i
-		case ie.OverloadControlInformation:
-			m.OverloadControlInformation = i
-		case ie.AdditionalUsageReportsInformation:
-			m.AdditionalUsageReportsInformation = i
-		case ie.PFCPSRReqFlags:
-			m.PFCPSRReqFlags = i
-		case ie.FSEID:
-			m.OldCPFSEID = i
-		case ie.PacketRateStatusReport:
-			m.PacketRateStatusReport = i
-		case ie.PortManagementInformationForTSCWithinSessionReportRequest:
-			m.PortManagementInformationForTSC = i
-		case ie.SessionReport:
-			m.SessionReport = append(m.SessionReport, i)
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *SessionReportRequest) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.ReportType:
+		m.ReportType = i
+	case ie.DownlinkDataReport:
+		m.DownlinkDataReport = i
+	case ie.UsageReportWithinSessionReportRequest:
+		m.UsageReport = append(m.UsageReport, i)
+	case ie.ErrorIndicationReport:
+		m.ErrorIndicationReport = i
+	case ie.LoadControlInformation:
+		m.LoadControlInformation = i
+	case ie.OverloadControlInformation:
+		m.OverloadControlInformation = i
+	case ie.AdditionalUsageReportsInformation:
+		m.AdditionalUsageReportsInformation = i
+	case ie.PFCPSRReqFlags:
+		m.PFCPSRReqFlags = i
+	case ie.FSEID:
+		m.OldCPFSEID = i
+	case ie.PacketRateStatusReport:
+		m.PacketRateStatusReport = i
+	case ie.PortManagementInformationForTSCWithinSessionReportRequest:
+		m.PortManagementInformationForTSC = i
+	case ie.SessionReport:
+		m.SessionReport = append(m.SessionReport, i)
+	case ie.TLContainer:
+		m.TLContainer = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *SessionReportRequest) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -277,6 +292,9 @@ func (m *SessionReportRequest) MarshalLen() int {
 	for _, i := range m.SessionReport {
 		l += i.MarshalLen()
 	}
+	if i := m.TLContainer; i != nil {
+		l += i.MarshalLen()
+	}
 
 	for _, ie := range m.IEs {
 		if ie == nil {
@@ -307,3 +325,24 @@ func (m *SessionReportRequest) SEID() uint64 {
 func (m *SessionReportRequest) IsRequest() bool {
 	return true
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *SessionReportRequest) Validate() error {
+	return validate(MsgTypeSessionReportRequest, m)
+}
+
+// MarshalJSON returns the JSON encoding of a SessionReportRequest.
+func (m *SessionReportRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a SessionReportRequest.
+func (m *SessionReportRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeSessionReportRequest, m)
+}
+
+// Clone returns a deep copy of a SessionReportRequest.
+func (m *SessionReportRequest) Clone() *SessionReportRequest {
+	return Clone(m).(*SessionReportRequest)
+}
@@ -398,6 +398,16 @@ func TestSessionReportRequest(t *testing.T) {
 				0x00, 0x9c, 0x00, 0x04, 0xdf, 0xd5, 0x2c, 0x00,
 				0x00, 0x4b, 0x00, 0x04, 0xdf, 0xd5, 0x2c, 0x00,
 			},
+		}, {
+			Description: "TL-Container",
+			Structured: message.NewSessionReportRequest(
+				mp, fo, seid, seq, pri,
+				ie.NewTLContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+			),
+			Serialized: []byte{
+				0x21, 0x38, 0x00, 0x14, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x11, 0x22, 0x33, 0x00,
+				0x01, 0x50, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef,
+			},
 		},
 	}
 
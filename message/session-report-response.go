@@ -68,50 +68,50 @@ func (m *SessionReportResponse) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *SessionReportResponse) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.Cause; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.OffendingIE; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.UpdateBAR; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.PFCPSRRspFlags; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.CPFSEID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.N4UFTEID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
 	if i := m.AlternativeSMFIPAddress; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -121,14 +121,13 @@ func (m *SessionReportResponse) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseSessionReportResponse decodes a given byte sequence as a SessionReportResponse.
@@ -157,29 +156,34 @@ func (m *SessionReportResponse) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.Cause:
-			m.Cause = i
-		case ie.OffendingIE:
-			m.OffendingIE = i
-		case ie.UpdateBARWithinSessionReportResponse:
-			m.UpdateBAR = i
-		case ie.PFCPSRRspFlags:
-			m.PFCPSRRspFlags = i
-		case ie.FSEID:
-			m.CPFSEID = i
-		case ie.FTEID:
-			m.N4UFTEID = i
-		case ie.AlternativeSMFIPAddress:
-			m.AlternativeSMFIPAddress = i
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *SessionReportResponse) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.Cause:
+		m.Cause = i
+	case ie.OffendingIE:
+		m.OffendingIE = i
+	case ie.UpdateBARWithinSessionReportResponse:
+		m.UpdateBAR = i
+	case ie.PFCPSRRspFlags:
+		m.PFCPSRRspFlags = i
+	case ie.FSEID:
+		m.CPFSEID = i
+	case ie.FTEID:
+		m.N4UFTEID = i
+	case ie.AlternativeSMFIPAddress:
+		m.AlternativeSMFIPAddress = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *SessionReportResponse) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -235,3 +239,24 @@ func (m *SessionReportResponse) SEID() uint64 {
 func (m *SessionReportResponse) IsRequest() bool {
 	return false
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *SessionReportResponse) Validate() error {
+	return validate(MsgTypeSessionReportResponse, m)
+}
+
+// MarshalJSON returns the JSON encoding of a SessionReportResponse.
+func (m *SessionReportResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a SessionReportResponse.
+func (m *SessionReportResponse) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeSessionReportResponse, m)
+}
+
+// Clone returns a deep copy of a SessionReportResponse.
+func (m *SessionReportResponse) Clone() *SessionReportResponse {
+	return Clone(m).(*SessionReportResponse)
+}
@@ -12,11 +12,14 @@ import (
 type SessionSetDeletionRequest struct {
 	*Header
 	NodeID *ie.IE
-	FQCSID *ie.IE
+	FQCSID []*ie.IE
 	IEs    []*ie.IE
 }
 
 // NewSessionSetDeletionRequest creates a new SessionSetDeletionRequest.
+//
+// The FQCSID IEs in ies are put in FQCSID following csid, as a request may
+// contain the ones of SGW-C, PGW-C/SMF, SGW-U, PGW-U/UPF, TWAN, ePDG and MME.
 func NewSessionSetDeletionRequest(seq uint32, id, csid *ie.IE, ies ...*ie.IE) *SessionSetDeletionRequest {
 	m := &SessionSetDeletionRequest{
 		Header: NewHeader(
@@ -25,8 +28,21 @@ func NewSessionSetDeletionRequest(seq uint32, id, csid *ie.IE, ies ...*ie.IE) *S
 			nil,
 		),
 		NodeID: id,
-		FQCSID: csid,
-		IEs:    ies,
+	}
+	if csid != nil {
+		m.FQCSID = append(m.FQCSID, csid)
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.FQCSID:
+			m.FQCSID = append(m.FQCSID, i)
+		default:
+			m.IEs = append(m.IEs, i)
+		}
 	}
 	m.SetLength()
 
@@ -45,20 +61,20 @@ func (m *SessionSetDeletionRequest) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *SessionSetDeletionRequest) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	if i := m.NodeID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
 	}
-	if i := m.FQCSID; i != nil {
-		if err := i.MarshalTo(m.Payload[offset:]); err != nil {
+	for _, i := range m.FQCSID {
+		if err := i.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += i.MarshalLen()
@@ -68,14 +84,13 @@ func (m *SessionSetDeletionRequest) MarshalTo(b []byte) error {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseSessionSetDeletionRequest decodes a given byte sequence as a SessionSetDeletionRequest.
@@ -104,19 +119,24 @@ func (m *SessionSetDeletionRequest) UnmarshalBinary(b []byte) error {
 	}
 
 	for _, i := range ies {
-		switch i.Type {
-		case ie.NodeID:
-			m.NodeID = i
-		case ie.FQCSID:
-			m.FQCSID = i
-		default:
-			m.IEs = append(m.IEs, i)
-		}
+		m.addIE(i)
 	}
 
 	return nil
 }
 
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *SessionSetDeletionRequest) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.NodeID:
+		m.NodeID = i
+	case ie.FQCSID:
+		m.FQCSID = append(m.FQCSID, i)
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *SessionSetDeletionRequest) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -124,7 +144,7 @@ func (m *SessionSetDeletionRequest) MarshalLen() int {
 	if i := m.NodeID; i != nil {
 		l += i.MarshalLen()
 	}
-	if i := m.FQCSID; i != nil {
+	for _, i := range m.FQCSID {
 		l += i.MarshalLen()
 	}
 
@@ -157,3 +177,24 @@ func (m *SessionSetDeletionRequest) SEID() uint64 {
 func (m *SessionSetDeletionRequest) IsRequest() bool {
 	return true
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *SessionSetDeletionRequest) Validate() error {
+	return validate(MsgTypeSessionSetDeletionRequest, m)
+}
+
+// MarshalJSON returns the JSON encoding of a SessionSetDeletionRequest.
+func (m *SessionSetDeletionRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a SessionSetDeletionRequest.
+func (m *SessionSetDeletionRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeSessionSetDeletionRequest, m)
+}
+
+// Clone returns a deep copy of a SessionSetDeletionRequest.
+func (m *SessionSetDeletionRequest) Clone() *SessionSetDeletionRequest {
+	return Clone(m).(*SessionSetDeletionRequest)
+}
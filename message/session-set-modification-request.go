@@ -0,0 +1,251 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// SessionSetModificationRequest is a SessionSetModificationRequest formed PFCP Header and its IEs above.
+type SessionSetModificationRequest struct {
+	*Header
+	NodeID                  *ie.IE
+	AlternativeSMFIPAddress *ie.IE
+	FQCSID                  []*ie.IE
+	GroupID                 []*ie.IE
+	CPIPAddress             []*ie.IE
+	PFCPSMReqFlags          *ie.IE
+	IEs                     []*ie.IE
+}
+
+// NewSessionSetModificationRequest creates a new SessionSetModificationRequest.
+func NewSessionSetModificationRequest(seq uint32, ies ...*ie.IE) *SessionSetModificationRequest {
+	m := &SessionSetModificationRequest{
+		Header: NewHeader(
+			1, 0, 0, 0,
+			MsgTypeSessionSetModificationRequest, 0, seq, 0,
+			nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.NodeID:
+			m.NodeID = i
+		case ie.AlternativeSMFIPAddress:
+			m.AlternativeSMFIPAddress = i
+		case ie.FQCSID:
+			m.FQCSID = append(m.FQCSID, i)
+		case ie.GroupID:
+			m.GroupID = append(m.GroupID, i)
+		case ie.CPIPAddress:
+			m.CPIPAddress = append(m.CPIPAddress, i)
+		case ie.PFCPSMReqFlags:
+			m.PFCPSMReqFlags = i
+		default:
+			m.IEs = append(m.IEs, i)
+		}
+	}
+
+	m.SetLength()
+	return m
+}
+
+// Marshal returns the byte sequence generated from a SessionSetModificationRequest.
+func (m *SessionSetModificationRequest) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (m *SessionSetModificationRequest) MarshalTo(b []byte) error {
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
+	}
+
+	offset := 0
+	if i := m.NodeID; i != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+	if i := m.AlternativeSMFIPAddress; i != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+	for _, i := range m.FQCSID {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+	for _, i := range m.GroupID {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+	for _, i := range m.CPIPAddress {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+	if i := m.PFCPSMReqFlags; i != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+
+	for _, ie := range m.IEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	return m.Header.marshalWithPayloadTo(b, len(p))
+}
+
+// ParseSessionSetModificationRequest decodes a given byte sequence as a SessionSetModificationRequest.
+func ParseSessionSetModificationRequest(b []byte) (*SessionSetModificationRequest, error) {
+	m := &SessionSetModificationRequest{}
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a SessionSetModificationRequest.
+func (m *SessionSetModificationRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	m.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(m.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(m.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		m.addIE(i)
+	}
+
+	return nil
+}
+
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *SessionSetModificationRequest) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.NodeID:
+		m.NodeID = i
+	case ie.AlternativeSMFIPAddress:
+		m.AlternativeSMFIPAddress = i
+	case ie.FQCSID:
+		m.FQCSID = append(m.FQCSID, i)
+	case ie.GroupID:
+		m.GroupID = append(m.GroupID, i)
+	case ie.CPIPAddress:
+		m.CPIPAddress = append(m.CPIPAddress, i)
+	case ie.PFCPSMReqFlags:
+		m.PFCPSMReqFlags = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
+// MarshalLen returns the serial length of Data.
+func (m *SessionSetModificationRequest) MarshalLen() int {
+	l := m.Header.MarshalLen() - len(m.Header.Payload)
+
+	if i := m.NodeID; i != nil {
+		l += i.MarshalLen()
+	}
+	if i := m.AlternativeSMFIPAddress; i != nil {
+		l += i.MarshalLen()
+	}
+	for _, i := range m.FQCSID {
+		l += i.MarshalLen()
+	}
+	for _, i := range m.GroupID {
+		l += i.MarshalLen()
+	}
+	for _, i := range m.CPIPAddress {
+		l += i.MarshalLen()
+	}
+	if i := m.PFCPSMReqFlags; i != nil {
+		l += i.MarshalLen()
+	}
+
+	for _, ie := range m.IEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (m *SessionSetModificationRequest) SetLength() {
+	m.Header.Length = uint16(m.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (m *SessionSetModificationRequest) MessageTypeName() string {
+	return "Session Set Modification Request"
+}
+
+// SEID returns the SEID in uint64.
+func (m *SessionSetModificationRequest) SEID() uint64 {
+	return m.Header.seid()
+}
+
+// IsRequest reports whether the message is a request.
+func (m *SessionSetModificationRequest) IsRequest() bool {
+	return true
+}
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *SessionSetModificationRequest) Validate() error {
+	return validate(MsgTypeSessionSetModificationRequest, m)
+}
+
+// MarshalJSON returns the JSON encoding of a SessionSetModificationRequest.
+func (m *SessionSetModificationRequest) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a SessionSetModificationRequest.
+func (m *SessionSetModificationRequest) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeSessionSetModificationRequest, m)
+}
+
+// Clone returns a deep copy of a SessionSetModificationRequest.
+func (m *SessionSetModificationRequest) Clone() *SessionSetModificationRequest {
+	return Clone(m).(*SessionSetModificationRequest)
+}
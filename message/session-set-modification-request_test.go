@@ -0,0 +1,50 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+
+	"github.com/wmnsk/go-pfcp/internal/testutil"
+)
+
+func TestSessionSetModificationRequest(t *testing.T) {
+	cases := []testutil.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewSessionSetModificationRequest(
+				seq,
+				ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+				ie.NewAlternativeSMFIPAddress(net.ParseIP("127.0.0.1"), nil),
+				ie.NewFQCSID("127.0.0.1", 1),
+				ie.NewGroupID([]byte{0x11, 0x22, 0x33, 0x44}),
+				ie.NewCPIPAddress(net.ParseIP("127.0.0.1"), nil),
+				ie.NewPFCPSMReqFlags(0x01),
+			),
+			Serialized: []byte{
+				0x20, 0x10, 0x00, 0x4f, 0x11, 0x22, 0x33, 0x00,
+				0x00, 0x3c, 0x00, 0x1d, 0x02, 0x07, 0x67, 0x6f, 0x2d, 0x70, 0x66, 0x63, 0x70, 0x03, 0x65, 0x70, 0x63, 0x0b, 0x33, 0x67, 0x70, 0x70, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x03, 0x6f, 0x72, 0x67,
+				0x00, 0xb2, 0x00, 0x05, 0x02, 0x7f, 0x00, 0x00, 0x01,
+				0x00, 0x41, 0x00, 0x07, 0x01, 0x7f, 0x00, 0x00, 0x01, 0x00, 0x01,
+				0x01, 0x23, 0x00, 0x04, 0x11, 0x22, 0x33, 0x44,
+				0x01, 0x24, 0x00, 0x05, 0x02, 0x7f, 0x00, 0x00, 0x01,
+				0x00, 0x31, 0x00, 0x01, 0x01,
+			},
+		},
+	}
+
+	testutil.Run(t, cases, func(b []byte) (testutil.Serializable, error) {
+		v, err := message.ParseSessionSetModificationRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -0,0 +1,197 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// SessionSetModificationResponse is a SessionSetModificationResponse formed PFCP Header and its IEs above.
+type SessionSetModificationResponse struct {
+	*Header
+	NodeID      *ie.IE
+	Cause       *ie.IE
+	OffendingIE *ie.IE
+	IEs         []*ie.IE
+}
+
+// NewSessionSetModificationResponse creates a new SessionSetModificationResponse.
+func NewSessionSetModificationResponse(seq uint32, id, cause, offending *ie.IE, ies ...*ie.IE) *SessionSetModificationResponse {
+	m := &SessionSetModificationResponse{
+		Header: NewHeader(
+			1, 0, 0, 0,
+			MsgTypeSessionSetModificationResponse, 0, seq, 0,
+			nil,
+		),
+		NodeID:      id,
+		Cause:       cause,
+		OffendingIE: offending,
+		IEs:         ies,
+	}
+	m.SetLength()
+
+	return m
+}
+
+// Marshal returns the byte sequence generated from a SessionSetModificationResponse.
+func (m *SessionSetModificationResponse) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (m *SessionSetModificationResponse) MarshalTo(b []byte) error {
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
+	}
+
+	offset := 0
+	if i := m.NodeID; i != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+	if i := m.Cause; i != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+	if i := m.OffendingIE; i != nil {
+		if err := i.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += i.MarshalLen()
+	}
+
+	for _, ie := range m.IEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(p[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	return m.Header.marshalWithPayloadTo(b, len(p))
+}
+
+// ParseSessionSetModificationResponse decodes a given byte sequence as a SessionSetModificationResponse.
+func ParseSessionSetModificationResponse(b []byte) (*SessionSetModificationResponse, error) {
+	m := &SessionSetModificationResponse{}
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a SessionSetModificationResponse.
+func (m *SessionSetModificationResponse) UnmarshalBinary(b []byte) error {
+	var err error
+	m.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(m.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(m.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		m.addIE(i)
+	}
+
+	return nil
+}
+
+// addIE sets i in the field for its type, or appends it to IEs.
+func (m *SessionSetModificationResponse) addIE(i *ie.IE) {
+	switch i.Type {
+	case ie.NodeID:
+		m.NodeID = i
+	case ie.Cause:
+		m.Cause = i
+	case ie.OffendingIE:
+		m.OffendingIE = i
+	default:
+		m.IEs = append(m.IEs, i)
+	}
+}
+
+// MarshalLen returns the serial length of Data.
+func (m *SessionSetModificationResponse) MarshalLen() int {
+	l := m.Header.MarshalLen() - len(m.Header.Payload)
+
+	if i := m.NodeID; i != nil {
+		l += i.MarshalLen()
+	}
+	if i := m.Cause; i != nil {
+		l += i.MarshalLen()
+	}
+	if i := m.OffendingIE; i != nil {
+		l += i.MarshalLen()
+	}
+
+	for _, ie := range m.IEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (m *SessionSetModificationResponse) SetLength() {
+	m.Header.Length = uint16(m.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (m *SessionSetModificationResponse) MessageTypeName() string {
+	return "Session Set Modification Response"
+}
+
+// SEID returns the SEID in uint64.
+func (m *SessionSetModificationResponse) SEID() uint64 {
+	return m.Header.seid()
+}
+
+// IsRequest reports whether the message is a request.
+func (m *SessionSetModificationResponse) IsRequest() bool {
+	return false
+}
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *SessionSetModificationResponse) Validate() error {
+	return validate(MsgTypeSessionSetModificationResponse, m)
+}
+
+// MarshalJSON returns the JSON encoding of a SessionSetModificationResponse.
+func (m *SessionSetModificationResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a SessionSetModificationResponse.
+func (m *SessionSetModificationResponse) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeSessionSetModificationResponse, m)
+}
+
+// Clone returns a deep copy of a SessionSetModificationResponse.
+func (m *SessionSetModificationResponse) Clone() *SessionSetModificationResponse {
+	return Clone(m).(*SessionSetModificationResponse)
+}
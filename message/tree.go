@@ -0,0 +1,84 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// WriteTree writes m to w in an indented tree format similar to the one of
+// Wireshark's PFCP dissector, i.e., the fields in the header followed by the
+// IEs in the order they appear on the wire. The IEs are written in the way
+// described in (*ie.IE).WriteTree, and the raw bytes of the header are added
+// if cfg.Hex is set. cfg can be nil to use the default options.
+func WriteTree(w io.Writer, m Message, cfg *ie.TreeConfig) error {
+	if cfg == nil {
+		cfg = &ie.TreeConfig{}
+	}
+
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return err
+	}
+	h, err := ParseHeader(b)
+	if err != nil {
+		return err
+	}
+
+	indent := cfg.Indent
+	if indent == "" {
+		indent = "    "
+	}
+	inner := cfg.Prefix + indent
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s%s\n", cfg.Prefix, m.MessageTypeName())
+	fmt.Fprintf(buf, "%sVersion: %d\n", inner, h.Version())
+	fmt.Fprintf(buf, "%sFlags: FO: %t, MP: %t, S: %t\n", inner, h.HasFO(), h.HasMP(), h.HasSEID())
+	fmt.Fprintf(buf, "%sMessage Type: %d\n", inner, h.Type)
+	fmt.Fprintf(buf, "%sLength: %d\n", inner, h.Length)
+	if h.HasSEID() {
+		fmt.Fprintf(buf, "%sSEID: %#016x\n", inner, h.SEID)
+	}
+	fmt.Fprintf(buf, "%sSequence Number: %#x\n", inner, h.SequenceNumber)
+	if h.HasMP() {
+		fmt.Fprintf(buf, "%sMessage Priority: %d\n", inner, h.MP())
+	}
+	if cfg.Hex {
+		fmt.Fprintf(buf, "%sRaw: % x\n", inner, b[:len(b)-len(h.Payload)])
+	}
+
+	ies, err := ie.ParseMultiIEs(h.Payload)
+	if err != nil {
+		fmt.Fprintf(buf, "%sPayload: % x\n", inner, h.Payload)
+		ies = nil
+	}
+
+	c := *cfg
+	c.Prefix = inner
+	for _, i := range ies {
+		if err := i.WriteTree(buf, &c); err != nil {
+			return err
+		}
+	}
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// Tree returns m in the tree format of WriteTree. It returns the error
+// message instead if m cannot be serialized.
+func Tree(m Message, cfg *ie.TreeConfig) string {
+	b := &strings.Builder{}
+	if err := WriteTree(b, m, cfg); err != nil {
+		return err.Error()
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,39 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+func TestTree(t *testing.T) {
+	m := message.NewSessionDeletionRequest(
+		mp, fo, seid, seq, pri,
+		ie.NewNodeID("127.0.0.1", "", ""),
+	)
+
+	want := strings.Join([]string{
+		"Session Deletion Request",
+		"    Version: 1",
+		"    Flags: FO: false, MP: false, S: true",
+		"    Message Type: 54",
+		"    Length: 21",
+		"    SEID: 0x1122334455667788",
+		"    Sequence Number: 0x112233",
+		"    Raw: 21 36 00 15 11 22 33 44 55 66 77 88 11 22 33 00",
+		"    NodeID (60), Length: 5, Value: 127.0.0.1",
+		"        Raw: 00 3c 00 05 00 7f 00 00 01",
+	}, "\n")
+
+	if diff := cmp.Diff(message.Tree(m, &ie.TreeConfig{Hex: true}), want); diff != "" {
+		t.Error(diff)
+	}
+}
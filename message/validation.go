@@ -0,0 +1,490 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"reflect"
+
+	"github.com/wmnsk/go-pfcp/ie"
+)
+
+// presence is the presence requirement of an IE in a message, as shown in
+// the "P" column of the tables in TS 29.244 chapter 7.
+type presence uint8
+
+const (
+	mandatory presence = iota
+	conditional
+	optional
+)
+
+// ieRule is the presence requirement of a type of IE in a message.
+//
+// required is only meaningful for conditional IEs. It reports whether the
+// condition is met with the IEs in the message. If it is nil, the condition
+// cannot be evaluated from the message itself and the IE is never reported
+// as missing.
+type ieRule struct {
+	typ      ie.IEType
+	presence presence
+	required func(ies []*ie.IE) bool
+}
+
+// ieRules is the list of IEs allowed in each type of message.
+// Spec: TS 29.244 V18 7.4 Node Related Messages, 7.5 Session Related Messages.
+var ieRules = map[uint8][]ieRule{
+	MsgTypeHeartbeatRequest: {
+		{typ: ie.RecoveryTimeStamp, presence: mandatory},
+		{typ: ie.SourceIPAddress, presence: optional},
+	},
+	MsgTypeHeartbeatResponse: {
+		{typ: ie.RecoveryTimeStamp, presence: mandatory},
+	},
+	MsgTypePFDManagementRequest: {
+		{typ: ie.ApplicationIDsPFDs, presence: conditional},
+		{typ: ie.NodeID, presence: optional},
+	},
+	MsgTypePFDManagementResponse: {
+		{typ: ie.Cause, presence: mandatory},
+		{typ: ie.OffendingIE, presence: conditional, required: isRejectedByIE},
+		{typ: ie.NodeID, presence: optional},
+	},
+	MsgTypeAssociationSetupRequest: {
+		{typ: ie.NodeID, presence: mandatory},
+		{typ: ie.RecoveryTimeStamp, presence: mandatory},
+		{typ: ie.UPFunctionFeatures, presence: conditional},
+		{typ: ie.CPFunctionFeatures, presence: conditional},
+		{typ: ie.UserPlaneIPResourceInformation, presence: optional},
+		{typ: ie.AlternativeSMFIPAddress, presence: optional},
+		{typ: ie.SMFSetID, presence: conditional},
+		{typ: ie.PFCPSessionRetentionInformation, presence: optional},
+		{typ: ie.UEIPAddressPoolInformation, presence: optional},
+		{typ: ie.GTPUPathQoSControlInformation, presence: conditional},
+		{typ: ie.ClockDriftControlInformation, presence: conditional},
+		{typ: ie.NFInstanceID, presence: optional},
+		{typ: ie.PFCPASReqFlags, presence: optional},
+	},
+	MsgTypeAssociationSetupResponse: {
+		{typ: ie.NodeID, presence: mandatory},
+		{typ: ie.Cause, presence: mandatory},
+		{typ: ie.RecoveryTimeStamp, presence: mandatory},
+		{typ: ie.UPFunctionFeatures, presence: conditional},
+		{typ: ie.CPFunctionFeatures, presence: conditional},
+		{typ: ie.UserPlaneIPResourceInformation, presence: optional},
+		{typ: ie.AlternativeSMFIPAddress, presence: optional},
+		{typ: ie.SMFSetID, presence: conditional},
+		{typ: ie.PFCPASRspFlags, presence: optional},
+		{typ: ie.UEIPAddressPoolInformation, presence: optional},
+		{typ: ie.GTPUPathQoSControlInformation, presence: conditional},
+		{typ: ie.ClockDriftControlInformation, presence: conditional},
+		{typ: ie.NFInstanceID, presence: optional},
+	},
+	MsgTypeAssociationUpdateRequest: {
+		{typ: ie.NodeID, presence: mandatory},
+		{typ: ie.UPFunctionFeatures, presence: optional},
+		{typ: ie.CPFunctionFeatures, presence: optional},
+		{typ: ie.UserPlaneIPResourceInformation, presence: optional},
+		{typ: ie.PFCPAssociationReleaseRequest, presence: conditional},
+		{typ: ie.GracefulReleasePeriod, presence: conditional},
+		{typ: ie.PFCPAUReqFlags, presence: optional},
+		{typ: ie.SMFSetID, presence: conditional},
+		{typ: ie.AlternativeSMFIPAddress, presence: optional},
+		{typ: ie.ClockDriftControlInformation, presence: conditional},
+		{typ: ie.UEIPAddressPoolInformation, presence: optional},
+		{typ: ie.GTPUPathQoSControlInformation, presence: conditional},
+		{typ: ie.UEIPAddressUsageInformation, presence: optional},
+	},
+	MsgTypeAssociationUpdateResponse: {
+		{typ: ie.NodeID, presence: mandatory},
+		{typ: ie.Cause, presence: mandatory},
+		{typ: ie.UPFunctionFeatures, presence: optional},
+		{typ: ie.CPFunctionFeatures, presence: optional},
+		{typ: ie.UEIPAddressUsageInformation, presence: optional},
+	},
+	MsgTypeAssociationReleaseRequest: {
+		{typ: ie.NodeID, presence: mandatory},
+	},
+	MsgTypeAssociationReleaseResponse: {
+		{typ: ie.NodeID, presence: mandatory},
+		{typ: ie.Cause, presence: mandatory},
+	},
+	MsgTypeVersionNotSupportedResponse: {},
+	MsgTypeNodeReportRequest: {
+		{typ: ie.NodeID, presence: mandatory},
+		{typ: ie.NodeReportType, presence: mandatory},
+		{typ: ie.UserPlanePathFailureReport, presence: conditional, required: hasNodeReportTypeBit(1)},
+		{typ: ie.UserPlanePathRecoveryReport, presence: conditional, required: hasNodeReportTypeBit(2)},
+		{typ: ie.ClockDriftReport, presence: conditional, required: hasNodeReportTypeBit(3)},
+		{typ: ie.GTPUPathQoSReport, presence: conditional, required: hasNodeReportTypeBit(4)},
+		{typ: ie.PeerUPRestartReport, presence: conditional},
+		{typ: ie.VendorSpecificNodeReportType, presence: optional},
+	},
+	MsgTypeNodeReportResponse: {
+		{typ: ie.NodeID, presence: mandatory},
+		{typ: ie.Cause, presence: mandatory},
+		{typ: ie.OffendingIE, presence: conditional, required: isRejectedByIE},
+	},
+	MsgTypeSessionSetDeletionRequest: {
+		{typ: ie.NodeID, presence: mandatory},
+		{typ: ie.FQCSID, presence: conditional},
+	},
+	MsgTypeSessionSetDeletionResponse: {
+		{typ: ie.NodeID, presence: mandatory},
+		{typ: ie.Cause, presence: mandatory},
+		{typ: ie.OffendingIE, presence: conditional, required: isRejectedByIE},
+	},
+	MsgTypeSessionSetModificationRequest: {
+		{typ: ie.NodeID, presence: mandatory},
+		{typ: ie.AlternativeSMFIPAddress, presence: mandatory},
+		{typ: ie.FQCSID, presence: conditional},
+		{typ: ie.GroupID, presence: conditional},
+		{typ: ie.CPIPAddress, presence: conditional},
+		{typ: ie.PFCPSMReqFlags, presence: conditional},
+	},
+	MsgTypeSessionSetModificationResponse: {
+		{typ: ie.NodeID, presence: mandatory},
+		{typ: ie.Cause, presence: mandatory},
+		{typ: ie.OffendingIE, presence: conditional, required: isRejectedByIE},
+	},
+	MsgTypeSessionEstablishmentRequest: {
+		{typ: ie.NodeID, presence: mandatory},
+		{typ: ie.FSEID, presence: mandatory},
+		{typ: ie.CreatePDR, presence: mandatory},
+		{typ: ie.CreateFAR, presence: mandatory},
+		{typ: ie.CreateURR, presence: conditional},
+		{typ: ie.CreateQER, presence: conditional},
+		{typ: ie.CreateBAR, presence: optional},
+		{typ: ie.CreateTrafficEndpoint, presence: conditional},
+		{typ: ie.PDNType, presence: conditional},
+		{typ: ie.FQCSID, presence: conditional},
+		{typ: ie.UserPlaneInactivityTimer, presence: optional},
+		{typ: ie.UserID, presence: optional},
+		{typ: ie.TraceInformation, presence: optional},
+		{typ: ie.APNDNN, presence: optional},
+		{typ: ie.CreateMAR, presence: conditional},
+		{typ: ie.PFCPSEReqFlags, presence: conditional},
+		{typ: ie.CreateBridgeInfoForTSC, presence: conditional},
+		{typ: ie.CreateSRR, presence: optional},
+		{typ: ie.ProvideATSSSControlInformation, presence: conditional},
+		{typ: ie.RecoveryTimeStamp, presence: optional},
+		{typ: ie.SNSSAI, presence: optional},
+		{typ: ie.ProvideRDSConfigurationInformation, presence: optional},
+		{typ: ie.RATType, presence: optional},
+		{typ: ie.L2TPTunnelInformation, presence: conditional},
+		{typ: ie.L2TPSessionInformation, presence: conditional},
+		{typ: ie.GroupID, presence: optional},
+		{typ: ie.MBSSessionN4mbControlInformation, presence: conditional},
+		{typ: ie.MBSSessionN4ControlInformation, presence: conditional},
+		{typ: ie.DSCPToPPIControlInformation, presence: optional},
+		{typ: ie.HPLMNSNSSAI, presence: conditional},
+		{typ: ie.UELevelMeasurementsConfiguration, presence: optional},
+	},
+	MsgTypeSessionEstablishmentResponse: {
+		{typ: ie.NodeID, presence: mandatory},
+		{typ: ie.Cause, presence: mandatory},
+		{typ: ie.OffendingIE, presence: conditional, required: isRejectedByIE},
+		{typ: ie.FSEID, presence: conditional, required: isAccepted},
+		{typ: ie.CreatedPDR, presence: conditional},
+		{typ: ie.LoadControlInformation, presence: optional},
+		{typ: ie.OverloadControlInformation, presence: optional},
+		{typ: ie.FQCSID, presence: conditional},
+		{typ: ie.FailedRuleID, presence: conditional, required: isRuleCreationFailed},
+		{typ: ie.CreatedTrafficEndpoint, presence: conditional},
+		{typ: ie.CreatedBridgeInfoForTSC, presence: conditional},
+		{typ: ie.ATSSSControlParameters, presence: conditional},
+		{typ: ie.RDSConfigurationInformation, presence: optional},
+		{typ: ie.PartialFailureInformation, presence: conditional},
+		{typ: ie.CreatedL2TPSession, presence: conditional},
+		{typ: ie.MBSSessionN4mbInformation, presence: conditional},
+		{typ: ie.MBSSessionN4Information, presence: conditional},
+	},
+	MsgTypeSessionModificationRequest: {
+		{typ: ie.FSEID, presence: conditional},
+		{typ: ie.RemovePDR, presence: conditional},
+		{typ: ie.RemoveFAR, presence: conditional},
+		{typ: ie.RemoveURR, presence: conditional},
+		{typ: ie.RemoveQER, presence: conditional},
+		{typ: ie.RemoveBAR, presence: conditional},
+		{typ: ie.RemoveTrafficEndpoint, presence: conditional},
+		{typ: ie.CreatePDR, presence: conditional},
+		{typ: ie.CreateFAR, presence: conditional},
+		{typ: ie.CreateURR, presence: conditional},
+		{typ: ie.CreateQER, presence: conditional},
+		{typ: ie.CreateBAR, presence: conditional},
+		{typ: ie.CreateTrafficEndpoint, presence: conditional},
+		{typ: ie.UpdatePDR, presence: conditional},
+		{typ: ie.UpdateFAR, presence: conditional},
+		{typ: ie.UpdateURR, presence: conditional},
+		{typ: ie.UpdateQER, presence: conditional},
+		{typ: ie.UpdateBARWithinSessionModificationRequest, presence: conditional},
+		{typ: ie.UpdateTrafficEndpoint, presence: conditional},
+		{typ: ie.PFCPSMReqFlags, presence: conditional},
+		{typ: ie.QueryURR, presence: conditional},
+		{typ: ie.FQCSID, presence: conditional},
+		{typ: ie.UserPlaneInactivityTimer, presence: conditional},
+		{typ: ie.QueryURRReference, presence: optional},
+		{typ: ie.TraceInformation, presence: conditional},
+		{typ: ie.RemoveMAR, presence: conditional},
+		{typ: ie.UpdateMAR, presence: conditional},
+		{typ: ie.CreateMAR, presence: conditional},
+		{typ: ie.NodeID, presence: conditional},
+		{typ: ie.TSCManagementInformationWithinSessionModificationRequest, presence: conditional},
+		{typ: ie.RemoveSRR, presence: conditional},
+		{typ: ie.CreateSRR, presence: conditional},
+		{typ: ie.UpdateSRR, presence: conditional},
+		{typ: ie.ProvideATSSSControlInformation, presence: conditional},
+		{typ: ie.EthernetContextInformation, presence: conditional},
+		{typ: ie.AccessAvailabilityInformation, presence: optional},
+		{typ: ie.QueryPacketRateStatusWithinSessionModificationRequest, presence: optional},
+		{typ: ie.SNSSAI, presence: optional},
+		{typ: ie.TLContainer, presence: conditional},
+		{typ: ie.UELevelMeasurementsConfiguration, presence: optional},
+		{typ: ie.RATType, presence: optional},
+		{typ: ie.GroupID, presence: optional},
+		{typ: ie.MBSSessionN4ControlInformation, presence: conditional},
+		{typ: ie.DSCPToPPIControlInformation, presence: optional},
+	},
+	MsgTypeSessionModificationResponse: {
+		{typ: ie.Cause, presence: mandatory},
+		{typ: ie.OffendingIE, presence: conditional, required: isRejectedByIE},
+		{typ: ie.CreatedPDR, presence: conditional},
+		{typ: ie.LoadControlInformation, presence: optional},
+		{typ: ie.OverloadControlInformation, presence: optional},
+		{typ: ie.UsageReportWithinSessionModificationResponse, presence: conditional},
+		{typ: ie.FailedRuleID, presence: conditional, required: isRuleCreationFailed},
+		{typ: ie.AdditionalUsageReportsInformation, presence: conditional},
+		{typ: ie.CreatedTrafficEndpoint, presence: conditional},
+		{typ: ie.CreatedBridgeInfoForTSC, presence: conditional},
+		{typ: ie.ATSSSControlParameters, presence: conditional},
+		{typ: ie.UpdatedPDR, presence: conditional},
+		{typ: ie.PacketRateStatusReport, presence: conditional},
+		{typ: ie.PacketRateStatusReportWithinSessionModificationResponse, presence: conditional},
+		{typ: ie.TLContainer, presence: conditional},
+		{typ: ie.TSCManagementInformationWithinSessionModificationResponse, presence: conditional},
+		{typ: ie.PartialFailureInformation, presence: conditional},
+		{typ: ie.MBSSessionN4Information, presence: conditional},
+	},
+	MsgTypeSessionDeletionRequest: {},
+	MsgTypeSessionDeletionResponse: {
+		{typ: ie.Cause, presence: mandatory},
+		{typ: ie.OffendingIE, presence: conditional, required: isRejectedByIE},
+		{typ: ie.LoadControlInformation, presence: optional},
+		{typ: ie.OverloadControlInformation, presence: optional},
+		{typ: ie.UsageReportWithinSessionDeletionResponse, presence: conditional},
+		{typ: ie.AdditionalUsageReportsInformation, presence: conditional},
+		{typ: ie.PacketRateStatusReport, presence: conditional},
+		{typ: ie.SessionReport, presence: conditional},
+		{typ: ie.TLContainer, presence: conditional},
+		{typ: ie.PFCPSDRspFlags, presence: conditional},
+		{typ: ie.MBSSessionN4Information, presence: conditional},
+	},
+	MsgTypeSessionReportRequest: {
+		{typ: ie.ReportType, presence: mandatory},
+		{typ: ie.DownlinkDataReport, presence: conditional, required: hasReportTypeBit((*ie.IE).HasDLDR)},
+		{typ: ie.UsageReportWithinSessionReportRequest, presence: conditional, required: hasReportTypeBit((*ie.IE).HasUSAR)},
+		{typ: ie.ErrorIndicationReport, presence: conditional, required: hasReportTypeBit((*ie.IE).HasERIR)},
+		{typ: ie.LoadControlInformation, presence: optional},
+		{typ: ie.OverloadControlInformation, presence: optional},
+		{typ: ie.AdditionalUsageReportsInformation, presence: conditional},
+		{typ: ie.PFCPSRReqFlags, presence: conditional},
+		{typ: ie.FSEID, presence: conditional},
+		{typ: ie.PacketRateStatusReport, presence: conditional},
+		{typ: ie.PortManagementInformationForTSCWithinSessionReportRequest, presence: conditional},
+		{typ: ie.TSCManagementInformationWithinSessionReportRequest, presence: conditional},
+		{typ: ie.SessionReport, presence: conditional},
+		{typ: ie.TLContainer, presence: conditional},
+		{typ: ie.Cause, presence: conditional},
+	},
+	MsgTypeSessionReportResponse: {
+		{typ: ie.Cause, presence: mandatory},
+		{typ: ie.OffendingIE, presence: conditional, required: isRejectedByIE},
+		{typ: ie.UpdateBARWithinSessionReportResponse, presence: conditional},
+		{typ: ie.PFCPSRRspFlags, presence: conditional},
+		{typ: ie.FSEID, presence: conditional},
+		{typ: ie.FTEID, presence: conditional},
+		{typ: ie.AlternativeSMFIPAddress, presence: conditional},
+		{typ: ie.FQCSID, presence: conditional},
+		{typ: ie.GroupID, presence: conditional},
+		{typ: ie.NodeID, presence: conditional},
+	},
+}
+
+// validate checks the IEs in m against the rules for msgType.
+//
+// The IEs are collected from all the fields of m in *ie.IE or []*ie.IE,
+// including IEs. The mandatory IEs are checked first, then the conditional
+// ones, then the unexpected ones, so that the first problem found is the
+// most severe one.
+func validate(msgType uint8, m Message) error {
+	rules, ok := ieRules[msgType]
+	if !ok {
+		// nothing is known about the message.
+		return nil
+	}
+
+	ies := collectIEs(m)
+	for _, r := range rules {
+		if r.presence != mandatory {
+			continue
+		}
+		if !containsIE(ies, r.typ) {
+			return &ValidationError{MessageType: msgType, IEType: r.typ, Err: ErrMandatoryIEMissing}
+		}
+	}
+
+	for _, r := range rules {
+		if r.presence != conditional || r.required == nil {
+			continue
+		}
+		if r.required(ies) && !containsIE(ies, r.typ) {
+			return &ValidationError{MessageType: msgType, IEType: r.typ, Err: ErrConditionalIEMissing}
+		}
+	}
+
+	for _, i := range ies {
+		if i.IsVendorSpecific() {
+			continue
+		}
+		if !hasRule(rules, i.Type) {
+			return &ValidationError{MessageType: msgType, IEType: i.Type, Err: ErrUnexpectedIE}
+		}
+	}
+
+	return nil
+}
+
+var (
+	ieType      = reflect.TypeOf((*ie.IE)(nil))
+	ieSliceType = reflect.TypeOf([]*ie.IE(nil))
+)
+
+// collectIEs returns all the non-nil IEs held by the fields of m.
+func collectIEs(m Message) []*ie.IE {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return nil
+	}
+	v = v.Elem()
+
+	var ies []*ie.IE
+	for n := 0; n < v.NumField(); n++ {
+		f := v.Field(n)
+		switch f.Type() {
+		case ieType:
+			if i, _ := f.Interface().(*ie.IE); i != nil {
+				ies = append(ies, i)
+			}
+		case ieSliceType:
+			for _, i := range f.Interface().([]*ie.IE) {
+				if i != nil {
+					ies = append(ies, i)
+				}
+			}
+		}
+	}
+
+	return ies
+}
+
+func containsIE(ies []*ie.IE, t ie.IEType) bool {
+	return findIE(ies, t) != nil
+}
+
+func findIE(ies []*ie.IE, t ie.IEType) *ie.IE {
+	for _, i := range ies {
+		if i.Type == t {
+			return i
+		}
+	}
+	return nil
+}
+
+func hasRule(rules []ieRule, t ie.IEType) bool {
+	for _, r := range rules {
+		if r.typ == t {
+			return true
+		}
+	}
+	return false
+}
+
+// isRejectedByIE reports whether the Cause IE indicates that the request is
+// rejected due to a specific IE, in which case Offending IE is required.
+func isRejectedByIE(ies []*ie.IE) bool {
+	i := findIE(ies, ie.Cause)
+	if i == nil {
+		return false
+	}
+	c, err := i.Cause()
+	if err != nil {
+		return false
+	}
+
+	switch c {
+	case ie.CauseMandatoryIEMissing, ie.CauseConditionalIEMissing,
+		ie.CauseMandatoryIEIncorrect, ie.CauseInvalidLength:
+		return true
+	}
+	return false
+}
+
+// isAccepted reports whether the Cause IE indicates success.
+func isAccepted(ies []*ie.IE) bool {
+	i := findIE(ies, ie.Cause)
+	if i == nil {
+		return false
+	}
+	c, err := i.Cause()
+	if err != nil {
+		return false
+	}
+
+	return c == ie.CauseRequestAccepted
+}
+
+// isRuleCreationFailed reports whether the Cause IE indicates that a rule
+// could not be created or modified, in which case Failed Rule ID is required.
+func isRuleCreationFailed(ies []*ie.IE) bool {
+	i := findIE(ies, ie.Cause)
+	if i == nil {
+		return false
+	}
+	c, err := i.Cause()
+	if err != nil {
+		return false
+	}
+
+	return c == ie.CauseRuleCreationModificationFailure
+}
+
+// hasNodeReportTypeBit returns a function that reports whether the n-th bit
+// of the Node Report Type IE is set.
+func hasNodeReportTypeBit(n int) func(ies []*ie.IE) bool {
+	return func(ies []*ie.IE) bool {
+		i := findIE(ies, ie.NodeReportType)
+		if i == nil {
+			return false
+		}
+		v, err := i.NodeReportType()
+		if err != nil {
+			return false
+		}
+
+		return v&(1<<(n-1)) != 0
+	}
+}
+
+// hasReportTypeBit returns a function that reports whether the Report Type IE
+// has the flag checked by has.
+func hasReportTypeBit(has func(*ie.IE) bool) func(ies []*ie.IE) bool {
+	return func(ies []*ie.IE) bool {
+		i := findIE(ies, ie.ReportType)
+		if i == nil {
+			return false
+		}
+
+		return has(i)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+func TestValidate(t *testing.T) {
+	createPDR := ie.NewCreatePDR(
+		ie.NewPDRID(0xffff),
+		ie.NewPrecedence(0x11111111),
+		ie.NewPDI(ie.NewSourceInterface(ie.SrcInterfaceAccess)),
+		ie.NewFARID(0xffffffff),
+	)
+	createFAR := ie.NewCreateFAR(
+		ie.NewFARID(0xffffffff),
+		ie.NewApplyAction(0x04),
+	)
+
+	cases := []struct {
+		description string
+		msg         interface{ Validate() error }
+		err         error
+		cause       uint8
+		offending   ie.IEType
+	}{
+		{
+			description: "SessionEstablishmentRequest/Valid",
+			msg: message.NewSessionEstablishmentRequest(
+				mp, fo, seid, seq, pri,
+				ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+				ie.NewFSEID(0x1111111122222222, net.ParseIP("127.0.0.1"), nil),
+				createPDR, createFAR,
+			),
+		}, {
+			description: "SessionEstablishmentRequest/RATType",
+			msg: message.NewSessionEstablishmentRequest(
+				mp, fo, seid, seq, pri,
+				ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+				ie.NewFSEID(0x1111111122222222, net.ParseIP("127.0.0.1"), nil),
+				createPDR, createFAR,
+				ie.NewRATType(ie.RATTypeEutran),
+			),
+		}, {
+			description: "SessionEstablishmentRequest/MissingNodeID",
+			msg: message.NewSessionEstablishmentRequest(
+				mp, fo, seid, seq, pri,
+				ie.NewFSEID(0x1111111122222222, net.ParseIP("127.0.0.1"), nil),
+				createPDR, createFAR,
+			),
+			err:       message.ErrMandatoryIEMissing,
+			cause:     ie.CauseMandatoryIEMissing,
+			offending: ie.NodeID,
+		}, {
+			description: "SessionEstablishmentRequest/MissingCreateFAR",
+			msg: message.NewSessionEstablishmentRequest(
+				mp, fo, seid, seq, pri,
+				ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+				ie.NewFSEID(0x1111111122222222, net.ParseIP("127.0.0.1"), nil),
+				createPDR,
+			),
+			err:       message.ErrMandatoryIEMissing,
+			cause:     ie.CauseMandatoryIEMissing,
+			offending: ie.CreateFAR,
+		}, {
+			description: "NodeReportRequest/MissingUserPlanePathFailureReport",
+			msg: message.NewNodeReportRequest(
+				seq,
+				ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+				ie.NewNodeReportType(0x01),
+			),
+			err:       message.ErrConditionalIEMissing,
+			cause:     ie.CauseConditionalIEMissing,
+			offending: ie.UserPlanePathFailureReport,
+		}, {
+			description: "SessionReportRequest/MissingDownlinkDataReport",
+			msg: message.NewSessionReportRequest(
+				mp, fo, seid, seq, pri,
+				ie.NewReportType(0, 0, 0, 1),
+			),
+			err:       message.ErrConditionalIEMissing,
+			cause:     ie.CauseConditionalIEMissing,
+			offending: ie.DownlinkDataReport,
+		}, {
+			description: "NodeReportResponse/MissingOffendingIE",
+			msg: message.NewNodeReportResponse(
+				seq,
+				ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+				ie.NewCause(ie.CauseMandatoryIEMissing),
+				nil,
+			),
+			err:       message.ErrConditionalIEMissing,
+			cause:     ie.CauseConditionalIEMissing,
+			offending: ie.OffendingIE,
+		}, {
+			description: "SessionDeletionResponse/PFCPSDRspFlags",
+			msg: message.NewSessionDeletionResponse(
+				mp, fo, seid, seq, pri,
+				ie.NewCause(ie.CauseRequestAccepted),
+				ie.NewPFCPSDRspFlags(0x01),
+			),
+		}, {
+			description: "AssociationUpdateRequest/UserPlaneIPResourceInformation",
+			msg: message.NewAssociationUpdateRequest(
+				seq,
+				ie.NewNodeID("", "", "go-pfcp.epc.3gppnetwork.org"),
+				ie.NewUserPlaneIPResourceInformation(0x01, 0, "127.0.0.1", "", "", ie.SrcInterfaceAccess),
+			),
+		}, {
+			description: "HeartbeatRequest/Unexpected",
+			msg: message.NewHeartbeatRequest(
+				seq,
+				ie.NewRecoveryTimeStamp(time.Now()),
+				nil,
+				ie.NewApplicationID("go-pfcp"),
+			),
+			err:       message.ErrUnexpectedIE,
+			cause:     ie.CauseRequestRejected,
+			offending: ie.ApplicationID,
+		}, {
+			description: "HeartbeatRequest/VendorSpecific",
+			msg: message.NewHeartbeatRequest(
+				seq,
+				ie.NewRecoveryTimeStamp(time.Now()),
+				nil,
+				ie.NewVendorSpecificIE(32770, 1234, []byte{0xde, 0xad, 0xbe, 0xef}),
+			),
+		}, {
+			description: "Generic/MissingRecoveryTimeStamp",
+			msg: message.NewGenericWithoutSEID(
+				message.MsgTypeHeartbeatResponse, seq,
+			),
+			err:       message.ErrMandatoryIEMissing,
+			cause:     ie.CauseMandatoryIEMissing,
+			offending: ie.RecoveryTimeStamp,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			err := c.msg.Validate()
+			if c.err == nil {
+				if err != nil {
+					t.Fatalf("got unexpected error: %v", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, c.err) {
+				t.Fatalf("got %v, want %v", err, c.err)
+			}
+			var verr *message.ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("got %T, want *message.ValidationError", err)
+			}
+			if got, want := verr.Cause(), c.cause; got != want {
+				t.Errorf("got cause %d, want %d", got, want)
+			}
+			got, err := verr.OffendingIE().OffendingIE()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := c.offending; got != want {
+				t.Errorf("got offending IE %s, want %s", got, want)
+			}
+		})
+	}
+}
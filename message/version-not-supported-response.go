@@ -41,24 +41,23 @@ func (m *VersionNotSupportedResponse) Marshal() ([]byte, error) {
 
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (m *VersionNotSupportedResponse) MarshalTo(b []byte) error {
-	if m.Header.Payload != nil {
-		m.Header.Payload = nil
+	p, err := m.Header.payloadIn(b, m.MarshalLen())
+	if err != nil {
+		return err
 	}
-	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
 
 	offset := 0
 	for _, ie := range m.IEs {
 		if ie == nil {
 			continue
 		}
-		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+		if err := ie.MarshalTo(p[offset:]); err != nil {
 			return err
 		}
 		offset += ie.MarshalLen()
 	}
 
-	m.Header.SetLength()
-	return m.Header.MarshalTo(b)
+	return m.Header.marshalWithPayloadTo(b, len(p))
 }
 
 // ParseVersionNotSupportedResponse decodes a given byte sequence as a VersionNotSupportedResponse.
@@ -91,6 +90,11 @@ func (m *VersionNotSupportedResponse) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+// addIE appends i to IEs.
+func (m *VersionNotSupportedResponse) addIE(i *ie.IE) {
+	m.IEs = append(m.IEs, i)
+}
+
 // MarshalLen returns the serial length of Data.
 func (m *VersionNotSupportedResponse) MarshalLen() int {
 	l := m.Header.MarshalLen() - len(m.Header.Payload)
@@ -124,3 +128,24 @@ func (m *VersionNotSupportedResponse) SEID() uint64 {
 func (m *VersionNotSupportedResponse) IsRequest() bool {
 	return false
 }
+
+// Validate checks if the IEs in the message meet the presence requirements
+// defined in TS 29.244, and returns *ValidationError if not.
+func (m *VersionNotSupportedResponse) Validate() error {
+	return validate(MsgTypeVersionNotSupportedResponse, m)
+}
+
+// MarshalJSON returns the JSON encoding of a VersionNotSupportedResponse.
+func (m *VersionNotSupportedResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the JSON encoding of a VersionNotSupportedResponse.
+func (m *VersionNotSupportedResponse) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, MsgTypeVersionNotSupportedResponse, m)
+}
+
+// Clone returns a deep copy of a VersionNotSupportedResponse.
+func (m *VersionNotSupportedResponse) Clone() *VersionNotSupportedResponse {
+	return Clone(m).(*VersionNotSupportedResponse)
+}
@@ -0,0 +1,624 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/internal/logger"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/server"
+	"github.com/wmnsk/go-pfcp/transport"
+)
+
+// AssociationState is the state of a PFCP association with a peer.
+type AssociationState uint8
+
+// AssociationState definitions.
+const (
+	AssociationStateIdle AssociationState = iota
+	AssociationStateSettingUp
+	AssociationStateAssociated
+	AssociationStateReleasing
+	AssociationStateReleased
+)
+
+// String returns the name of the state.
+func (s AssociationState) String() string {
+	switch s {
+	case AssociationStateIdle:
+		return "Idle"
+	case AssociationStateSettingUp:
+		return "SettingUp"
+	case AssociationStateAssociated:
+		return "Associated"
+	case AssociationStateReleasing:
+		return "Releasing"
+	case AssociationStateReleased:
+		return "Released"
+	default:
+		return fmt.Sprintf("AssociationState(%d)", uint8(s))
+	}
+}
+
+// Role is the role of the local node in PFCP.
+type Role uint8
+
+// Role definitions.
+const (
+	RoleCP Role = iota + 1
+	RoleUP
+)
+
+// AssociationConfig is a set of parameters to configure AssociationManager.
+type AssociationConfig struct {
+	// Role is the role of the local node, which decides the IEs sent in the
+	// Association Setup messages.
+	Role Role
+	// NodeID is the NodeID IE of the local node. This is mandatory.
+	NodeID *ie.IE
+	// RecoveryTimeStamp is the time the local node has started. If zero, the
+	// one of Heartbeat is used if given, or the time AssociationManager is
+	// created otherwise.
+	RecoveryTimeStamp time.Time
+	// UPFunctionFeatures is sent to the peers if Role is RoleUP.
+	UPFunctionFeatures *ie.IE
+	// CPFunctionFeatures is sent to the peers if Role is RoleCP.
+	CPFunctionFeatures *ie.IE
+	// UserPlaneIPResourceInformation is sent to the peers if Role is RoleUP.
+	UserPlaneIPResourceInformation []*ie.IE
+	// SessionRetentionInformation is the PFCPSessionRetentionInformation IE
+	// sent to the peers if Role is RoleCP, which asks the UP functions to
+	// retain the existing sessions, e.g., after the local node restarts.
+	SessionRetentionInformation *ie.IE
+	// RetainSessions lets the UP function retain the sessions with a peer
+	// that sets up the association again if the peer asks it with
+	// PFCPSessionRetentionInformation IE. Otherwise, the sessions are torn
+	// down as the ones of the existing association.
+	RetainSessions bool
+
+	// Heartbeat, if given, starts sending Heartbeat Requests to the peers
+	// once associated, and stops it once released.
+	Heartbeat *HeartbeatManager
+
+	// Sessions, if given, is the SessionTable whose sessions with the peer
+	// are torn down when the association is released. The sessions are
+	// matched with the NodeID of the peer.
+	Sessions *SessionTable
+
+	// OnStateChange is called when the state of an association changes.
+	OnStateChange func(a *Association, prev, cur AssociationState)
+	// OnReleaseStart is called when the release of an association starts,
+	// after which no new sessions are accepted from the peer. period is the
+	// time given to the existing sessions to drain, if known.
+	OnReleaseStart func(a *Association, period time.Duration)
+	// OnReleaseCancel is called when the release started by PrepareRelease
+	// or RequestRelease is cancelled as the peer has not accepted the
+	// request, after which new sessions are accepted from the peer again.
+	OnReleaseCancel func(a *Association)
+	// OnDrained is called when the sessions with the peer have drained, or
+	// when the graceful release period has expired with remaining sessions.
+	OnDrained func(a *Association, remaining int)
+	// OnSessionTeardown is called for each session with the peer deleted from
+	// Sessions when the association is released.
+	OnSessionTeardown func(a *Association, s *Session)
+}
+
+// AssociationManager manages the PFCP associations of the local node with
+// its peers, as described in TS 29.244 clause 6.2.6 to 6.2.8.
+//
+// To handle the Association Setup, Update and Release Requests from the
+// peers, register it to the server with Register. Middleware can also be
+// used to reject the session-related requests from unassociated peers.
+type AssociationManager struct {
+	conn *transport.Conn
+	cfg  AssociationConfig
+	ts   time.Time
+
+	mu     sync.RWMutex
+	assocs map[string]*Association
+}
+
+// NewAssociationManager creates a new AssociationManager that sends requests
+// on conn. cfg.NodeID must not be nil.
+//
+// Serve must be running on conn to receive the responses.
+func NewAssociationManager(conn *transport.Conn, cfg *AssociationConfig) *AssociationManager {
+	m := &AssociationManager{
+		conn:   conn,
+		cfg:    *cfg,
+		ts:     cfg.RecoveryTimeStamp,
+		assocs: map[string]*Association{},
+	}
+
+	if m.ts.IsZero() {
+		if cfg.Heartbeat != nil {
+			m.ts = cfg.Heartbeat.RecoveryTimeStamp()
+		} else {
+			m.ts = time.Now()
+		}
+	}
+
+	return m
+}
+
+// Register registers the handlers of the association-related requests to s.
+func (m *AssociationManager) Register(s *server.Server) {
+	s.Handle(message.MsgTypeAssociationSetupRequest, server.Typed(m.handleSetup))
+	s.Handle(message.MsgTypeAssociationUpdateRequest, server.Typed(m.handleUpdate))
+	s.Handle(message.MsgTypeAssociationReleaseRequest, server.Typed(m.handleRelease))
+}
+
+// Middleware returns a server.Middleware that rejects the session-related
+// requests from the peers not associated with the local node with
+// CauseNoEstablishedPFCPAssociation.
+//
+// While the association is being released, the Session Establishment
+// Requests are rejected with CauseRequestRejected, and the other requests are
+// passed to let the existing sessions drain.
+func (m *AssociationManager) Middleware() server.Middleware {
+	return func(next server.Handler) server.Handler {
+		return server.HandlerFunc(func(w server.ResponseWriter, r *server.Request) {
+			if !isSessionRelated(r.Message) {
+				next.ServePFCP(w, r)
+				return
+			}
+
+			cause := ie.CauseNoEstablishedPFCPAssociation
+			if a, ok := m.Association(r.Peer); ok {
+				switch a.State() {
+				case AssociationStateAssociated:
+					next.ServePFCP(w, r)
+					return
+				case AssociationStateReleasing:
+					if r.Message.MessageType() != message.MsgTypeSessionEstablishmentRequest {
+						next.ServePFCP(w, r)
+						return
+					}
+					cause = ie.CauseRequestRejected
+				}
+			}
+
+			res := newSessionResponse(r.Message, cause, m.cfg.NodeID)
+			if res == nil {
+				logger.Logf("ignored %s from %s: no association established", r.Message.MessageTypeName(), r.Peer)
+				return
+			}
+			if err := w.Write(res); err != nil {
+				logger.Logf("failed to send %s to %s: %v", res.MessageTypeName(), r.Peer, err)
+			}
+		})
+	}
+}
+
+// Setup sends an Association Setup Request to peer and waits for the
+// response.
+//
+// It returns *RejectedError if the peer does not accept it, and the
+// association stays in AssociationStateIdle.
+func (m *AssociationManager) Setup(ctx context.Context, peer net.Addr) (*Association, error) {
+	a := m.association(peer)
+	prev, err := a.transition(AssociationStateSettingUp, AssociationStateIdle, AssociationStateReleased)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := m.conn.SendRequest(ctx, peer, message.NewAssociationSetupRequest(0, m.setupIEs()...))
+	if err != nil {
+		a.setState(prev)
+		return nil, err
+	}
+
+	res, ok := msg.(*message.AssociationSetupResponse)
+	if !ok {
+		a.setState(prev)
+		return nil, &UnexpectedMessageError{Message: msg}
+	}
+	if err := checkCause(res.MessageType(), res.Cause); err != nil {
+		a.setState(AssociationStateIdle)
+		return nil, err
+	}
+
+	a.store(res.NodeID, res.UPFunctionFeatures, res.CPFunctionFeatures, res.UserPlaneIPResourceInformation)
+	a.setRetained(res.PFCPASRspFlags != nil && res.PFCPASRspFlags.HasPSREI())
+	m.associated(a, res.RecoveryTimeStamp)
+	return a, nil
+}
+
+// Association returns the association with peer.
+func (m *AssociationManager) Association(peer net.Addr) (*Association, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	a, ok := m.assocs[peer.String()]
+	return a, ok
+}
+
+// Associations returns all the associations known to the manager, including
+// the ones not in AssociationStateAssociated.
+func (m *AssociationManager) Associations() []*Association {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	assocs := make([]*Association, 0, len(m.assocs))
+	for _, a := range m.assocs {
+		assocs = append(assocs, a)
+	}
+	return assocs
+}
+
+// IsAssociated reports whether the association with peer is established.
+func (m *AssociationManager) IsAssociated(peer net.Addr) bool {
+	a, ok := m.Association(peer)
+	return ok && a.State() == AssociationStateAssociated
+}
+
+// association returns the association with peer, creating it if it does not
+// exist.
+func (m *AssociationManager) association(peer net.Addr) *Association {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	a, ok := m.assocs[peer.String()]
+	if !ok {
+		a = &Association{m: m, peer: peer}
+		m.assocs[peer.String()] = a
+	}
+	return a
+}
+
+// setupIEs returns the IEs of the local node sent in the Association Setup
+// messages.
+func (m *AssociationManager) setupIEs() []*ie.IE {
+	ies := []*ie.IE{m.cfg.NodeID, ie.NewRecoveryTimeStamp(m.ts)}
+
+	switch m.cfg.Role {
+	case RoleCP:
+		ies = appendIEs(ies, m.cfg.CPFunctionFeatures, m.cfg.SessionRetentionInformation)
+	case RoleUP:
+		ies = appendIEs(ies, m.cfg.UPFunctionFeatures)
+		ies = appendIEs(ies, m.cfg.UserPlaneIPResourceInformation...)
+	}
+	return ies
+}
+
+// associated moves a to AssociationStateAssociated and starts Heartbeat.
+func (m *AssociationManager) associated(a *Association, recovery *ie.IE) {
+	var ts time.Time
+	if recovery != nil {
+		if t, err := recovery.RecoveryTimeStamp(); err == nil {
+			ts = t
+		}
+	}
+
+	a.mu.Lock()
+	a.recovery = ts
+	a.mu.Unlock()
+	a.setState(AssociationStateAssociated)
+
+	hb := m.cfg.Heartbeat
+	if hb == nil {
+		return
+	}
+	if !ts.IsZero() {
+		hb.ReportRecoveryTimeStamp(a.peer, ts)
+	}
+	hb.AddPeer(a.peer)
+}
+
+// released tears down the sessions with the peer, moves a to
+// AssociationStateReleased and stops Heartbeat.
+func (m *AssociationManager) released(a *Association) {
+	m.teardown(a)
+	a.setState(AssociationStateReleased)
+
+	if m.cfg.Heartbeat != nil {
+		m.cfg.Heartbeat.RemovePeer(a.peer)
+	}
+}
+
+func (m *AssociationManager) handleSetup(w server.ResponseWriter, r *server.Request, req *message.AssociationSetupRequest) {
+	var offending ie.IEType
+	switch {
+	case req.NodeID == nil:
+		offending = ie.NodeID
+	case req.RecoveryTimeStamp == nil:
+		offending = ie.RecoveryTimeStamp
+	}
+	if offending != 0 {
+		m.write(w, r, message.NewAssociationSetupResponse(0,
+			m.cfg.NodeID, ie.NewCause(ie.CauseMandatoryIEMissing), ie.NewOffendingIE(offending),
+		))
+		return
+	}
+
+	a := m.association(r.Peer)
+	ies := append([]*ie.IE{ie.NewCause(ie.CauseRequestAccepted)}, m.setupIEs()...)
+	if a.established() {
+		// the peer sets up the association again, overwriting the existing
+		// one, as described in TS 29.244 clause 6.2.6.2.2.
+		if m.retain(a, req.PFCPSessionRetentionInformation) {
+			ies = append(ies, ie.NewPFCPASRspFlags(0x01)) // PSREI
+		}
+	}
+
+	a.store(req.NodeID, req.UPFunctionFeatures, req.CPFunctionFeatures, req.UserPlaneIPResourceInformation)
+	m.write(w, r, message.NewAssociationSetupResponse(0, ies...))
+	m.associated(a, req.RecoveryTimeStamp)
+}
+
+func (m *AssociationManager) handleUpdate(w server.ResponseWriter, r *server.Request, req *message.AssociationUpdateRequest) {
+	a, ok := m.Association(r.Peer)
+	if !ok || !a.established() {
+		m.write(w, r, message.NewAssociationUpdateResponse(0,
+			m.cfg.NodeID, ie.NewCause(ie.CauseNoEstablishedPFCPAssociation),
+		))
+		return
+	}
+
+	a.store(req.NodeID, req.UPFunctionFeatures, req.CPFunctionFeatures, req.UserPlaneIPResourceInformation)
+	m.write(w, r, message.NewAssociationUpdateResponse(0,
+		m.cfg.NodeID, ie.NewCause(ie.CauseRequestAccepted),
+	))
+	m.handleReleaseIndication(a, req)
+}
+
+func (m *AssociationManager) handleRelease(w server.ResponseWriter, r *server.Request, req *message.AssociationReleaseRequest) {
+	a, ok := m.Association(r.Peer)
+	if !ok || !a.established() {
+		m.write(w, r, message.NewAssociationReleaseResponse(0,
+			m.cfg.NodeID, ie.NewCause(ie.CauseNoEstablishedPFCPAssociation),
+		))
+		return
+	}
+
+	a.setState(AssociationStateReleasing)
+	m.write(w, r, message.NewAssociationReleaseResponse(0,
+		m.cfg.NodeID, ie.NewCause(ie.CauseRequestAccepted),
+	))
+	m.released(a)
+}
+
+func (m *AssociationManager) write(w server.ResponseWriter, r *server.Request, res message.Message) {
+	if err := w.Write(res); err != nil {
+		logger.Logf("failed to send %s to %s: %v", res.MessageTypeName(), r.Peer, err)
+	}
+}
+
+// Association is a PFCP association with a peer, which holds the parameters
+// the peer has sent in the association-related messages.
+type Association struct {
+	m    *AssociationManager
+	peer net.Addr
+
+	mu            sync.RWMutex
+	state         AssociationState
+	nodeID        *ie.IE
+	recovery      time.Time
+	retained      bool
+	upFeatures    *ie.IE
+	cpFeatures    *ie.IE
+	upIPResources []*ie.IE
+}
+
+// Peer returns the address of the peer.
+func (a *Association) Peer() net.Addr {
+	return a.peer
+}
+
+// State returns the current state of the association.
+func (a *Association) State() AssociationState {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.state
+}
+
+// NodeID returns the NodeID IE of the peer.
+func (a *Association) NodeID() *ie.IE {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.nodeID
+}
+
+// RecoveryTimeStamp returns the RecoveryTimeStamp the peer has sent when the
+// association is set up, which is zero if unknown.
+func (a *Association) RecoveryTimeStamp() time.Time {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.recovery
+}
+
+// SessionsRetained reports whether the UP function has retained the existing
+// sessions when the association is set up by the local node with
+// SessionRetentionInformation.
+func (a *Association) SessionsRetained() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.retained
+}
+
+// UPFunctionFeatures returns the UPFunctionFeatures IE of the peer, which is
+// nil if the peer is not a UP function.
+func (a *Association) UPFunctionFeatures() *ie.IE {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.upFeatures
+}
+
+// CPFunctionFeatures returns the CPFunctionFeatures IE of the peer, which is
+// nil if the peer is not a CP function.
+func (a *Association) CPFunctionFeatures() *ie.IE {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.cpFeatures
+}
+
+// UserPlaneIPResourceInformation returns the UserPlaneIPResourceInformation
+// IEs of the peer.
+func (a *Association) UserPlaneIPResourceInformation() []*ie.IE {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.upIPResources
+}
+
+// Update sends an Association Update Request with ies to the peer and waits
+// for the response. The NodeID IE of the local node is added automatically.
+//
+// It returns *RejectedError if the peer does not accept it.
+func (a *Association) Update(ctx context.Context, ies ...*ie.IE) error {
+	if !a.established() {
+		return &InvalidStateError{State: a.State()}
+	}
+
+	req := message.NewAssociationUpdateRequest(0, append([]*ie.IE{a.m.cfg.NodeID}, ies...)...)
+	msg, err := a.m.conn.SendRequest(ctx, a.peer, req)
+	if err != nil {
+		return err
+	}
+
+	res, ok := msg.(*message.AssociationUpdateResponse)
+	if !ok {
+		return &UnexpectedMessageError{Message: msg}
+	}
+	if err := checkCause(res.MessageType(), res.Cause); err != nil {
+		return err
+	}
+
+	a.store(res.NodeID, res.UPFunctionFeatures, res.CPFunctionFeatures, nil)
+	return nil
+}
+
+// Release sends an Association Release Request to the peer immediately and
+// waits for the response. Use GracefulRelease to let the sessions drain
+// before that.
+//
+// It returns *RejectedError if the peer does not accept it, and the
+// association stays in the previous state.
+func (a *Association) Release(ctx context.Context) error {
+	prev, err := a.transition(AssociationStateReleasing, AssociationStateAssociated, AssociationStateReleasing)
+	if err != nil {
+		return err
+	}
+
+	msg, err := a.m.conn.SendRequest(ctx, a.peer, message.NewAssociationReleaseRequest(0, a.m.cfg.NodeID))
+	if err != nil {
+		a.setState(prev)
+		return err
+	}
+
+	res, ok := msg.(*message.AssociationReleaseResponse)
+	if !ok {
+		a.setState(prev)
+		return &UnexpectedMessageError{Message: msg}
+	}
+	if err := checkCause(res.MessageType(), res.Cause); err != nil {
+		a.setState(prev)
+		return err
+	}
+
+	a.m.released(a)
+	return nil
+}
+
+// established reports whether the association is established, including
+// the one being released.
+func (a *Association) established() bool {
+	s := a.State()
+	return s == AssociationStateAssociated || s == AssociationStateReleasing
+}
+
+// store updates the parameters of the peer with the non-nil ones.
+func (a *Association) store(nodeID, up, cp *ie.IE, upIPResources []*ie.IE) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if nodeID != nil {
+		a.nodeID = nodeID
+	}
+	if up != nil {
+		a.upFeatures = up
+	}
+	if cp != nil {
+		a.cpFeatures = cp
+	}
+	if upIPResources != nil {
+		a.upIPResources = upIPResources
+	}
+}
+
+func (a *Association) setRetained(retained bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.retained = retained
+}
+
+// transition moves the association to state if the current state is one of
+// from, and returns the previous state.
+func (a *Association) transition(state AssociationState, from ...AssociationState) (AssociationState, error) {
+	a.mu.Lock()
+	prev := a.state
+	valid := false
+	for _, s := range from {
+		if prev == s {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		a.mu.Unlock()
+		return prev, &InvalidStateError{State: prev}
+	}
+	a.state = state
+	a.mu.Unlock()
+
+	a.notify(prev, state)
+	return prev, nil
+}
+
+// setState moves the association to state unconditionally.
+func (a *Association) setState(state AssociationState) {
+	a.mu.Lock()
+	prev := a.state
+	a.state = state
+	a.mu.Unlock()
+
+	a.notify(prev, state)
+}
+
+func (a *Association) notify(prev, cur AssociationState) {
+	if prev != cur && a.m.cfg.OnStateChange != nil {
+		a.m.cfg.OnStateChange(a, prev, cur)
+	}
+}
+
+// checkCause returns *RejectedError if cause is not CauseRequestAccepted.
+func checkCause(msgType uint8, cause *ie.IE) error {
+	if cause == nil {
+		return &RejectedError{MessageType: msgType}
+	}
+	c, err := cause.Cause()
+	if err != nil {
+		return err
+	}
+	if c != ie.CauseRequestAccepted {
+		return &RejectedError{MessageType: msgType, Cause: c}
+	}
+	return nil
+}
+
+// appendIEs appends the non-nil IEs in ies to dst.
+func appendIEs(dst []*ie.IE, ies ...*ie.IE) []*ie.IE {
+	for _, i := range ies {
+		if i != nil {
+			dst = append(dst, i)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,166 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/node"
+	"github.com/wmnsk/go-pfcp/server"
+)
+
+func TestAssociationManager(t *testing.T) {
+	ctx := context.Background()
+
+	upSrv := server.New("", nil)
+	upConn := serve(t, upSrv)
+	up := node.NewAssociationManager(upConn, &node.AssociationConfig{
+		Role:               node.RoleUP,
+		NodeID:             ie.NewNodeID("127.0.0.2", "", ""),
+		UPFunctionFeatures: ie.NewUPFunctionFeatures(0x01, 0x02),
+		UserPlaneIPResourceInformation: []*ie.IE{
+			ie.NewUserPlaneIPResourceInformation(0x01, 0, "127.0.0.3", "", "", 0),
+		},
+	})
+	up.Register(upSrv)
+	upSrv.Use(up.Middleware())
+	upSrv.HandleFunc(message.MsgTypeSessionEstablishmentRequest, func(w server.ResponseWriter, r *server.Request) {
+		_ = w.Write(message.NewSessionEstablishmentResponse(0, 0, 0, 0, 0, ie.NewCause(ie.CauseRequestAccepted)))
+	})
+
+	states := make(chan node.AssociationState, 8)
+	cpSrv := server.New("", nil)
+	cpConn := serve(t, cpSrv)
+	cp := node.NewAssociationManager(cpConn, &node.AssociationConfig{
+		Role:               node.RoleCP,
+		NodeID:             ie.NewNodeID("127.0.0.1", "", ""),
+		CPFunctionFeatures: ie.NewCPFunctionFeatures(0x3f),
+		OnStateChange: func(a *node.Association, prev, cur node.AssociationState) {
+			states <- cur
+		},
+	})
+	cp.Register(cpSrv)
+
+	establish := func(t *testing.T) uint8 {
+		t.Helper()
+
+		req := message.NewSessionEstablishmentRequest(0, 0, 0, 0, 0,
+			ie.NewNodeID("127.0.0.1", "", ""),
+			ie.NewFSEID(1, net.ParseIP("127.0.0.1"), nil),
+		)
+		msg, err := cpConn.SendRequest(ctx, upConn.LocalAddr(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cause, err := msg.(*message.SessionEstablishmentResponse).Cause.Cause()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cause
+	}
+
+	t.Run("NotAssociated", func(t *testing.T) {
+		if got, want := establish(t), ie.CauseNoEstablishedPFCPAssociation; got != want {
+			t.Errorf("got %d want %d", got, want)
+		}
+	})
+
+	var a *node.Association
+	t.Run("Setup", func(t *testing.T) {
+		var err error
+		a, err = cp.Setup(ctx, upConn.LocalAddr())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, want := range []node.AssociationState{node.AssociationStateSettingUp, node.AssociationStateAssociated} {
+			if got := wait(t, states); got != want {
+				t.Errorf("got %s want %s", got, want)
+			}
+		}
+
+		if diff := cmp.Diff(a.NodeID(), ie.NewNodeID("127.0.0.2", "", "")); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff(a.UPFunctionFeatures(), ie.NewUPFunctionFeatures(0x01, 0x02)); diff != "" {
+			t.Error(diff)
+		}
+		if got := len(a.UserPlaneIPResourceInformation()); got != 1 {
+			t.Errorf("got %d UserPlaneIPResourceInformation, want 1", got)
+		}
+
+		peer, ok := up.Association(cpConn.LocalAddr())
+		if !ok {
+			t.Fatal("association not found on the UP side")
+		}
+		if got, want := peer.State(), node.AssociationStateAssociated; got != want {
+			t.Errorf("got %s want %s", got, want)
+		}
+		if diff := cmp.Diff(peer.CPFunctionFeatures(), ie.NewCPFunctionFeatures(0x3f)); diff != "" {
+			t.Error(diff)
+		}
+
+		if got, want := establish(t), ie.CauseRequestAccepted; got != want {
+			t.Errorf("got %d want %d", got, want)
+		}
+	})
+
+	t.Run("AlreadyAssociated", func(t *testing.T) {
+		var serr *node.InvalidStateError
+		if _, err := cp.Setup(ctx, upConn.LocalAddr()); !errors.As(err, &serr) {
+			t.Errorf("got %v want InvalidStateError", err)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		if err := a.Update(ctx, ie.NewCPFunctionFeatures(0x01)); err != nil {
+			t.Fatal(err)
+		}
+
+		peer, _ := up.Association(cpConn.LocalAddr())
+		if diff := cmp.Diff(peer.CPFunctionFeatures(), ie.NewCPFunctionFeatures(0x01)); diff != "" {
+			t.Error(diff)
+		}
+
+		// the UP function updates its resources.
+		res := ie.NewUserPlaneIPResourceInformation(0x01, 0, "127.0.0.4", "", "", 0)
+		if err := peer.Update(ctx, res); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(a.UserPlaneIPResourceInformation(), []*ie.IE{res}); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("Release", func(t *testing.T) {
+		if err := a.Release(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, want := range []node.AssociationState{node.AssociationStateReleasing, node.AssociationStateReleased} {
+			if got := wait(t, states); got != want {
+				t.Errorf("got %s want %s", got, want)
+			}
+		}
+		if up.IsAssociated(cpConn.LocalAddr()) {
+			t.Error("association is not released on the UP side")
+		}
+
+		var rerr *node.InvalidStateError
+		if err := a.Update(ctx); !errors.As(err, &rerr) {
+			t.Errorf("got %v want InvalidStateError", err)
+		}
+		if got, want := establish(t), ie.CauseNoEstablishedPFCPAssociation; got != want {
+			t.Errorf("got %d want %d", got, want)
+		}
+	})
+}
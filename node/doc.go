@@ -0,0 +1,8 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package node provides the node-level procedures of PFCP, such as Heartbeat
+// and the management of the PFCP associations, built on top of the transport
+// and server packages.
+package node
@@ -0,0 +1,64 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// Error definitions.
+var (
+	ErrThrottled               = errors.New("request throttled as the peer is overloaded")
+	ErrNoAlternativeSMFAddress = errors.New("no IP address in Alternative SMF IP Address")
+)
+
+// InvalidStateError indicates that a procedure is not allowed in the current
+// state of the association.
+type InvalidStateError struct {
+	State AssociationState
+}
+
+// Error returns message with the current state.
+func (e *InvalidStateError) Error() string {
+	return fmt.Sprintf("not allowed in association state %s", e.State)
+}
+
+// RejectedError indicates that a request has been rejected by the peer.
+//
+// Cause is 0 if the response does not contain Cause IE.
+type RejectedError struct {
+	MessageType uint8
+	Cause       uint8
+}
+
+// Error returns message with the type of response and the cause.
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("request rejected in message(Type=%d) with Cause=%d", e.MessageType, e.Cause)
+}
+
+// UnexpectedMessageError indicates that the response to a request is not of
+// the expected type.
+type UnexpectedMessageError struct {
+	Message message.Message
+}
+
+// Error returns message with the type of message received.
+func (e *UnexpectedMessageError) Error() string {
+	return fmt.Sprintf("got unexpected message: %s", e.Message.MessageTypeName())
+}
+
+// MissingIEError indicates that a mandatory IE is missing in a message.
+type MissingIEError struct {
+	Type ie.IEType
+}
+
+// Error returns message with the type of IE missing.
+func (e *MissingIEError) Error() string {
+	return fmt.Sprintf("mandatory IE missing: %s", e.Type)
+}
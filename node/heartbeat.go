@@ -0,0 +1,293 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/internal/logger"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/server"
+	"github.com/wmnsk/go-pfcp/transport"
+)
+
+// DefaultHeartbeatInterval is the default interval of Heartbeat Requests.
+const DefaultHeartbeatInterval = 10 * time.Second
+
+// HeartbeatConfig is a set of parameters to configure HeartbeatManager.
+type HeartbeatConfig struct {
+	// Interval is the interval of Heartbeat Requests sent to each peer.
+	Interval time.Duration
+	// RecoveryTimeStamp is the time the local node has started, which is sent
+	// to the peers. If zero, the time HeartbeatManager is created is used.
+	RecoveryTimeStamp time.Time
+
+	// OnPathFailure is called when a peer stops responding to Heartbeat
+	// Requests. It is not called again until the peer responds again.
+	OnPathFailure func(peer net.Addr, err error)
+	// OnPathRecovery is called when a peer responds again after path failure.
+	OnPathRecovery func(peer net.Addr)
+	// OnPeerRestart is called when a peer reports a RecoveryTimeStamp newer
+	// than the one previously reported, which means the peer has restarted.
+	OnPeerRestart func(peer net.Addr, prev, cur time.Time)
+}
+
+// HeartbeatManager sends Heartbeat Requests to the peers periodically and
+// answers the ones from the peers, as described in TS 29.244 clause 6.2.2.
+//
+// It also keeps track of the RecoveryTimeStamp each peer reports to detect
+// the restart of the peers. To answer the Heartbeat Requests, register it to
+// the server for message.MsgTypeHeartbeatRequest.
+type HeartbeatManager struct {
+	conn     *transport.Conn
+	interval time.Duration
+	ts       time.Time
+
+	onPathFailure  func(peer net.Addr, err error)
+	onPathRecovery func(peer net.Addr)
+	onPeerRestart  func(peer net.Addr, prev, cur time.Time)
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu    sync.Mutex
+	peers map[string]*heartbeatPeer
+}
+
+type heartbeatPeer struct {
+	recovery time.Time
+	failed   bool
+	stop     context.CancelFunc // nil if Heartbeat is not sent to the peer
+}
+
+// NewHeartbeatManager creates a new HeartbeatManager that sends Heartbeat
+// Requests on conn. cfg can be nil to use the default parameters.
+//
+// Serve must be running on conn to receive the responses.
+func NewHeartbeatManager(conn *transport.Conn, cfg *HeartbeatConfig) *HeartbeatManager {
+	m := &HeartbeatManager{
+		conn:     conn,
+		interval: DefaultHeartbeatInterval,
+		ts:       time.Now(),
+		peers:    map[string]*heartbeatPeer{},
+	}
+	m.ctx, m.cancel = context.WithCancel(context.Background())
+
+	if cfg != nil {
+		if cfg.Interval > 0 {
+			m.interval = cfg.Interval
+		}
+		if !cfg.RecoveryTimeStamp.IsZero() {
+			m.ts = cfg.RecoveryTimeStamp
+		}
+		m.onPathFailure = cfg.OnPathFailure
+		m.onPathRecovery = cfg.OnPathRecovery
+		m.onPeerRestart = cfg.OnPeerRestart
+	}
+
+	return m
+}
+
+// RecoveryTimeStamp returns the RecoveryTimeStamp of the local node.
+func (m *HeartbeatManager) RecoveryTimeStamp() time.Time {
+	return m.ts
+}
+
+// AddPeer starts sending Heartbeat Requests to peer periodically.
+//
+// Calling AddPeer for the peer already added does nothing.
+func (m *HeartbeatManager) AddPeer(peer net.Addr) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p := m.peer(peer)
+	if p.stop != nil {
+		return
+	}
+
+	var ctx context.Context
+	ctx, p.stop = context.WithCancel(m.ctx)
+	go m.run(ctx, peer)
+}
+
+// RemovePeer stops sending Heartbeat Requests to peer and forgets the
+// RecoveryTimeStamp reported by it.
+func (m *HeartbeatManager) RemovePeer(peer net.Addr) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if p, ok := m.peers[peer.String()]; ok {
+		if p.stop != nil {
+			p.stop()
+		}
+		delete(m.peers, peer.String())
+	}
+}
+
+// PeerRecoveryTimeStamp returns the RecoveryTimeStamp last reported by peer.
+func (m *HeartbeatManager) PeerRecoveryTimeStamp(peer net.Addr) (time.Time, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.peers[peer.String()]
+	if !ok || p.recovery.IsZero() {
+		return time.Time{}, false
+	}
+	return p.recovery, true
+}
+
+// ReportRecoveryTimeStamp records the RecoveryTimeStamp reported by peer,
+// and calls OnPeerRestart if it is newer than the previous one.
+//
+// This is called with the ones in Heartbeat messages by the HeartbeatManager
+// itself, and it can be called with the ones in other messages such as
+// Association Setup Request.
+func (m *HeartbeatManager) ReportRecoveryTimeStamp(peer net.Addr, ts time.Time) {
+	m.reportRecoveryTimeStamp(peer, ts, true)
+}
+
+// reportRecoveryTimeStamp is ReportRecoveryTimeStamp that ignores the peer not
+// known yet if create is false.
+func (m *HeartbeatManager) reportRecoveryTimeStamp(peer net.Addr, ts time.Time, create bool) {
+	m.mu.Lock()
+	p, ok := m.peers[peer.String()]
+	if !ok {
+		if !create {
+			m.mu.Unlock()
+			return
+		}
+		p = m.peer(peer)
+	}
+	prev := p.recovery
+	if !ts.After(prev) {
+		m.mu.Unlock()
+		return
+	}
+	p.recovery = ts
+	m.mu.Unlock()
+
+	if !prev.IsZero() && m.onPeerRestart != nil {
+		m.onPeerRestart(peer, prev, ts)
+	}
+}
+
+// Close stops sending Heartbeat Requests to all the peers.
+func (m *HeartbeatManager) Close() {
+	m.cancel()
+}
+
+// ServePFCP answers a Heartbeat Request with the RecoveryTimeStamp of the
+// local node.
+func (m *HeartbeatManager) ServePFCP(w server.ResponseWriter, r *server.Request) {
+	req, ok := r.Message.(*message.HeartbeatRequest)
+	if !ok {
+		logger.Logf("ignored %s from %s: not a Heartbeat Request", r.Message.MessageTypeName(), r.Peer)
+		return
+	}
+
+	if err := w.Write(message.NewHeartbeatResponse(0, ie.NewRecoveryTimeStamp(m.ts))); err != nil {
+		logger.Logf("failed to send Heartbeat Response to %s: %v", r.Peer, err)
+	}
+
+	if req.RecoveryTimeStamp == nil {
+		return
+	}
+	ts, err := req.RecoveryTimeStamp.RecoveryTimeStamp()
+	if err != nil {
+		logger.Logf("got Heartbeat Request with invalid RecoveryTimeStamp from %s: %v", r.Peer, err)
+		return
+	}
+	m.ReportRecoveryTimeStamp(r.Peer, ts)
+}
+
+// peer returns the state of peer, creating it if it does not exist.
+// m.mu must be held.
+func (m *HeartbeatManager) peer(peer net.Addr) *heartbeatPeer {
+	p, ok := m.peers[peer.String()]
+	if !ok {
+		p = &heartbeatPeer{}
+		m.peers[peer.String()] = p
+	}
+	return p
+}
+
+func (m *HeartbeatManager) run(ctx context.Context, peer net.Addr) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		m.heartbeat(ctx, peer)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (m *HeartbeatManager) heartbeat(ctx context.Context, peer net.Addr) {
+	req := message.NewHeartbeatRequest(0, ie.NewRecoveryTimeStamp(m.ts), nil)
+	msg, err := m.conn.SendRequest(ctx, peer, req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+
+		var terr *transport.TimeoutError
+		if !errors.As(err, &terr) {
+			logger.Logf("failed to send Heartbeat Request to %s: %v", peer, err)
+			return
+		}
+		m.setFailed(peer, true, err)
+		return
+	}
+	if !m.setFailed(peer, false, nil) {
+		return
+	}
+
+	res, ok := msg.(*message.HeartbeatResponse)
+	if !ok || res.RecoveryTimeStamp == nil {
+		logger.Logf("got unexpected response to Heartbeat Request from %s: %s", peer, msg.MessageTypeName())
+		return
+	}
+	ts, err := res.RecoveryTimeStamp.RecoveryTimeStamp()
+	if err != nil {
+		logger.Logf("got Heartbeat Response with invalid RecoveryTimeStamp from %s: %v", peer, err)
+		return
+	}
+	m.reportRecoveryTimeStamp(peer, ts, false)
+}
+
+// setFailed updates the path state of peer, and calls the callback if changed.
+// It returns false if peer has been removed, e.g., while the Heartbeat Request
+// was in flight.
+func (m *HeartbeatManager) setFailed(peer net.Addr, failed bool, err error) bool {
+	m.mu.Lock()
+	p, ok := m.peers[peer.String()]
+	if !ok {
+		m.mu.Unlock()
+		return false
+	}
+	changed := p.failed != failed
+	p.failed = failed
+	m.mu.Unlock()
+
+	if !changed {
+		return true
+	}
+	if failed && m.onPathFailure != nil {
+		m.onPathFailure(peer, err)
+	}
+	if !failed && m.onPathRecovery != nil {
+		m.onPathRecovery(peer)
+	}
+	return true
+}
@@ -0,0 +1,38 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestHeartbeatManagerRemovedPeer(t *testing.T) {
+	failed := false
+	m := NewHeartbeatManager(nil, &HeartbeatConfig{
+		OnPathFailure: func(peer net.Addr, err error) {
+			failed = true
+		},
+	})
+	peer := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 8805}
+
+	m.ReportRecoveryTimeStamp(peer, time.Now())
+	m.RemovePeer(peer)
+
+	// the results of the Heartbeat Request in flight when the peer is removed.
+	if m.setFailed(peer, true, errors.New("timed out")) {
+		t.Error("setFailed should report the peer has been removed")
+	}
+	m.reportRecoveryTimeStamp(peer, time.Now(), false)
+
+	if failed {
+		t.Error("OnPathFailure should not be called for the removed peer")
+	}
+	if n := len(m.peers); n != 0 {
+		t.Errorf("got %d peers, want 0", n)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/node"
+	"github.com/wmnsk/go-pfcp/server"
+	"github.com/wmnsk/go-pfcp/transport"
+	"github.com/wmnsk/go-pfcp/transport/memnet"
+)
+
+var (
+	cfg = &transport.Config{T1: 20 * time.Millisecond, N1: 1}
+	nw  = memnet.NewNetwork(nil)
+)
+
+// serve starts serving a Conn on nw with s until the test ends.
+func serve(t *testing.T, s *server.Server) *transport.Conn {
+	t.Helper()
+
+	pc, err := nw.Listen("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := transport.NewConn(pc, cfg)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() { _ = s.Serve(ctx, conn) }()
+
+	t.Cleanup(func() {
+		cancel()
+		_ = conn.Close()
+	})
+	return conn
+}
+
+// wait waits for a value from ch or fails the test after a while.
+func wait[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(time.Second):
+		t.Fatal("timed out")
+	}
+
+	var zero T
+	return zero
+}
+
+func TestHeartbeatManager(t *testing.T) {
+	var (
+		ts1 = time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+		ts2 = ts1.Add(time.Hour)
+	)
+
+	restarted := make(chan time.Time, 1)
+	failed := make(chan net.Addr, 1)
+	recovered := make(chan net.Addr, 1)
+
+	local := node.NewHeartbeatManager(serve(t, server.New("", nil)), &node.HeartbeatConfig{
+		Interval: 10 * time.Millisecond,
+		OnPeerRestart: func(peer net.Addr, prev, cur time.Time) {
+			restarted <- cur
+		},
+		OnPathFailure: func(peer net.Addr, err error) {
+			failed <- peer
+		},
+		OnPathRecovery: func(peer net.Addr) {
+			recovered <- peer
+		},
+	})
+	defer local.Close()
+
+	// the peer answers with ts1 first, and with ts2 after "restart".
+	peerSrv := server.New("", nil)
+	peer := serve(t, peerSrv)
+	peerSrv.Handle(message.MsgTypeHeartbeatRequest, node.NewHeartbeatManager(peer, &node.HeartbeatConfig{RecoveryTimeStamp: ts1}))
+
+	local.AddPeer(peer.LocalAddr())
+	deadline := time.Now().Add(time.Second)
+	for {
+		if ts, ok := local.PeerRecoveryTimeStamp(peer.LocalAddr()); ok {
+			if !ts.Equal(ts1) {
+				t.Fatalf("got %v want %v", ts, ts1)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("RecoveryTimeStamp of the peer has not been recorded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Run("PeerRestart", func(t *testing.T) {
+		peerSrv.Handle(message.MsgTypeHeartbeatRequest, node.NewHeartbeatManager(peer, &node.HeartbeatConfig{RecoveryTimeStamp: ts2}))
+		if got := wait(t, restarted); !got.Equal(ts2) {
+			t.Errorf("got %v want %v", got, ts2)
+		}
+	})
+
+	t.Run("PathFailure", func(t *testing.T) {
+		peerSrv.HandleFunc(message.MsgTypeHeartbeatRequest, func(w server.ResponseWriter, r *server.Request) {})
+		if got := wait(t, failed); got.String() != peer.LocalAddr().String() {
+			t.Errorf("got %v want %v", got, peer.LocalAddr())
+		}
+	})
+
+	t.Run("PathRecovery", func(t *testing.T) {
+		peerSrv.Handle(message.MsgTypeHeartbeatRequest, node.NewHeartbeatManager(peer, &node.HeartbeatConfig{RecoveryTimeStamp: ts2}))
+		if got := wait(t, recovered); got.String() != peer.LocalAddr().String() {
+			t.Errorf("got %v want %v", got, peer.LocalAddr())
+		}
+	})
+}
@@ -0,0 +1,360 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/internal/logger"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/server"
+	"github.com/wmnsk/go-pfcp/transport"
+)
+
+// LoadReporterConfig is a set of parameters to configure LoadReporter.
+type LoadReporterConfig struct {
+	// Load returns the current load of the local node in percentage, from 0
+	// to 100. If nil, Load Control Information is not reported.
+	Load func() uint8
+	// Overload returns the percentage of the traffic the peers are requested
+	// to reduce, from 0 to 100, and the period of validity of it. metric 0
+	// means the local node is not overloaded. If nil, Overload Control
+	// Information is not reported.
+	Overload func() (metric uint8, validity time.Duration)
+
+	// Associations, if given, is used to report Load Control Information
+	// and Overload Control Information only to the peers that have
+	// advertised LOAD and OVRL features respectively in CPFunctionFeatures
+	// IE, as required by TS 29.244 clause 6.2.6 and 6.2.7. If nil, they are
+	// reported to all the peers.
+	Associations *AssociationManager
+}
+
+// LoadReporter reports the load and overload of the local UP function to the
+// CP functions, as described in TS 29.244 clause 6.2.4 and 6.2.5.
+//
+// It attaches Load Control Information and Overload Control Information to
+// the outgoing messages only when the values have changed since the last
+// ones sent to the peer. Use Middleware to attach them to the responses, and
+// Attach for the Session Report Requests.
+type LoadReporter struct {
+	load     func() uint8
+	overload func() (uint8, time.Duration)
+	assocs   *AssociationManager
+
+	mu         sync.Mutex
+	loadSeq    uint32
+	loadMetric uint8
+	ovSeq      uint32
+	ovMetric   uint8
+	ovValidity time.Duration
+	lastSent   map[string]*reportedLoad
+}
+
+type reportedLoad struct {
+	loadSeq uint32
+	ovSeq   uint32
+}
+
+// NewLoadReporter creates a new LoadReporter.
+func NewLoadReporter(cfg *LoadReporterConfig) *LoadReporter {
+	return &LoadReporter{
+		load:     cfg.Load,
+		overload: cfg.Overload,
+		assocs:   cfg.Associations,
+		lastSent: map[string]*reportedLoad{},
+	}
+}
+
+// SessionLoad returns a function to be used as LoadReporterConfig.Load, which
+// computes the load from the number of sessions in t against capacity.
+func SessionLoad(t *SessionTable, capacity int) func() uint8 {
+	return func() uint8 {
+		if capacity <= 0 {
+			return 100
+		}
+		load := t.Len() * 100 / capacity
+		if load > 100 {
+			return 100
+		}
+		return uint8(load)
+	}
+}
+
+// Attach adds Load Control Information and Overload Control Information to
+// msg sent to peer if the values have changed since the last ones sent to
+// the peer, and if peer supports them when LoadReporterConfig.Associations
+// is given. msg should be a Session Establishment, Modification or Deletion
+// Response, or a Session Report Request, and any other message is left
+// untouched.
+func (r *LoadReporter) Attach(peer net.Addr, msg message.Message) {
+	var lci, oci **ie.IE
+	switch m := msg.(type) {
+	case *message.SessionEstablishmentResponse:
+		lci, oci = &m.LoadControlInformation, &m.OverloadControlInformation
+	case *message.SessionModificationResponse:
+		lci, oci = &m.LoadControlInformation, &m.OverloadControlInformation
+	case *message.SessionDeletionResponse:
+		lci, oci = &m.LoadControlInformation, &m.OverloadControlInformation
+	case *message.SessionReportRequest:
+		lci, oci = &m.LoadControlInformation, &m.OverloadControlInformation
+	default:
+		return
+	}
+
+	supportsLoad, supportsOverload := r.supports(peer)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.update()
+
+	last, ok := r.lastSent[peer.String()]
+	if !ok {
+		last = &reportedLoad{}
+		r.lastSent[peer.String()] = last
+	}
+
+	if r.load != nil && supportsLoad && last.loadSeq != r.loadSeq {
+		*lci = ie.NewLoadControlInformation(
+			ie.NewSequenceNumber(r.loadSeq),
+			ie.NewMetric(r.loadMetric),
+		)
+		last.loadSeq = r.loadSeq
+	}
+
+	// the peer that has never been told about overload does not need to be
+	// told that the local node is not overloaded.
+	if r.overload != nil && supportsOverload && last.ovSeq != r.ovSeq && (last.ovSeq != 0 || r.ovMetric != 0) {
+		*oci = ie.NewOverloadControlInformation(
+			ie.NewSequenceNumber(r.ovSeq),
+			ie.NewMetric(r.ovMetric),
+			ie.NewTimer(r.ovValidity),
+			ie.NewOCIFlags(0),
+		)
+		last.ovSeq = r.ovSeq
+	}
+}
+
+// Middleware returns a server.Middleware that attaches Load Control
+// Information and Overload Control Information to the responses.
+func (r *LoadReporter) Middleware() server.Middleware {
+	return func(next server.Handler) server.Handler {
+		return server.HandlerFunc(func(w server.ResponseWriter, req *server.Request) {
+			next.ServePFCP(server.ResponseWriterFunc(func(res message.Message) error {
+				r.Attach(req.Peer, res)
+				return w.Write(res)
+			}), req)
+		})
+	}
+}
+
+// supports reports whether peer supports Load Control and Overload Control,
+// by LOAD and OVRL features in CPFunctionFeatures IE of the association.
+// Both are true if r has no AssociationManager.
+func (r *LoadReporter) supports(peer net.Addr) (load, overload bool) {
+	if r.assocs == nil {
+		return true, true
+	}
+
+	a, ok := r.assocs.Association(peer)
+	if !ok {
+		return false, false
+	}
+	f := a.CPFunctionFeatures()
+	if f == nil {
+		return false, false
+	}
+	return f.HasLOAD(), f.HasOVRL()
+}
+
+// update takes the current values and increments the sequence numbers if
+// they have changed. r.mu must be held.
+func (r *LoadReporter) update() {
+	if r.load != nil {
+		if m := r.load(); m != r.loadMetric || r.loadSeq == 0 {
+			r.loadMetric = m
+			r.loadSeq++
+		}
+	}
+
+	if r.overload != nil {
+		m, v := r.overload()
+		if m != r.ovMetric || v != r.ovValidity || r.ovSeq == 0 {
+			r.ovMetric, r.ovValidity = m, v
+			r.ovSeq++
+		}
+	}
+}
+
+// LoadTracker keeps the load and overload reported by the UP functions, and
+// throttles the new sessions towards the overloaded ones, as described in
+// TS 29.244 clause 6.2.4 and 6.2.5. This is used by a CP function.
+//
+// The values are taken from the messages passed to Observe, which is called
+// automatically by SendRequest and Middleware.
+type LoadTracker struct {
+	mu    sync.Mutex
+	peers map[string]*peerLoad
+}
+
+type peerLoad struct {
+	loadSeq   uint32
+	load      uint8
+	ovSeq     uint32
+	reduction uint8
+	until     time.Time
+
+	// the number of new sessions requested and admitted during overload.
+	requested, admitted int
+}
+
+// NewLoadTracker creates a new LoadTracker.
+func NewLoadTracker() *LoadTracker {
+	return &LoadTracker{peers: map[string]*peerLoad{}}
+}
+
+// Observe records Load Control Information and Overload Control Information
+// in msg received from peer, if the sequence numbers are newer than the ones
+// previously recorded.
+func (t *LoadTracker) Observe(peer net.Addr, msg message.Message) {
+	var lci, oci *ie.IE
+	switch m := msg.(type) {
+	case *message.SessionEstablishmentResponse:
+		lci, oci = m.LoadControlInformation, m.OverloadControlInformation
+	case *message.SessionModificationResponse:
+		lci, oci = m.LoadControlInformation, m.OverloadControlInformation
+	case *message.SessionDeletionResponse:
+		lci, oci = m.LoadControlInformation, m.OverloadControlInformation
+	case *message.SessionReportRequest:
+		lci, oci = m.LoadControlInformation, m.OverloadControlInformation
+	default:
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.peers[peer.String()]
+	if !ok {
+		p = &peerLoad{}
+		t.peers[peer.String()] = p
+	}
+
+	if lci != nil {
+		f, err := lci.LoadControlInformation()
+		if err != nil {
+			logger.Logf("got invalid Load Control Information from %s: %v", peer, err)
+		} else if f.Sequence > p.loadSeq {
+			p.loadSeq, p.load = f.Sequence, f.Metric
+		}
+	}
+
+	if oci != nil {
+		f, err := oci.OverloadControlInformation()
+		if err != nil {
+			logger.Logf("got invalid Overload Control Information from %s: %v", peer, err)
+		} else if f.Sequence > p.ovSeq {
+			p.ovSeq, p.reduction = f.Sequence, f.Metric
+			p.until = time.Now().Add(f.PeriodOfValidity)
+			p.requested, p.admitted = 0, 0
+		}
+	}
+}
+
+// Forget discards the load and overload recorded for peer.
+//
+// This should be called when peer has restarted, as the sequence numbers it
+// sends start over and the new values would be ignored otherwise, e.g., with
+// HeartbeatConfig.OnPeerRestart:
+//
+//	OnPeerRestart: func(peer net.Addr, prev, cur time.Time) {
+//		tracker.Forget(peer)
+//	},
+func (t *LoadTracker) Forget(peer net.Addr) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.peers, peer.String())
+}
+
+// Load returns the load last reported by peer.
+func (t *LoadTracker) Load(peer net.Addr) (uint8, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.peers[peer.String()]
+	if !ok || p.loadSeq == 0 {
+		return 0, false
+	}
+	return p.load, true
+}
+
+// Overload returns the reduction metric requested by peer and the time until
+// when it is valid. ok is false if peer is not overloaded.
+func (t *LoadTracker) Overload(peer net.Addr) (reduction uint8, until time.Time, ok bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, found := t.peers[peer.String()]
+	if !found || p.reduction == 0 || !time.Now().Before(p.until) {
+		return 0, time.Time{}, false
+	}
+	return p.reduction, p.until, true
+}
+
+// Allow reports whether a new session can be requested to peer.
+//
+// While peer is overloaded, the new sessions are throttled so that the
+// percentage of the ones allowed is (100 - reduction metric).
+func (t *LoadTracker) Allow(peer net.Addr) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.peers[peer.String()]
+	if !ok || p.reduction == 0 || !time.Now().Before(p.until) {
+		return true
+	}
+
+	p.requested++
+	if p.admitted*100 >= p.requested*(100-int(p.reduction)) {
+		return false
+	}
+	p.admitted++
+	return true
+}
+
+// SendRequest sends req to peer on conn and records the values in the
+// response.
+//
+// If req is a Session Establishment Request and peer is overloaded, it may
+// return ErrThrottled without sending it.
+func (t *LoadTracker) SendRequest(ctx context.Context, conn *transport.Conn, peer net.Addr, req message.Message) (message.Message, error) {
+	if req.MessageType() == message.MsgTypeSessionEstablishmentRequest && !t.Allow(peer) {
+		return nil, ErrThrottled
+	}
+
+	res, err := conn.SendRequest(ctx, peer, req)
+	if err != nil {
+		return nil, err
+	}
+	t.Observe(peer, res)
+	return res, nil
+}
+
+// Middleware returns a server.Middleware that records the values in the
+// incoming requests, i.e., Session Report Requests.
+func (t *LoadTracker) Middleware() server.Middleware {
+	return func(next server.Handler) server.Handler {
+		return server.HandlerFunc(func(w server.ResponseWriter, r *server.Request) {
+			t.Observe(r.Peer, r.Message)
+			next.ServePFCP(w, r)
+		})
+	}
+}
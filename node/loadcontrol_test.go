@@ -0,0 +1,206 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/node"
+	"github.com/wmnsk/go-pfcp/server"
+)
+
+func TestLoadReporter(t *testing.T) {
+	var (
+		load     uint8 = 10
+		overload uint8
+	)
+	r := node.NewLoadReporter(&node.LoadReporterConfig{
+		Load: func() uint8 { return load },
+		Overload: func() (uint8, time.Duration) {
+			return overload, time.Minute
+		},
+	})
+
+	attach := func() *message.SessionEstablishmentResponse {
+		res := message.NewSessionEstablishmentResponse(0, 0, 0, 0, 0, ie.NewCause(ie.CauseRequestAccepted))
+		r.Attach(peerAddr, res)
+		return res
+	}
+
+	res := attach()
+	if res.LoadControlInformation == nil {
+		t.Fatal("Load Control Information is not attached first")
+	}
+	if res.OverloadControlInformation != nil {
+		t.Error("Overload Control Information is attached while not overloaded")
+	}
+
+	if res := attach(); res.LoadControlInformation != nil {
+		t.Error("Load Control Information is attached without change")
+	}
+
+	load = 20
+	res = attach()
+	if res.LoadControlInformation == nil {
+		t.Fatal("Load Control Information is not attached after change")
+	}
+	f, err := res.LoadControlInformation.LoadControlInformation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.Metric, load; got != want {
+		t.Errorf("got %d want %d", got, want)
+	}
+	if got, want := f.Sequence, uint32(2); got != want {
+		t.Errorf("got %d want %d", got, want)
+	}
+
+	overload = 50
+	res = attach()
+	if res.OverloadControlInformation == nil {
+		t.Fatal("Overload Control Information is not attached after overload")
+	}
+	o, err := res.OverloadControlInformation.OverloadControlInformation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := o.Metric, overload; got != want {
+		t.Errorf("got %d want %d", got, want)
+	}
+	if got, want := o.PeriodOfValidity, time.Minute; got != want {
+		t.Errorf("got %s want %s", got, want)
+	}
+
+	overload = 0
+	if res := attach(); res.OverloadControlInformation == nil {
+		t.Error("Overload Control Information is not attached after recovery")
+	}
+}
+
+func TestLoadReporterFeatures(t *testing.T) {
+	upSrv := server.New("", nil)
+	upConn, cpConn := serve(t, upSrv), serve(t, server.New("", nil))
+	upMgr := node.NewAssociationManager(upConn, &node.AssociationConfig{
+		Role: node.RoleUP, NodeID: ie.NewNodeID("127.0.0.2", "", ""),
+	})
+	upMgr.Register(upSrv)
+	cpMgr := node.NewAssociationManager(cpConn, &node.AssociationConfig{
+		Role: node.RoleCP, NodeID: ie.NewNodeID("127.0.0.1", "", ""),
+		// LOAD only.
+		CPFunctionFeatures: ie.NewCPFunctionFeatures(0x01),
+	})
+	if _, err := cpMgr.Setup(context.Background(), upConn.LocalAddr()); err != nil {
+		t.Fatal(err)
+	}
+
+	r := node.NewLoadReporter(&node.LoadReporterConfig{
+		Load:         func() uint8 { return 10 },
+		Overload:     func() (uint8, time.Duration) { return 50, time.Minute },
+		Associations: upMgr,
+	})
+
+	res := message.NewSessionEstablishmentResponse(0, 0, 0, 0, 0, ie.NewCause(ie.CauseRequestAccepted))
+	r.Attach(cpConn.LocalAddr(), res)
+	if res.LoadControlInformation == nil {
+		t.Error("Load Control Information is not attached to the peer with LOAD")
+	}
+	if res.OverloadControlInformation != nil {
+		t.Error("Overload Control Information is attached to the peer without OVRL")
+	}
+
+	res = message.NewSessionEstablishmentResponse(0, 0, 0, 0, 0, ie.NewCause(ie.CauseRequestAccepted))
+	r.Attach(peerAddr, res)
+	if res.LoadControlInformation != nil || res.OverloadControlInformation != nil {
+		t.Error("Load or Overload Control Information is attached to the unassociated peer")
+	}
+}
+
+func TestLoadTracker(t *testing.T) {
+	newResponse := func(seq uint32, load, reduction uint8) *message.SessionEstablishmentResponse {
+		return message.NewSessionEstablishmentResponse(0, 0, 0, 0, 0,
+			ie.NewCause(ie.CauseRequestAccepted),
+			ie.NewLoadControlInformation(ie.NewSequenceNumber(seq), ie.NewMetric(load)),
+			ie.NewOverloadControlInformation(
+				ie.NewSequenceNumber(seq),
+				ie.NewMetric(reduction),
+				ie.NewTimer(time.Minute),
+				ie.NewOCIFlags(0),
+			),
+		)
+	}
+
+	tr := node.NewLoadTracker()
+	tr.Observe(peerAddr, newResponse(2, 30, 75))
+	// older values should be ignored.
+	tr.Observe(peerAddr, newResponse(1, 10, 0))
+
+	if got, ok := tr.Load(peerAddr); !ok || got != 30 {
+		t.Errorf("got %d want %d", got, 30)
+	}
+	if got, _, ok := tr.Overload(peerAddr); !ok || got != 75 {
+		t.Errorf("got %d want %d", got, 75)
+	}
+
+	allowed := 0
+	for range 100 {
+		if tr.Allow(peerAddr) {
+			allowed++
+		}
+	}
+	if got, want := allowed, 25; got != want {
+		t.Errorf("got %d allowed, want %d", got, want)
+	}
+
+	tr.Observe(peerAddr, newResponse(3, 30, 0))
+	if _, _, ok := tr.Overload(peerAddr); ok {
+		t.Error("peer is still overloaded")
+	}
+	if !tr.Allow(peerAddr) {
+		t.Error("not allowed after recovery")
+	}
+
+	// the sequence numbers start over after the peer restarts.
+	tr.Forget(peerAddr)
+	tr.Observe(peerAddr, newResponse(1, 50, 10))
+	if got, ok := tr.Load(peerAddr); !ok || got != 50 {
+		t.Errorf("got %d want %d", got, 50)
+	}
+	if got, _, ok := tr.Overload(peerAddr); !ok || got != 10 {
+		t.Errorf("got %d want %d", got, 10)
+	}
+}
+
+func TestLoadControl(t *testing.T) {
+	up := server.New("", nil)
+	up.Use(node.NewLoadReporter(&node.LoadReporterConfig{
+		Overload: func() (uint8, time.Duration) { return 100, time.Minute },
+	}).Middleware())
+	up.HandleFunc(message.MsgTypeSessionEstablishmentRequest, func(w server.ResponseWriter, r *server.Request) {
+		_ = w.Write(message.NewSessionEstablishmentResponse(0, 0, 0, 0, 0, ie.NewCause(ie.CauseRequestAccepted)))
+	})
+	upConn := serve(t, up)
+	cpConn := serve(t, server.New("", nil))
+
+	tr := node.NewLoadTracker()
+	send := func() error {
+		req := message.NewSessionEstablishmentRequest(0, 0, 0, 0, 0,
+			ie.NewNodeID("127.0.0.1", "", ""),
+		)
+		_, err := tr.SendRequest(context.Background(), cpConn, upConn.LocalAddr(), req)
+		return err
+	}
+
+	if err := send(); err != nil {
+		t.Fatal(err)
+	}
+	if err := send(); !errors.Is(err, node.ErrThrottled) {
+		t.Errorf("got unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/internal/logger"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// drainCheckInterval is the interval to check if the sessions with the peer
+// have drained during the graceful release.
+const drainCheckInterval = 100 * time.Millisecond
+
+// AcceptsNewSessions reports whether new sessions can be established with the
+// peer, which is false once the release of the association has started.
+func (a *Association) AcceptsNewSessions() bool {
+	return a.State() == AssociationStateAssociated
+}
+
+// GracefulRelease releases the association gracefully, as described in
+// TS 29.244 clause 6.2.8.
+//
+// It stops accepting new sessions from the peer first, and waits for the
+// existing sessions in AssociationConfig.Sessions to drain for period at
+// most. Then it sends an Association Release Request to the peer and tears
+// down the remaining sessions. The callbacks in AssociationConfig are called
+// at each stage.
+//
+// If ctx is done while draining, it returns ctx.Err() and the association
+// stays in AssociationStateReleasing.
+func (a *Association) GracefulRelease(ctx context.Context, period time.Duration) error {
+	if err := a.m.startRelease(a, period); err != nil {
+		return err
+	}
+
+	remaining, err := a.m.drain(ctx, a, period)
+	if err != nil {
+		return err
+	}
+	if a.m.cfg.OnDrained != nil {
+		a.m.cfg.OnDrained(a, remaining)
+	}
+
+	return a.Release(ctx)
+}
+
+// PrepareRelease notifies the peer that the local node is preparing for the
+// release of the association, by sending an Association Update Request with
+// the PARPS flag. This is used by a UP function.
+//
+// New sessions are not accepted from the peer afterwards.
+func (a *Association) PrepareRelease(ctx context.Context) error {
+	return a.requestRelease(ctx, 0, ie.NewPFCPAUReqFlags(0x01))
+}
+
+// RequestRelease requests the peer to release the association after period,
+// by sending an Association Update Request with the SARR flag. This is used
+// by a UP function, and the CP function is expected to release the
+// association with GracefulRelease.
+//
+// New sessions are not accepted from the peer afterwards.
+func (a *Association) RequestRelease(ctx context.Context, period time.Duration) error {
+	return a.requestRelease(ctx, period,
+		ie.NewPFCPAssociationReleaseRequest(1, 0),
+		ie.NewGracefulReleasePeriod(period),
+	)
+}
+
+// requestRelease starts the release locally and sends an Association Update
+// Request with ies. The release is started before sending the request, as
+// the peer may release the association before the response arrives, and it
+// is cancelled if the request fails.
+func (a *Association) requestRelease(ctx context.Context, period time.Duration, ies ...*ie.IE) error {
+	prev := a.State()
+	if err := a.m.startRelease(a, period); err != nil {
+		return err
+	}
+
+	if err := a.Update(ctx, ies...); err != nil {
+		if prev == AssociationStateAssociated {
+			a.m.cancelRelease(a)
+		}
+		return err
+	}
+	return nil
+}
+
+// handleReleaseIndication starts the release of the association if the
+// Association Update Request from the peer requests it with the SARR flag,
+// or stops accepting new sessions if it has the PARPS flag.
+func (m *AssociationManager) handleReleaseIndication(a *Association, req *message.AssociationUpdateRequest) {
+	var period time.Duration
+	if req.GracefulReleasePeriod != nil {
+		p, err := req.GracefulReleasePeriod.GracefulReleasePeriod()
+		if err != nil {
+			logger.Logf("got Association Update Request with invalid GracefulReleasePeriod from %s: %v", a.peer, err)
+		}
+		period = p
+	}
+
+	switch {
+	case req.PFCPAssociationReleaseRequest != nil && req.PFCPAssociationReleaseRequest.HasSARR():
+		go func() {
+			if err := a.GracefulRelease(context.Background(), period); err != nil {
+				logger.Logf("failed to release the association with %s: %v", a.peer, err)
+			}
+		}()
+	case req.PFCPAUReqFlags != nil && req.PFCPAUReqFlags.HasPARPS():
+		if err := m.startRelease(a, period); err != nil {
+			logger.Logf("failed to prepare for the release of the association with %s: %v", a.peer, err)
+		}
+	}
+}
+
+// startRelease moves a to AssociationStateReleasing and calls OnReleaseStart
+// if it has not been released yet.
+func (m *AssociationManager) startRelease(a *Association, period time.Duration) error {
+	prev, err := a.transition(AssociationStateReleasing, AssociationStateAssociated, AssociationStateReleasing)
+	if err != nil {
+		return err
+	}
+
+	if prev == AssociationStateAssociated && m.cfg.OnReleaseStart != nil {
+		m.cfg.OnReleaseStart(a, period)
+	}
+	return nil
+}
+
+// cancelRelease moves a back to AssociationStateAssociated and calls
+// OnReleaseCancel if it is still in AssociationStateReleasing.
+func (m *AssociationManager) cancelRelease(a *Association) {
+	if _, err := a.transition(AssociationStateAssociated, AssociationStateReleasing); err != nil {
+		return
+	}
+
+	if m.cfg.OnReleaseCancel != nil {
+		m.cfg.OnReleaseCancel(a)
+	}
+}
+
+// drain waits for the sessions with the peer of a to drain for period at
+// most, and returns the number of the sessions remaining.
+func (m *AssociationManager) drain(ctx context.Context, a *Association, period time.Duration) (int, error) {
+	timer := time.NewTimer(period)
+	defer timer.Stop()
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		n := m.countSessions(a)
+		if n == 0 {
+			return 0, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-timer.C:
+			return m.countSessions(a), nil
+		case <-ctx.Done():
+			return n, ctx.Err()
+		}
+	}
+}
+
+// countSessions returns the number of sessions with the peer of a.
+func (m *AssociationManager) countSessions(a *Association) int {
+	if m.cfg.Sessions == nil {
+		return 0
+	}
+
+	n := 0
+	m.cfg.Sessions.RangeByNodeID(a.peerNodeID(), func(*Session) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+// teardown deletes all the sessions with the peer of a.
+func (m *AssociationManager) teardown(a *Association) {
+	m.teardownIf(a, func(*Session) bool { return true })
+}
+
+// teardownIf deletes the sessions with the peer of a for which fn returns
+// true.
+func (m *AssociationManager) teardownIf(a *Association, fn func(s *Session) bool) {
+	if m.cfg.Sessions == nil {
+		return
+	}
+
+	m.cfg.Sessions.RangeByNodeID(a.peerNodeID(), func(s *Session) bool {
+		if !fn(s) {
+			return true
+		}
+		if _, ok := m.cfg.Sessions.Delete(s.LocalSEID()); ok && m.cfg.OnSessionTeardown != nil {
+			m.cfg.OnSessionTeardown(a, s)
+		}
+		return true
+	})
+}
+
+// peerNodeID returns the NodeID of the peer in string, which is empty if the
+// peer has not sent a valid one.
+func (a *Association) peerNodeID() string {
+	i := a.NodeID()
+	if i == nil {
+		return ""
+	}
+
+	id, err := i.NodeID()
+	if err != nil {
+		return ""
+	}
+	return id
+}
@@ -0,0 +1,164 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/node"
+	"github.com/wmnsk/go-pfcp/server"
+)
+
+// associate sets up an association between the CP and UP functions with the
+// configurations given, and returns the association on each side.
+func associate(t *testing.T, cpCfg, upCfg *node.AssociationConfig) (cp, up *node.Association) {
+	t.Helper()
+
+	cpCfg.Role, cpCfg.NodeID = node.RoleCP, ie.NewNodeID("127.0.0.1", "", "")
+	upCfg.Role, upCfg.NodeID = node.RoleUP, ie.NewNodeID("127.0.0.2", "", "")
+
+	cpSrv, upSrv := server.New("", nil), server.New("", nil)
+	cpConn, upConn := serve(t, cpSrv), serve(t, upSrv)
+	cpMgr := node.NewAssociationManager(cpConn, cpCfg)
+	upMgr := node.NewAssociationManager(upConn, upCfg)
+	cpMgr.Register(cpSrv)
+	upMgr.Register(upSrv)
+
+	cp, err := cpMgr.Setup(context.Background(), upConn.LocalAddr())
+	if err != nil {
+		t.Fatal(err)
+	}
+	up, ok := upMgr.Association(cpConn.LocalAddr())
+	if !ok {
+		t.Fatal("association not found on the UP side")
+	}
+	return cp, up
+}
+
+// waitState waits for a to be in state or fails the test after a while.
+func waitState(t *testing.T, a *node.Association, state node.AssociationState) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for a.State() != state {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %s want %s", a.State(), state)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestGracefulRelease(t *testing.T) {
+	t.Run("Drain", func(t *testing.T) {
+		stages := make(chan string, 8)
+		cpSessions, upSessions := node.NewSessionTable(), node.NewSessionTable()
+
+		cp, up := associate(t, &node.AssociationConfig{
+			Sessions: cpSessions,
+			OnReleaseStart: func(a *node.Association, period time.Duration) {
+				stages <- fmt.Sprintf("start %s", period)
+			},
+			OnDrained: func(a *node.Association, remaining int) {
+				stages <- fmt.Sprintf("drained %d", remaining)
+			},
+			OnSessionTeardown: func(a *node.Association, s *node.Session) {
+				stages <- "teardown"
+			},
+		}, &node.AssociationConfig{
+			Sessions: upSessions,
+		})
+
+		var seids []uint64
+		for range 2 {
+			s, err := cpSessions.Create(cp.Peer(), "127.0.0.2", &ie.FSEIDFields{SEID: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			seids = append(seids, s.LocalSEID())
+			if _, err := upSessions.Create(up.Peer(), "127.0.0.1", &ie.FSEIDFields{SEID: 1}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		done := make(chan error, 1)
+		go func() { done <- cp.GracefulRelease(context.Background(), 300*time.Millisecond) }()
+
+		if got, want := wait(t, stages), "start 300ms"; got != want {
+			t.Errorf("got %s want %s", got, want)
+		}
+		if cp.AcceptsNewSessions() {
+			t.Error("new sessions are accepted while releasing")
+		}
+
+		// one session drains within the period, and the other does not.
+		cpSessions.Delete(seids[0])
+
+		for _, want := range []string{"drained 1", "teardown"} {
+			if got := wait(t, stages); got != want {
+				t.Errorf("got %s want %s", got, want)
+			}
+		}
+		if err := wait(t, done); err != nil {
+			t.Fatal(err)
+		}
+
+		waitState(t, up, node.AssociationStateReleased)
+		if got := cpSessions.Len(); got != 0 {
+			t.Errorf("got %d sessions on the CP side, want 0", got)
+		}
+		if got := upSessions.Len(); got != 0 {
+			t.Errorf("got %d sessions on the UP side, want 0", got)
+		}
+	})
+
+	t.Run("RequestedByUP", func(t *testing.T) {
+		cp, up := associate(t, &node.AssociationConfig{}, &node.AssociationConfig{})
+
+		if err := up.PrepareRelease(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		waitState(t, cp, node.AssociationStateReleasing)
+		if up.AcceptsNewSessions() {
+			t.Error("new sessions are accepted while preparing for release")
+		}
+
+		if err := up.RequestRelease(context.Background(), 0); err != nil {
+			t.Fatal(err)
+		}
+		waitState(t, cp, node.AssociationStateReleased)
+		waitState(t, up, node.AssociationStateReleased)
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		stages := make(chan string, 8)
+		_, up := associate(t, &node.AssociationConfig{}, &node.AssociationConfig{
+			OnReleaseStart: func(a *node.Association, period time.Duration) {
+				stages <- "start"
+			},
+			OnReleaseCancel: func(a *node.Association) {
+				stages <- "cancel"
+			},
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := up.PrepareRelease(ctx); err == nil {
+			t.Fatal("got no error with the cancelled context")
+		}
+
+		for _, want := range []string{"start", "cancel"} {
+			if got := wait(t, stages); got != want {
+				t.Errorf("got %s want %s", got, want)
+			}
+		}
+		if !up.AcceptsNewSessions() {
+			t.Error("new sessions are not accepted after the release is cancelled")
+		}
+	})
+}
@@ -0,0 +1,248 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/internal/logger"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// DefaultRestorationTimeout is the default time allowed to the restoration
+// started by SessionRestorer.OnPeerRestart.
+const DefaultRestorationTimeout = time.Minute
+
+// RestorationConfig is a set of parameters to configure SessionRestorer.
+type RestorationConfig struct {
+	// Associations is used to set up the association with the restarted UP
+	// function again. This is mandatory.
+	Associations *AssociationManager
+	// Sessions is the SessionTable of the sessions to restore, which are
+	// matched with the NodeID of the UP function. This is mandatory.
+	Sessions *SessionTable
+	// Request returns the Session Establishment Request to restore s, which
+	// should have the same IEs as the one s has been established with. RESTI
+	// flag is set by SessionRestorer. If nil is returned, s is not restored.
+	// This is mandatory.
+	Request func(s *Session) *message.SessionEstablishmentRequest
+
+	// Timeout is the time allowed to the restoration started by
+	// OnPeerRestart. If zero, DefaultRestorationTimeout is used.
+	Timeout time.Duration
+	// OnRestored is called when the restoration started by OnPeerRestart
+	// has finished. result is nil if err is not nil.
+	OnRestored func(peer net.Addr, result *RestorationResult, err error)
+}
+
+// RestorationResult is the result of the restoration of the sessions with a
+// UP function.
+type RestorationResult struct {
+	// Restored is the sessions restored successfully.
+	Restored []*Session
+	// Failed is the sessions failed to be restored, which have been deleted
+	// from the SessionTable.
+	Failed []*RestorationFailure
+}
+
+// RestorationFailure is a session failed to be restored and the reason.
+type RestorationFailure struct {
+	Session *Session
+	// Err is *RejectedError if the UP function has rejected the request.
+	Err error
+}
+
+// ResourceNotAvailable reports whether the UP function has rejected the
+// restoration as the resources requested are not available, e.g., the F-TEID
+// previously allocated is in use.
+func (f *RestorationFailure) ResourceNotAvailable() bool {
+	var rerr *RejectedError
+	return errors.As(f.Err, &rerr) &&
+		rerr.Cause == ie.CausePFCPSessionRestorationFailureDueToRequestedResourceNotAvailable
+}
+
+// SessionRestorer restores the sessions on a UP function that has restarted,
+// as described in TS 23.527 and TS 29.244. This is used by a CP function.
+//
+// The sessions are restored by sending the Session Establishment Requests
+// again with RESTI flag set in PFCPSEReq-Flags IE, after setting up the
+// association again. To start it on the restart detected by Heartbeat, call
+// OnPeerRestart from HeartbeatConfig.OnPeerRestart.
+type SessionRestorer struct {
+	cfg RestorationConfig
+}
+
+// NewSessionRestorer creates a new SessionRestorer.
+func NewSessionRestorer(cfg *RestorationConfig) *SessionRestorer {
+	r := &SessionRestorer{cfg: *cfg}
+	if r.cfg.Timeout <= 0 {
+		r.cfg.Timeout = DefaultRestorationTimeout
+	}
+	return r
+}
+
+// OnPeerRestart starts restoring the sessions with peer in background, and
+// calls OnRestored when finished. The signature matches the one of
+// HeartbeatConfig.OnPeerRestart.
+func (r *SessionRestorer) OnPeerRestart(peer net.Addr, prev, cur time.Time) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), r.cfg.Timeout)
+		defer cancel()
+
+		result, err := r.Restore(ctx, peer, cur)
+		if err != nil {
+			logger.Logf("failed to restore sessions with %s: %v", peer, err)
+		}
+		if r.cfg.OnRestored != nil {
+			r.cfg.OnRestored(peer, result, err)
+		}
+	}()
+}
+
+// Restore restores the sessions with peer, which has restarted at recovery.
+//
+// The association is set up again unless it already has been with the peer
+// after recovery. The sessions are restored one by one, and the ones failed
+// are deleted from the SessionTable and reported in the result. An error is
+// returned only if the association cannot be set up.
+func (r *SessionRestorer) Restore(ctx context.Context, peer net.Addr, recovery time.Time) (*RestorationResult, error) {
+	m := r.cfg.Associations
+
+	a, ok := m.Association(peer)
+	if !ok || a.State() != AssociationStateAssociated || a.RecoveryTimeStamp().Before(recovery) {
+		if ok {
+			// the association has been lost on the peer.
+			_, _ = a.transition(AssociationStateIdle, AssociationStateAssociated, AssociationStateReleasing)
+		}
+
+		var err error
+		if a, err = m.Setup(ctx, peer); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &RestorationResult{}
+	var sessions []*Session
+	r.cfg.Sessions.RangeByNodeID(a.peerNodeID(), func(s *Session) bool {
+		sessions = append(sessions, s)
+		return true
+	})
+
+	for _, s := range sessions {
+		req := r.cfg.Request(s)
+		if req == nil {
+			continue
+		}
+
+		if err := r.restore(ctx, a, s, req); err != nil {
+			r.cfg.Sessions.Delete(s.LocalSEID())
+			result.Failed = append(result.Failed, &RestorationFailure{Session: s, Err: err})
+			continue
+		}
+		result.Restored = append(result.Restored, s)
+	}
+	return result, nil
+}
+
+// restore sends req to restore s, and binds s to the F-SEID in the response.
+func (r *SessionRestorer) restore(ctx context.Context, a *Association, s *Session, req *message.SessionEstablishmentRequest) error {
+	var flags uint8
+	if req.PFCPSEReqFlags != nil {
+		flags, _ = req.PFCPSEReqFlags.PFCPSEReqFlags()
+	}
+	req.PFCPSEReqFlags = ie.NewPFCPSEReqFlags(flags | 0x01) // RESTI
+	req.SetSEID(0)
+
+	msg, err := r.cfg.Associations.conn.SendRequest(ctx, a.peer, req)
+	if err != nil {
+		return err
+	}
+
+	res, ok := msg.(*message.SessionEstablishmentResponse)
+	if !ok {
+		return &UnexpectedMessageError{Message: msg}
+	}
+	if err := checkCause(res.MessageType(), res.Cause); err != nil {
+		return err
+	}
+
+	if res.UPFSEID != nil {
+		remote, err := res.UPFSEID.FSEID()
+		if err != nil {
+			return err
+		}
+		r.cfg.Sessions.UpdateRemoteFSEID(s, remote)
+	}
+	return nil
+}
+
+// retain tears down the sessions with the peer of a, which sets up the
+// association again, except the ones the peer asks to retain with info, the
+// PFCPSessionRetentionInformation IE. It reports whether any sessions are
+// retained.
+//
+// If info has CP PFCP Entity IP Addresses, only the sessions with the CP
+// F-SEID containing one of them are retained.
+func (m *AssociationManager) retain(a *Association, info *ie.IE) bool {
+	if !m.cfg.RetainSessions || info == nil {
+		m.teardown(a)
+		return false
+	}
+
+	addrs, err := cpEntityAddresses(info)
+	if err != nil {
+		logger.Logf("got invalid PFCP Session Retention Information from %s: %v", a.peer, err)
+		m.teardown(a)
+		return false
+	}
+	if len(addrs) == 0 {
+		return true
+	}
+
+	m.teardownIf(a, func(s *Session) bool {
+		remote := s.RemoteFSEID()
+		if remote == nil {
+			return true
+		}
+		for _, addr := range addrs {
+			if addr.Equal(remote.IPv4Address) || addr.Equal(remote.IPv6Address) {
+				return false
+			}
+		}
+		return true
+	})
+	return true
+}
+
+// cpEntityAddresses returns the IP addresses in all the CP PFCP Entity IP
+// Address IEs in the PFCPSessionRetentionInformation IE.
+func cpEntityAddresses(info *ie.IE) ([]net.IP, error) {
+	ies, err := ie.ParseMultiIEs(info.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var addrs []net.IP
+	for _, i := range ies {
+		if i.Type != ie.CPPFCPEntityIPAddress {
+			continue
+		}
+		f, err := i.CPPFCPEntityIPAddress()
+		if err != nil {
+			return nil, err
+		}
+		if f.IPv4Address != nil {
+			addrs = append(addrs, f.IPv4Address)
+		}
+		if f.IPv6Address != nil {
+			addrs = append(addrs, f.IPv6Address)
+		}
+	}
+	return addrs, nil
+}
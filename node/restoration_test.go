@@ -0,0 +1,165 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/node"
+	"github.com/wmnsk/go-pfcp/server"
+)
+
+func TestSessionRestorer(t *testing.T) {
+	const unavailable = 2 // the CP SEID of the session UP cannot restore
+
+	upSrv := server.New("", nil)
+	upConn := serve(t, upSrv)
+	upMgr := node.NewAssociationManager(upConn, &node.AssociationConfig{
+		Role: node.RoleUP, NodeID: ie.NewNodeID("127.0.0.2", "", ""),
+	})
+	upMgr.Register(upSrv)
+	upSrv.Handle(message.MsgTypeSessionEstablishmentRequest, server.Typed(
+		func(w server.ResponseWriter, r *server.Request, req *message.SessionEstablishmentRequest) {
+			if req.PFCPSEReqFlags == nil || !req.PFCPSEReqFlags.HasRESTI() {
+				t.Error("RESTI is not set")
+			}
+			f, err := req.CPFSEID.FSEID()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			cause := ie.CauseRequestAccepted
+			if f.SEID == unavailable {
+				cause = ie.CausePFCPSessionRestorationFailureDueToRequestedResourceNotAvailable
+			}
+			_ = w.Write(message.NewSessionEstablishmentResponse(0, 0, f.SEID, 0, 0,
+				ie.NewNodeID("127.0.0.2", "", ""),
+				ie.NewCause(cause),
+				ie.NewFSEID(f.SEID+100, net.ParseIP("127.0.0.2"), nil),
+			))
+		},
+	))
+	cpConn := serve(t, server.New("", nil))
+
+	cpMgr := node.NewAssociationManager(cpConn, &node.AssociationConfig{
+		Role: node.RoleCP, NodeID: ie.NewNodeID("127.0.0.1", "", ""),
+	})
+	if _, err := cpMgr.Setup(context.Background(), upConn.LocalAddr()); err != nil {
+		t.Fatal(err)
+	}
+
+	sessions := node.NewSessionTable()
+	for range 2 {
+		if _, err := sessions.Create(upConn.LocalAddr(), "127.0.0.2", &ie.FSEIDFields{SEID: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	seids := map[uint64]uint64{} // local SEID => SEID sent to UP as CP F-SEID
+	r := node.NewSessionRestorer(&node.RestorationConfig{
+		Associations: cpMgr,
+		Sessions:     sessions,
+		Request: func(s *node.Session) *message.SessionEstablishmentRequest {
+			seid := uint64(len(seids) + 1)
+			seids[s.LocalSEID()] = seid
+			return message.NewSessionEstablishmentRequest(0, 0, s.RemoteSEID(), 0, 0,
+				ie.NewNodeID("127.0.0.1", "", ""),
+				ie.NewFSEID(seid, net.ParseIP("127.0.0.1"), nil),
+			)
+		},
+	})
+
+	result, err := r.Restore(context.Background(), upConn.LocalAddr(), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Restored) != 1 || len(result.Failed) != 1 {
+		t.Fatalf("got %d restored and %d failed, want 1 and 1", len(result.Restored), len(result.Failed))
+	}
+	restored := result.Restored[0]
+	if got, want := restored.RemoteSEID(), seids[restored.LocalSEID()]+100; got != want {
+		t.Errorf("got %d want %d", got, want)
+	}
+
+	failed := result.Failed[0]
+	if !failed.ResourceNotAvailable() {
+		t.Errorf("got unexpected error: %v", failed.Err)
+	}
+	if _, ok := sessions.Get(failed.Session.LocalSEID()); ok {
+		t.Error("failed session is not deleted")
+	}
+
+	if _, ok := upMgr.Association(cpConn.LocalAddr()); !ok {
+		t.Error("association is not set up again")
+	}
+}
+
+func TestRetainSessions(t *testing.T) {
+	upSrv := server.New("", nil)
+	upConn, cpConn := serve(t, upSrv), serve(t, server.New("", nil))
+	upSessions := node.NewSessionTable()
+	upMgr := node.NewAssociationManager(upConn, &node.AssociationConfig{
+		Role:           node.RoleUP,
+		NodeID:         ie.NewNodeID("127.0.0.2", "", ""),
+		Sessions:       upSessions,
+		RetainSessions: true,
+	})
+	upMgr.Register(upSrv)
+
+	setup := func(cfg *node.AssociationConfig) *node.Association {
+		t.Helper()
+
+		cfg.Role, cfg.NodeID = node.RoleCP, ie.NewNodeID("127.0.0.1", "", "")
+		a, err := node.NewAssociationManager(cpConn, cfg).Setup(context.Background(), upConn.LocalAddr())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return a
+	}
+	setup(&node.AssociationConfig{})
+
+	retained, err := upSessions.Create(cpConn.LocalAddr(), "127.0.0.1",
+		ie.NewFSEIDFields(1, net.ParseIP("127.0.0.1"), nil),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleted, err := upSessions.Create(cpConn.LocalAddr(), "127.0.0.1",
+		ie.NewFSEIDFields(2, net.ParseIP("10.0.0.1"), nil),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the CP function restarts, and asks to retain the sessions of its own.
+	a := setup(&node.AssociationConfig{
+		SessionRetentionInformation: ie.NewPFCPSessionRetentionInformation(
+			ie.NewCPPFCPEntityIPAddress(net.ParseIP("127.0.0.1"), nil),
+		),
+	})
+
+	if !a.SessionsRetained() {
+		t.Error("sessions are not retained")
+	}
+	if _, ok := upSessions.Get(retained.LocalSEID()); !ok {
+		t.Error("session is not retained")
+	}
+	if _, ok := upSessions.Get(deleted.LocalSEID()); ok {
+		t.Error("session of another CP entity is retained")
+	}
+
+	// without the retention information, all the sessions are deleted.
+	setup(&node.AssociationConfig{})
+	if got := upSessions.Len(); got != 0 {
+		t.Errorf("got %d sessions want 0", got)
+	}
+}
@@ -0,0 +1,450 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node
+
+import (
+	"math/rand/v2"
+	"net"
+	"slices"
+	"sync"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/internal/logger"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/server"
+)
+
+// Session is a PFCP session, identified by the SEID allocated by the local
+// node and bound to the F-SEID allocated by the peer.
+type Session struct {
+	localSEID uint64
+
+	mu      sync.RWMutex
+	peer    net.Addr
+	nodeID  string
+	remote  *ie.FSEIDFields
+	fqcsids []*ie.IE
+}
+
+// LocalSEID returns the SEID allocated by the local node.
+func (s *Session) LocalSEID() uint64 {
+	return s.localSEID
+}
+
+// Peer returns the address of the peer.
+func (s *Session) Peer() net.Addr {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.peer
+}
+
+// PeerNodeID returns the NodeID of the peer.
+func (s *Session) PeerNodeID() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.nodeID
+}
+
+// RemoteFSEID returns the F-SEID allocated by the peer.
+func (s *Session) RemoteFSEID() *ie.FSEIDFields {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.remote
+}
+
+// RemoteSEID returns the SEID allocated by the peer, which is set in the
+// header of the messages sent to the peer.
+func (s *Session) RemoteSEID() uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.remote == nil {
+		return 0
+	}
+	return s.remote.SEID
+}
+
+// FQCSIDs returns the FQ-CSID IEs the session belongs to.
+func (s *Session) FQCSIDs() []*ie.IE {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.fqcsids
+}
+
+// fqcsidKey identifies a PDN connection set by the node address and a CSID.
+type fqcsidKey struct {
+	addr string
+	csid uint16
+}
+
+// fqcsidKeys returns the keys of all the CSIDs in fqcsid.
+func fqcsidKeys(fqcsid *ie.IE) ([]fqcsidKey, error) {
+	addr, err := fqcsid.NodeAddress()
+	if err != nil {
+		return nil, err
+	}
+	csids, err := fqcsid.CSIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]fqcsidKey, len(csids))
+	for n, csid := range csids {
+		keys[n] = fqcsidKey{addr: string(addr), csid: csid}
+	}
+	return keys, nil
+}
+
+// SessionTable is a registry of PFCP sessions, which allocates the local
+// SEIDs and keeps the F-SEIDs of the peers. It is safe for concurrent use.
+//
+// Middleware can be used to look up the session for every incoming
+// session-related request.
+type SessionTable struct {
+	mu       sync.RWMutex
+	sessions map[uint64]*Session
+	byNodeID map[string]map[uint64]*Session
+	byFQCSID map[fqcsidKey]map[uint64]*Session
+}
+
+// NewSessionTable creates a new empty SessionTable.
+func NewSessionTable() *SessionTable {
+	return &SessionTable{
+		sessions: map[uint64]*Session{},
+		byNodeID: map[string]map[uint64]*Session{},
+		byFQCSID: map[fqcsidKey]map[uint64]*Session{},
+	}
+}
+
+// Create allocates a new local SEID that is unique in the table and creates
+// a session bound to remote, the F-SEID allocated by the peer.
+//
+// nodeID is the NodeID of the peer, and fqcsids are the FQ-CSID IEs the
+// session belongs to, both of which can be used to iterate the sessions.
+func (t *SessionTable) Create(peer net.Addr, nodeID string, remote *ie.FSEIDFields, fqcsids ...*ie.IE) (*Session, error) {
+	var keys []fqcsidKey
+	for _, f := range fqcsids {
+		k, err := fqcsidKeys(f)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k...)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	seid := rand.Uint64()
+	for _, ok := t.sessions[seid]; ok || seid == 0; _, ok = t.sessions[seid] {
+		seid = rand.Uint64()
+	}
+
+	s := &Session{
+		localSEID: seid,
+		peer:      peer,
+		nodeID:    nodeID,
+		remote:    remote,
+		fqcsids:   fqcsids,
+	}
+	t.sessions[seid] = s
+	index(t.byNodeID, nodeID, s)
+	for _, k := range keys {
+		index(t.byFQCSID, k, s)
+	}
+	return s, nil
+}
+
+// CreateFromRequest creates a session with the parameters taken from a
+// Session Establishment Request received from peer.
+//
+// It returns *MissingIEError if the request does not contain NodeID or
+// CP F-SEID.
+func (t *SessionTable) CreateFromRequest(peer net.Addr, req *message.SessionEstablishmentRequest) (*Session, error) {
+	if req.NodeID == nil {
+		return nil, &MissingIEError{Type: ie.NodeID}
+	}
+	if req.CPFSEID == nil {
+		return nil, &MissingIEError{Type: ie.FSEID}
+	}
+
+	nodeID, err := req.NodeID.NodeID()
+	if err != nil {
+		return nil, err
+	}
+	remote, err := req.CPFSEID.FSEID()
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Create(peer, nodeID, remote, req.FQCSID...)
+}
+
+// Get returns the session with the local SEID.
+func (t *SessionTable) Get(seid uint64) (*Session, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	s, ok := t.sessions[seid]
+	return s, ok
+}
+
+// Delete deletes the session with the local SEID, and returns the deleted
+// session if it exists.
+func (t *SessionTable) Delete(seid uint64) (*Session, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s, ok := t.sessions[seid]
+	if !ok {
+		return nil, false
+	}
+
+	delete(t.sessions, seid)
+	unindex(t.byNodeID, s.PeerNodeID(), s)
+	for _, f := range s.FQCSIDs() {
+		keys, _ := fqcsidKeys(f) // validated on insertion
+		for _, k := range keys {
+			unindex(t.byFQCSID, k, s)
+		}
+	}
+	return s, true
+}
+
+// UpdateRemoteFSEID binds the session to a new F-SEID allocated by the peer,
+// e.g., when the peer has changed it in a Session Modification Request.
+func (t *SessionTable) UpdateRemoteFSEID(s *Session, remote *ie.FSEIDFields) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.remote = remote
+}
+
+// UpdatePeer binds the session to a new peer and F-SEID, e.g., when another
+// CP function in the same SMF set has taken over the session.
+func (t *SessionTable) UpdatePeer(s *Session, peer net.Addr, remote *ie.FSEIDFields) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.peer = peer
+	s.remote = remote
+}
+
+// UpdateFQCSIDs replaces the FQ-CSID IEs the session belongs to.
+func (t *SessionTable) UpdateFQCSIDs(s *Session, fqcsids ...*ie.IE) error {
+	var keys []fqcsidKey
+	for _, f := range fqcsids {
+		k, err := fqcsidKeys(f)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, k...)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s.mu.Lock()
+	old := s.fqcsids
+	s.fqcsids = fqcsids
+	s.mu.Unlock()
+
+	if _, ok := t.sessions[s.localSEID]; !ok {
+		return nil
+	}
+	for _, f := range old {
+		prev, _ := fqcsidKeys(f) // validated on insertion
+		for _, k := range prev {
+			unindex(t.byFQCSID, k, s)
+		}
+	}
+	for _, k := range keys {
+		index(t.byFQCSID, k, s)
+	}
+	return nil
+}
+
+// Len returns the number of sessions in the table.
+func (t *SessionTable) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.sessions)
+}
+
+// Range calls fn for each session in the table until fn returns false.
+//
+// fn is called with a snapshot of the sessions, so it can modify the table.
+func (t *SessionTable) Range(fn func(s *Session) bool) {
+	t.mu.RLock()
+	sessions := snapshot(t.sessions)
+	t.mu.RUnlock()
+
+	for _, s := range sessions {
+		if !fn(s) {
+			return
+		}
+	}
+}
+
+// RangeByNodeID calls fn for each session with the peer of nodeID until fn
+// returns false.
+func (t *SessionTable) RangeByNodeID(nodeID string, fn func(s *Session) bool) {
+	t.mu.RLock()
+	sessions := snapshot(t.byNodeID[nodeID])
+	t.mu.RUnlock()
+
+	for _, s := range sessions {
+		if !fn(s) {
+			return
+		}
+	}
+}
+
+// RangeByFQCSID calls fn for each session that belongs to any of the CSIDs in
+// the FQ-CSID IE until fn returns false.
+func (t *SessionTable) RangeByFQCSID(fqcsid *ie.IE, fn func(s *Session) bool) error {
+	keys, err := fqcsidKeys(fqcsid)
+	if err != nil {
+		return err
+	}
+
+	t.mu.RLock()
+	seen := map[uint64]struct{}{}
+	var sessions []*Session
+	for _, k := range keys {
+		for seid, s := range t.byFQCSID[k] {
+			if _, ok := seen[seid]; ok {
+				continue
+			}
+			seen[seid] = struct{}{}
+			sessions = append(sessions, s)
+		}
+	}
+	t.mu.RUnlock()
+
+	for _, s := range sessions {
+		if !fn(s) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// CSIDs returns the CSIDs allocated by the node of nodeAddr that any session
+// in the table belongs to.
+func (t *SessionTable) CSIDs(nodeAddr net.IP) []uint16 {
+	addr := nodeAddr.To4()
+	if addr == nil {
+		addr = nodeAddr.To16()
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var csids []uint16
+	for k := range t.byFQCSID {
+		if k.addr == string(addr) {
+			csids = append(csids, k.csid)
+		}
+	}
+	slices.Sort(csids)
+	return csids
+}
+
+// Middleware returns a server.Middleware that looks up the session with the
+// SEID in the header of every session-related request except Session
+// Establishment Request.
+//
+// The requests for unknown SEIDs are answered with CauseSessionContextNotFound
+// without calling the next Handler. For the known ones, the SEID of the peer
+// is set to the responses without SEID.
+//
+// The session reported with PSDBU flag in a Session Report Request, i.e., the
+// one deleted by the UP function, is deleted from the table once the next
+// Handler returns.
+func (t *SessionTable) Middleware() server.Middleware {
+	return func(next server.Handler) server.Handler {
+		return server.HandlerFunc(func(w server.ResponseWriter, r *server.Request) {
+			if !isSessionRelated(r.Message) || r.Message.MessageType() == message.MsgTypeSessionEstablishmentRequest {
+				next.ServePFCP(w, r)
+				return
+			}
+
+			s, ok := t.Get(r.Message.SEID())
+			if !ok {
+				res := newSessionResponse(r.Message, ie.CauseSessionContextNotFound, nil)
+				if res == nil {
+					logger.Logf("ignored %s from %s: unknown SEID %#x", r.Message.MessageTypeName(), r.Peer, r.Message.SEID())
+					return
+				}
+				if err := w.Write(res); err != nil {
+					logger.Logf("failed to send %s to %s: %v", res.MessageTypeName(), r.Peer, err)
+				}
+				return
+			}
+
+			next.ServePFCP(server.ResponseWriterFunc(func(res message.Message) error {
+				if h, ok := res.(interface {
+					HasSEID() bool
+					SetSEID(seid uint64)
+				}); ok && h.HasSEID() && res.SEID() == 0 {
+					h.SetSEID(s.RemoteSEID())
+				}
+				return w.Write(res)
+			}), r)
+
+			if req, ok := r.Message.(*message.SessionReportRequest); ok &&
+				req.PFCPSRReqFlags != nil && req.PFCPSRReqFlags.HasPSDBU() {
+				t.Delete(s.LocalSEID())
+			}
+		})
+	}
+}
+
+func index[K comparable](m map[K]map[uint64]*Session, k K, s *Session) {
+	sessions, ok := m[k]
+	if !ok {
+		sessions = map[uint64]*Session{}
+		m[k] = sessions
+	}
+	sessions[s.localSEID] = s
+}
+
+func unindex[K comparable](m map[K]map[uint64]*Session, k K, s *Session) {
+	delete(m[k], s.localSEID)
+	if len(m[k]) == 0 {
+		delete(m, k)
+	}
+}
+
+func snapshot(m map[uint64]*Session) []*Session {
+	sessions := make([]*Session, 0, len(m))
+	for _, s := range m {
+		sessions = append(sessions, s)
+	}
+	return sessions
+}
+
+// isSessionRelated reports whether msg is a session-related request.
+func isSessionRelated(msg message.Message) bool {
+	return msg.IsRequest() && msg.MessageType() >= message.MsgTypeSessionEstablishmentRequest
+}
+
+// newSessionResponse creates the response to a session-related request with
+// cause, or returns nil if req is not a session-related request. nodeID is
+// the NodeID of the local node, which is required only in Session
+// Establishment Response.
+func newSessionResponse(req message.Message, cause uint8, nodeID *ie.IE) message.Message {
+	c := ie.NewCause(cause)
+
+	switch req.MessageType() {
+	case message.MsgTypeSessionEstablishmentRequest:
+		return message.NewSessionEstablishmentResponse(0, 0, 0, 0, 0, appendIEs([]*ie.IE{c}, nodeID)...)
+	case message.MsgTypeSessionModificationRequest:
+		return message.NewSessionModificationResponse(0, 0, 0, 0, 0, c)
+	case message.MsgTypeSessionDeletionRequest:
+		return message.NewSessionDeletionResponse(0, 0, 0, 0, 0, c)
+	case message.MsgTypeSessionReportRequest:
+		return message.NewSessionReportResponse(0, 0, 0, 0, 0, c)
+	default:
+		return nil
+	}
+}
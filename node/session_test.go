@@ -0,0 +1,223 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/node"
+	"github.com/wmnsk/go-pfcp/server"
+)
+
+var peerAddr = &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 8805}
+
+func TestSessionTable(t *testing.T) {
+	t.Run("CreateFromRequest", func(t *testing.T) {
+		table := node.NewSessionTable()
+		s, err := table.CreateFromRequest(peerAddr, message.NewSessionEstablishmentRequest(0, 0, 0, 0, 0,
+			ie.NewNodeID("127.0.0.1", "", ""),
+			ie.NewFSEID(0x1111, net.ParseIP("127.0.0.1"), nil),
+			ie.NewFQCSID("127.0.0.1", 1),
+		))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, ok := table.Get(s.LocalSEID())
+		if !ok || got != s {
+			t.Fatalf("session %#x not found", s.LocalSEID())
+		}
+		if got, want := s.RemoteSEID(), uint64(0x1111); got != want {
+			t.Errorf("got %#x want %#x", got, want)
+		}
+		if got, want := s.PeerNodeID(), "127.0.0.1"; got != want {
+			t.Errorf("got %s want %s", got, want)
+		}
+
+		if _, ok := table.Delete(s.LocalSEID()); !ok {
+			t.Error("failed to delete")
+		}
+		if got := table.Len(); got != 0 {
+			t.Errorf("got %d sessions, want 0", got)
+		}
+	})
+
+	t.Run("MissingIE", func(t *testing.T) {
+		table := node.NewSessionTable()
+		_, err := table.CreateFromRequest(peerAddr, message.NewSessionEstablishmentRequest(0, 0, 0, 0, 0,
+			ie.NewNodeID("127.0.0.1", "", ""),
+		))
+		var merr *node.MissingIEError
+		if !errors.As(err, &merr) || merr.Type != ie.FSEID {
+			t.Errorf("got %v want MissingIEError for F-SEID", err)
+		}
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		table := node.NewSessionTable()
+		for _, c := range []struct {
+			nodeID string
+			fqcsid *ie.IE
+		}{
+			{"cp1", ie.NewFQCSID("127.0.0.1", 1)},
+			{"cp1", ie.NewFQCSID("127.0.0.1", 2)},
+			{"cp2", ie.NewFQCSID("127.0.0.1", 1, 3)},
+			{"cp2", ie.NewFQCSID("127.0.0.2", 1)},
+		} {
+			if _, err := table.Create(peerAddr, c.nodeID, &ie.FSEIDFields{SEID: 1}, c.fqcsid); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		n := 0
+		table.RangeByNodeID("cp1", func(s *node.Session) bool {
+			n++
+			return true
+		})
+		if n != 2 {
+			t.Errorf("got %d sessions with cp1, want 2", n)
+		}
+
+		n = 0
+		if err := table.RangeByFQCSID(ie.NewFQCSID("127.0.0.1", 1, 3), func(s *node.Session) bool {
+			n++
+			return true
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if n != 2 {
+			t.Errorf("got %d sessions with the FQ-CSID, want 2", n)
+		}
+
+		table.Range(func(s *node.Session) bool {
+			table.Delete(s.LocalSEID())
+			return true
+		})
+		if got := table.Len(); got != 0 {
+			t.Errorf("got %d sessions, want 0", got)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		table := node.NewSessionTable()
+
+		var wg sync.WaitGroup
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 100 {
+					s, err := table.Create(peerAddr, "cp", &ie.FSEIDFields{SEID: 1})
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					if _, ok := table.Get(s.LocalSEID()); !ok {
+						t.Errorf("session %#x not found", s.LocalSEID())
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		if got := table.Len(); got != 800 {
+			t.Errorf("got %d sessions, want 800", got)
+		}
+	})
+}
+
+func TestSessionTableMiddleware(t *testing.T) {
+	table := node.NewSessionTable()
+	srv := server.New("", nil)
+	srv.Use(table.Middleware())
+	srv.HandleFunc(message.MsgTypeSessionDeletionRequest, func(w server.ResponseWriter, r *server.Request) {
+		table.Delete(r.Message.SEID())
+		_ = w.Write(message.NewSessionDeletionResponse(0, 0, 0, 0, 0, ie.NewCause(ie.CauseRequestAccepted)))
+	})
+	conn := serve(t, srv)
+	client := serve(t, server.New("", nil))
+
+	s, err := table.Create(client.LocalAddr(), "cp", &ie.FSEIDFields{SEID: 0x1111})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	del := func(t *testing.T, seid uint64) *message.SessionDeletionResponse {
+		t.Helper()
+
+		msg, err := client.SendRequest(context.Background(), conn.LocalAddr(), message.NewSessionDeletionRequest(0, 0, seid, 0, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return msg.(*message.SessionDeletionResponse)
+	}
+
+	t.Run("Known", func(t *testing.T) {
+		res := del(t, s.LocalSEID())
+		if got, want := res.SEID(), uint64(0x1111); got != want {
+			t.Errorf("got SEID %#x want %#x", got, want)
+		}
+		if cause, _ := res.Cause.Cause(); cause != ie.CauseRequestAccepted {
+			t.Errorf("got Cause %d want %d", cause, ie.CauseRequestAccepted)
+		}
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		res := del(t, s.LocalSEID())
+		if got := res.SEID(); got != 0 {
+			t.Errorf("got SEID %#x want 0", got)
+		}
+		if cause, _ := res.Cause.Cause(); cause != ie.CauseSessionContextNotFound {
+			t.Errorf("got Cause %d want %d", cause, ie.CauseSessionContextNotFound)
+		}
+	})
+}
+
+func TestSessionTableMiddlewarePSDBU(t *testing.T) {
+	table := node.NewSessionTable()
+	srv := server.New("", nil)
+	srv.Use(table.Middleware())
+	srv.HandleFunc(message.MsgTypeSessionReportRequest, func(w server.ResponseWriter, r *server.Request) {
+		_ = w.Write(message.NewSessionReportResponse(0, 0, 0, 0, 0, ie.NewCause(ie.CauseRequestAccepted)))
+	})
+	conn := serve(t, srv)
+	client := serve(t, server.New("", nil))
+
+	s, err := table.Create(client.LocalAddr(), "up", &ie.FSEIDFields{SEID: 0x1111})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := func(ies ...*ie.IE) {
+		t.Helper()
+
+		req := message.NewSessionReportRequest(0, 0, s.LocalSEID(), 0, 0, ies...)
+		if _, err := client.SendRequest(context.Background(), conn.LocalAddr(), req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report(ie.NewReportType(0, 0, 1, 0))
+	if _, ok := table.Get(s.LocalSEID()); !ok {
+		t.Fatal("session is deleted without PSDBU")
+	}
+
+	report(ie.NewReportType(0, 0, 1, 0), ie.NewPFCPSRReqFlags(0x01))
+	// the session is deleted after the response is sent.
+	deadline := time.Now().Add(time.Second)
+	for table.Len() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("session is not deleted with PSDBU")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"slices"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/internal/logger"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/server"
+	"github.com/wmnsk/go-pfcp/transport"
+)
+
+// maxCSIDs is the maximum number of CSIDs in an FQ-CSID IE, which is limited
+// by the 4-bit Number of CSIDs field.
+const maxCSIDs = 15
+
+// SessionSetConfig is a set of parameters to configure SessionSetManager.
+type SessionSetConfig struct {
+	// NodeID is the NodeID IE of the local node. This is mandatory.
+	NodeID *ie.IE
+	// Sessions is the SessionTable in which the sessions are looked up by
+	// FQ-CSID. This is mandatory.
+	Sessions *SessionTable
+
+	// OnDelete is called for each session deleted from Sessions by Session
+	// Set Deletion.
+	OnDelete func(s *Session)
+
+	// GroupID returns the Group ID the session belongs to, which is used to
+	// match the Group ID IEs in Session Set Modification. If nil, the Group
+	// ID IEs are ignored.
+	GroupID func(s *Session) []byte
+	// OnRebind is called for each session bound to another CP function by
+	// Session Set Modification.
+	OnRebind func(s *Session)
+}
+
+// SessionSetManager deletes the sets of sessions identified by FQ-CSIDs on
+// the partial failure of a node, as described in TS 23.007 and TS 29.244.
+//
+// The sessions are indexed by all the FQ-CSIDs given to the SessionTable,
+// i.e., the ones of SGW-C, PGW-C/SMF, SGW-U, PGW-U/UPF and MME, which are
+// unique by the node address and CSID regardless of the role of the node.
+//
+// The sessions can also be moved to another member of the same SMF set by
+// Session Set Modification, which matches them by FQ-CSID, Group ID or CP IP
+// Address.
+//
+// On a UP function, register it to the server with Register to handle the
+// Session Set Deletion and Modification Requests. On a CP function, call
+// NodeFailed when a partner node has failed, and Modify to move the sessions
+// to another SMF.
+type SessionSetManager struct {
+	conn *transport.Conn
+	cfg  SessionSetConfig
+}
+
+// NewSessionSetManager creates a new SessionSetManager that sends requests on
+// conn.
+func NewSessionSetManager(conn *transport.Conn, cfg *SessionSetConfig) *SessionSetManager {
+	return &SessionSetManager{conn: conn, cfg: *cfg}
+}
+
+// Register registers the handlers of the Session Set Deletion and Session Set
+// Modification Requests to s.
+func (m *SessionSetManager) Register(s *server.Server) {
+	s.Handle(message.MsgTypeSessionSetDeletionRequest, server.Typed(m.handleDeletion))
+	s.Handle(message.MsgTypeSessionSetModificationRequest, server.Typed(m.handleModification))
+}
+
+// Delete deletes all the sessions that belong to any of the CSIDs in the
+// FQ-CSID IEs, and returns the number of sessions deleted.
+func (m *SessionSetManager) Delete(fqcsids ...*ie.IE) (int, error) {
+	var sessions []*Session
+	for _, f := range fqcsids {
+		if err := m.cfg.Sessions.RangeByFQCSID(f, func(s *Session) bool {
+			sessions = append(sessions, s)
+			return true
+		}); err != nil {
+			return 0, err
+		}
+	}
+
+	n := 0
+	for _, s := range sessions {
+		if _, ok := m.cfg.Sessions.Delete(s.LocalSEID()); !ok {
+			continue // belongs to multiple CSIDs
+		}
+		n++
+		if m.cfg.OnDelete != nil {
+			m.cfg.OnDelete(s)
+		}
+	}
+	return n, nil
+}
+
+// NodeFailed deletes the sessions that belong to any CSID allocated by the
+// failed node of nodeAddr, and sends a Session Set Deletion Request with the
+// CSIDs to each peer of the sessions.
+//
+// The sessions are deleted even if the requests fail, and the errors are
+// returned joined together.
+func (m *SessionSetManager) NodeFailed(ctx context.Context, nodeAddr net.IP) error {
+	csids := m.cfg.Sessions.CSIDs(nodeAddr)
+	if len(csids) == 0 {
+		return nil
+	}
+
+	var fqcsids []*ie.IE
+	for c := range slices.Chunk(csids, maxCSIDs) {
+		fqcsids = append(fqcsids, ie.NewFQCSID(nodeAddr.String(), c...))
+	}
+
+	peers := map[string]net.Addr{}
+	for _, f := range fqcsids {
+		_ = m.cfg.Sessions.RangeByFQCSID(f, func(s *Session) bool {
+			peers[s.Peer().String()] = s.Peer()
+			return true
+		})
+	}
+
+	if _, err := m.Delete(fqcsids...); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, peer := range peers {
+		errs = append(errs, m.request(ctx, peer, fqcsids))
+	}
+	return errors.Join(errs...)
+}
+
+// request sends a Session Set Deletion Request with fqcsids to peer and
+// waits for the response.
+func (m *SessionSetManager) request(ctx context.Context, peer net.Addr, fqcsids []*ie.IE) error {
+	req := message.NewSessionSetDeletionRequest(0, m.cfg.NodeID, nil, fqcsids...)
+	msg, err := m.conn.SendRequest(ctx, peer, req)
+	if err != nil {
+		return err
+	}
+
+	res, ok := msg.(*message.SessionSetDeletionResponse)
+	if !ok {
+		return &UnexpectedMessageError{Message: msg}
+	}
+	return checkCause(res.MessageType(), res.Cause)
+}
+
+func (m *SessionSetManager) handleDeletion(w server.ResponseWriter, r *server.Request, req *message.SessionSetDeletionRequest) {
+	write := func(cause uint8, offending *ie.IE) {
+		res := message.NewSessionSetDeletionResponse(0, m.cfg.NodeID, ie.NewCause(cause), offending)
+		if err := w.Write(res); err != nil {
+			logger.Logf("failed to send %s to %s: %v", res.MessageTypeName(), r.Peer, err)
+		}
+	}
+
+	if req.NodeID == nil {
+		write(ie.CauseMandatoryIEMissing, ie.NewOffendingIE(ie.NodeID))
+		return
+	}
+
+	if _, err := m.Delete(req.FQCSID...); err != nil {
+		logger.Logf("got invalid FQ-CSID in %s from %s: %v", req.MessageTypeName(), r.Peer, err)
+		write(ie.CauseMandatoryIEIncorrect, ie.NewOffendingIE(ie.FQCSID))
+		return
+	}
+	write(ie.CauseRequestAccepted, nil)
+}
+
+// Rebind binds all the sessions that match any of the FQ-CSID, Group ID or CP
+// IP Address IEs in ies to the SMF of alternative, the Alternative SMF IP
+// Address IE, and returns the number of sessions bound.
+//
+// The SEID allocated by the peer is kept, and the address of the peer and the
+// F-SEID are replaced with the alternative one. The port of the peer is kept
+// as well if it is a *net.UDPAddr. Other IEs in ies are ignored.
+func (m *SessionSetManager) Rebind(alternative *ie.IE, ies ...*ie.IE) (int, error) {
+	alt, err := alternative.AlternativeSMFIPAddress()
+	if err != nil {
+		return 0, err
+	}
+	ip := alt.IPv4Address
+	if ip == nil {
+		ip = alt.IPv6Address
+	}
+	if ip == nil {
+		return 0, ErrNoAlternativeSMFAddress
+	}
+
+	sessions := map[uint64]*Session{}
+	add := func(s *Session) bool {
+		sessions[s.LocalSEID()] = s
+		return true
+	}
+	for _, i := range ies {
+		switch i.Type {
+		case ie.FQCSID:
+			if err := m.cfg.Sessions.RangeByFQCSID(i, add); err != nil {
+				return 0, err
+			}
+		case ie.GroupID:
+			if m.cfg.GroupID == nil {
+				continue
+			}
+			id, err := i.GroupID()
+			if err != nil {
+				return 0, err
+			}
+			m.cfg.Sessions.Range(func(s *Session) bool {
+				if bytes.Equal(m.cfg.GroupID(s), id) {
+					add(s)
+				}
+				return true
+			})
+		case ie.CPIPAddress:
+			f, err := i.CPIPAddress()
+			if err != nil {
+				return 0, err
+			}
+			m.cfg.Sessions.Range(func(s *Session) bool {
+				if remote := s.RemoteFSEID(); remote != nil &&
+					(f.IPv4Address != nil && f.IPv4Address.Equal(remote.IPv4Address) ||
+						f.IPv6Address != nil && f.IPv6Address.Equal(remote.IPv6Address)) {
+					add(s)
+				}
+				return true
+			})
+		}
+	}
+
+	for _, s := range sessions {
+		peer := &net.UDPAddr{IP: ip, Port: server.DefaultPort}
+		if old, ok := s.Peer().(*net.UDPAddr); ok {
+			peer.Port = old.Port
+		}
+		remote := ie.NewFSEIDFields(s.RemoteSEID(), alt.IPv4Address, alt.IPv6Address)
+		m.cfg.Sessions.UpdatePeer(s, peer, remote)
+		if m.cfg.OnRebind != nil {
+			m.cfg.OnRebind(s)
+		}
+	}
+	return len(sessions), nil
+}
+
+// Modify sends a Session Set Modification Request to peer to bind the
+// sessions that match any of the FQ-CSID, Group ID or CP IP Address IEs in
+// ies to the SMF of alternative, the Alternative SMF IP Address IE, and waits
+// for the response.
+func (m *SessionSetManager) Modify(ctx context.Context, peer net.Addr, alternative *ie.IE, ies ...*ie.IE) error {
+	req := message.NewSessionSetModificationRequest(0, append([]*ie.IE{m.cfg.NodeID, alternative}, ies...)...)
+	msg, err := m.conn.SendRequest(ctx, peer, req)
+	if err != nil {
+		return err
+	}
+
+	res, ok := msg.(*message.SessionSetModificationResponse)
+	if !ok {
+		return &UnexpectedMessageError{Message: msg}
+	}
+	return checkCause(res.MessageType(), res.Cause)
+}
+
+func (m *SessionSetManager) handleModification(w server.ResponseWriter, r *server.Request, req *message.SessionSetModificationRequest) {
+	write := func(cause uint8, offending *ie.IE) {
+		res := message.NewSessionSetModificationResponse(0, m.cfg.NodeID, ie.NewCause(cause), offending)
+		if err := w.Write(res); err != nil {
+			logger.Logf("failed to send %s to %s: %v", res.MessageTypeName(), r.Peer, err)
+		}
+	}
+
+	switch {
+	case req.NodeID == nil:
+		write(ie.CauseMandatoryIEMissing, ie.NewOffendingIE(ie.NodeID))
+		return
+	case req.AlternativeSMFIPAddress == nil:
+		write(ie.CauseMandatoryIEMissing, ie.NewOffendingIE(ie.AlternativeSMFIPAddress))
+		return
+	case len(req.FQCSID) == 0 && len(req.GroupID) == 0 && len(req.CPIPAddress) == 0:
+		write(ie.CauseConditionalIEMissing, ie.NewOffendingIE(ie.FQCSID))
+		return
+	}
+
+	var ies []*ie.IE
+	ies = append(ies, req.FQCSID...)
+	ies = append(ies, req.GroupID...)
+	ies = append(ies, req.CPIPAddress...)
+	if _, err := m.Rebind(req.AlternativeSMFIPAddress, ies...); err != nil {
+		logger.Logf("got invalid IE in %s from %s: %v", req.MessageTypeName(), r.Peer, err)
+		write(ie.CauseMandatoryIEIncorrect, nil)
+		return
+	}
+	write(ie.CauseRequestAccepted, nil)
+}
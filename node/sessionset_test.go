@@ -0,0 +1,153 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package node_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/node"
+	"github.com/wmnsk/go-pfcp/server"
+)
+
+func TestSessionSetManager(t *testing.T) {
+	upSrv := server.New("", nil)
+	upConn, cpConn := serve(t, upSrv), serve(t, server.New("", nil))
+
+	cpSessions, upSessions := node.NewSessionTable(), node.NewSessionTable()
+	var deleted int
+	node.NewSessionSetManager(upConn, &node.SessionSetConfig{
+		NodeID:   ie.NewNodeID("127.0.0.2", "", ""),
+		Sessions: upSessions,
+		OnDelete: func(*node.Session) { deleted++ },
+	}).Register(upSrv)
+	cp := node.NewSessionSetManager(cpConn, &node.SessionSetConfig{
+		NodeID:   ie.NewNodeID("127.0.0.1", "", ""),
+		Sessions: cpSessions,
+	})
+
+	// the sessions served by the MMEs and the SGW-U.
+	for _, fqcsids := range [][]*ie.IE{
+		{ie.NewFQCSID("10.0.0.1", 1), ie.NewFQCSID("127.0.0.2", 100)},
+		{ie.NewFQCSID("10.0.0.1", 2), ie.NewFQCSID("127.0.0.2", 100)},
+		{ie.NewFQCSID("10.0.0.2", 1), ie.NewFQCSID("127.0.0.2", 101)},
+	} {
+		if _, err := cpSessions.Create(upConn.LocalAddr(), "127.0.0.2", &ie.FSEIDFields{SEID: 1}, fqcsids...); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := upSessions.Create(cpConn.LocalAddr(), "127.0.0.1", &ie.FSEIDFields{SEID: 1}, fqcsids...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got, want := cpSessions.CSIDs(net.ParseIP("10.0.0.1")), []uint16{1, 2}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("got %v want %v", got, want)
+	}
+
+	// the MME of 10.0.0.1 has failed.
+	if err := cp.NodeFailed(context.Background(), net.ParseIP("10.0.0.1")); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := cpSessions.Len(), 1; got != want {
+		t.Errorf("got %d sessions on CP want %d", got, want)
+	}
+	if got, want := upSessions.Len(), 1; got != want {
+		t.Errorf("got %d sessions on UP want %d", got, want)
+	}
+	if got, want := deleted, 2; got != want {
+		t.Errorf("got %d deleted want %d", got, want)
+	}
+}
+
+func TestSessionSetDeletionMissingIE(t *testing.T) {
+	srv := server.New("", nil)
+	conn, client := serve(t, srv), serve(t, server.New("", nil))
+	node.NewSessionSetManager(conn, &node.SessionSetConfig{
+		NodeID:   ie.NewNodeID("127.0.0.2", "", ""),
+		Sessions: node.NewSessionTable(),
+	}).Register(srv)
+
+	req := message.NewSessionSetDeletionRequest(0, nil, ie.NewFQCSID("10.0.0.1", 1))
+	msg, err := client.SendRequest(context.Background(), conn.LocalAddr(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := msg.(*message.SessionSetDeletionResponse)
+	if cause, _ := res.Cause.Cause(); cause != ie.CauseMandatoryIEMissing {
+		t.Errorf("got Cause %d want %d", cause, ie.CauseMandatoryIEMissing)
+	}
+}
+
+func TestSessionSetModification(t *testing.T) {
+	upSrv := server.New("", nil)
+	upConn, cpConn := serve(t, upSrv), serve(t, server.New("", nil))
+
+	upSessions := node.NewSessionTable()
+	var rebound int
+	node.NewSessionSetManager(upConn, &node.SessionSetConfig{
+		NodeID:   ie.NewNodeID("127.0.0.2", "", ""),
+		Sessions: upSessions,
+		OnRebind: func(*node.Session) { rebound++ },
+	}).Register(upSrv)
+	cp := node.NewSessionSetManager(cpConn, &node.SessionSetConfig{
+		NodeID:   ie.NewNodeID("127.0.0.1", "", ""),
+		Sessions: node.NewSessionTable(),
+	})
+
+	var sessions []*node.Session
+	for seid, addr := range []string{"127.0.0.1", "127.0.0.1", "10.0.0.1", "10.0.0.2"} {
+		var fqcsids []*ie.IE
+		if addr == "127.0.0.1" {
+			fqcsids = append(fqcsids, ie.NewFQCSID("127.0.0.1", 1))
+		}
+		s, err := upSessions.Create(cpConn.LocalAddr(), "smf-set.example",
+			ie.NewFSEIDFields(uint64(seid+1), net.ParseIP(addr), nil), fqcsids...,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sessions = append(sessions, s)
+	}
+
+	alternative := ie.NewAlternativeSMFIPAddress(net.ParseIP("127.0.0.3"), nil)
+	if err := cp.Modify(context.Background(), upConn.LocalAddr(), alternative,
+		ie.NewFQCSID("127.0.0.1", 1),
+		ie.NewCPIPAddress(net.ParseIP("10.0.0.1"), nil),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := rebound, 3; got != want {
+		t.Errorf("got %d rebound want %d", got, want)
+	}
+	for n, s := range sessions {
+		want := "127.0.0.3"
+		if n == 3 {
+			want = "10.0.0.2"
+		}
+		if got := s.RemoteFSEID().IPv4Address.String(); got != want {
+			t.Errorf("session %d: got F-SEID address %s want %s", n, got, want)
+		}
+		if got, want := s.RemoteSEID(), uint64(n+1); got != want {
+			t.Errorf("session %d: got SEID %d want %d", n, got, want)
+		}
+	}
+	if got, want := sessions[0].Peer().(*net.UDPAddr).IP.String(), "127.0.0.3"; got != want {
+		t.Errorf("got peer %s want %s", got, want)
+	}
+
+	// none of FQ-CSID, Group ID and CP IP Address is present.
+	err := cp.Modify(context.Background(), upConn.LocalAddr(), alternative)
+	var rerr *node.RejectedError
+	if !errors.As(err, &rerr) || rerr.Cause != ie.CauseConditionalIEMissing {
+		t.Errorf("got unexpected error: %v", err)
+	}
+}
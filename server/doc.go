@@ -0,0 +1,12 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package server provides a PFCP server that dispatches the received requests
+// to the handlers registered per message type.
+//
+// The server works on top of transport.Conn, which takes care of answering the
+// retransmitted requests, so that a handler is called only once per request.
+// The requests in an unsupported version of PFCP are answered with Version Not
+// Supported Response without reaching the handlers.
+package server
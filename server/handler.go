@@ -0,0 +1,111 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package server
+
+import (
+	"net"
+
+	"github.com/wmnsk/go-pfcp/internal/logger"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/transport"
+)
+
+// Request represents a request received by the Server.
+type Request struct {
+	// Peer is the address the request came from.
+	Peer net.Addr
+	// Message is the decoded request.
+	Message message.Message
+}
+
+// ResponseWriter is used by a Handler to send the response to a request.
+type ResponseWriter interface {
+	// Write sends res as the response to the request.
+	//
+	// The sequence number of res is overwritten with the one in the request.
+	// If res is a Session Establishment Response without SEID, the SEID in the
+	// CP F-SEID IE of the request is filled in. For the other session-related
+	// responses, the SEID of the peer must be set by the handler (or by
+	// node.SessionTable's middleware), as the request header carries the SEID
+	// of the local node. It is left 0 if the peer's SEID is unknown.
+	Write(res message.Message) error
+}
+
+// ResponseWriterFunc is an adapter to allow the use of ordinary functions as
+// ResponseWriter. This is useful for a Middleware to see the outgoing response.
+type ResponseWriterFunc func(res message.Message) error
+
+// Write calls f(res).
+func (f ResponseWriterFunc) Write(res message.Message) error {
+	return f(res)
+}
+
+// Handler responds to a request.
+type Handler interface {
+	ServePFCP(w ResponseWriter, r *Request)
+}
+
+// HandlerFunc is an adapter to allow the use of ordinary functions as Handler.
+type HandlerFunc func(w ResponseWriter, r *Request)
+
+// ServePFCP calls f(w, r).
+func (f HandlerFunc) ServePFCP(w ResponseWriter, r *Request) {
+	f(w, r)
+}
+
+// Typed returns a Handler that calls fn with the request message asserted to
+// the concrete type T, e.g., *message.SessionEstablishmentRequest.
+//
+// The requests of any other type are ignored with a log.
+func Typed[T message.Message](fn func(w ResponseWriter, r *Request, msg T)) Handler {
+	return HandlerFunc(func(w ResponseWriter, r *Request) {
+		msg, ok := r.Message.(T)
+		if !ok {
+			logger.Logf("ignored %s from %s: unexpected type %T", r.Message.MessageTypeName(), r.Peer, r.Message)
+			return
+		}
+		fn(w, r, msg)
+	})
+}
+
+// notFound is the Handler called for the requests of the message type
+// without any handler registered.
+var notFound = HandlerFunc(func(w ResponseWriter, r *Request) {
+	logger.Logf("ignored %s from %s: no handler is registered", r.Message.MessageTypeName(), r.Peer)
+})
+
+// responseWriter is the ResponseWriter bound to a request received on a Conn.
+type responseWriter struct {
+	conn *transport.Conn
+	req  *Request
+}
+
+// Write sends res as the response to the request.
+func (w *responseWriter) Write(res message.Message) error {
+	fillSEID(w.req.Message, res)
+	return w.conn.RespondTo(w.req.Peer, w.req.Message, res)
+}
+
+// fillSEID sets the SEID of the peer to res if it is a Session Establishment
+// Response without SEID. The SEID is taken from the CP F-SEID IE in req.
+//
+// The SEID of the peer cannot be derived from the header of the other
+// requests, as it carries the SEID allocated by the local node.
+func fillSEID(req, res message.Message) {
+	r, ok := req.(*message.SessionEstablishmentRequest)
+	if !ok || r.CPFSEID == nil {
+		return
+	}
+	m, ok := res.(*message.SessionEstablishmentResponse)
+	if !ok || !m.HasSEID() || m.SEID() != 0 {
+		return
+	}
+
+	f, err := r.CPFSEID.FSEID()
+	if err != nil {
+		return
+	}
+	m.SetSEID(f.SEID)
+}
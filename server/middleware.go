@@ -0,0 +1,174 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package server
+
+import (
+	"log"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/internal/logger"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// Middleware wraps a Handler to add a behavior common to the handlers, in the
+// same way as the middlewares of net/http.
+//
+// A Middleware sees the request and the peer in the Request, and it can see
+// the outgoing response by passing its own ResponseWriter (typically created
+// with ResponseWriterFunc) to the next Handler.
+type Middleware func(next Handler) Handler
+
+// Chain returns h wrapped with the middlewares. The first middleware is the
+// outermost one, which sees the request first and the response last.
+func Chain(h Handler, mws ...Middleware) Handler {
+	for i := len(mws) - 1; i >= 0; i-- {
+		h = mws[i](h)
+	}
+	return h
+}
+
+// Logging returns a Middleware that logs each request with its response and
+// the time taken to handle it.
+//
+// If l is nil, the logger of the package is used.
+// See also: pfcp.SetLogger.
+func Logging(l *log.Logger) Middleware {
+	logf := logger.Logf
+	if l != nil {
+		logf = l.Printf
+	}
+
+	return func(next Handler) Handler {
+		return HandlerFunc(func(w ResponseWriter, r *Request) {
+			var res message.Message
+			start := time.Now()
+			next.ServePFCP(ResponseWriterFunc(func(m message.Message) error {
+				res = m
+				return w.Write(m)
+			}), r)
+
+			if res == nil {
+				logf("%s (Seq=%d) from %s: no response, took %s",
+					r.Message.MessageTypeName(), r.Message.Sequence(), r.Peer, time.Since(start),
+				)
+				return
+			}
+			logf("%s (Seq=%d) from %s: responded with %s, took %s",
+				r.Message.MessageTypeName(), r.Message.Sequence(), r.Peer, res.MessageTypeName(), time.Since(start),
+			)
+		})
+	}
+}
+
+// MetricsFunc is called by the Metrics middleware when a request has been
+// handled. res is nil if the handler has not written any response.
+type MetricsFunc func(r *Request, res message.Message, elapsed time.Duration)
+
+// Metrics returns a Middleware that calls fn with each request, its response
+// and the time taken to handle it, which can be used to update the metrics.
+func Metrics(fn MetricsFunc) Middleware {
+	return func(next Handler) Handler {
+		return HandlerFunc(func(w ResponseWriter, r *Request) {
+			var res message.Message
+			start := time.Now()
+			next.ServePFCP(ResponseWriterFunc(func(m message.Message) error {
+				res = m
+				return w.Write(m)
+			}), r)
+
+			fn(r, res, time.Since(start))
+		})
+	}
+}
+
+// Recovery returns a Middleware that recovers the panic in the handler and
+// logs it with the stack trace, instead of crashing the whole program.
+func Recovery() Middleware {
+	return func(next Handler) Handler {
+		return HandlerFunc(func(w ResponseWriter, r *Request) {
+			defer func() {
+				if v := recover(); v != nil {
+					logger.Logf("recovered from panic in handling %s from %s: %v\n%s",
+						r.Message.MessageTypeName(), r.Peer, v, debug.Stack(),
+					)
+				}
+			}()
+
+			next.ServePFCP(w, r)
+		})
+	}
+}
+
+// RateLimit returns a Middleware that limits the number of requests passed
+// to the handler to rate per second, allowing bursts of up to burst requests.
+//
+// The requests exceeding the limit are dropped without any response, which
+// lets the peer retransmit them later.
+func RateLimit(rate float64, burst int) Middleware {
+	b := &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+
+	return func(next Handler) Handler {
+		return HandlerFunc(func(w ResponseWriter, r *Request) {
+			if !b.take() {
+				logger.Logf("dropped %s from %s: rate limit exceeded", r.Message.MessageTypeName(), r.Peer)
+				return
+			}
+
+			next.ServePFCP(w, r)
+		})
+	}
+}
+
+// tokenBucket is a simple token bucket used by RateLimit.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func (b *tokenBucket) take() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// Validate returns a Middleware that checks each request with fn before
+// passing it to the handler.
+//
+// The requests fn returns an error for are dropped with a log. To answer
+// such requests, the handler should validate them by itself instead.
+func Validate(fn func(r *Request) error) Middleware {
+	return func(next Handler) Handler {
+		return HandlerFunc(func(w ResponseWriter, r *Request) {
+			if err := fn(r); err != nil {
+				logger.Logf("dropped invalid %s from %s: %v", r.Message.MessageTypeName(), r.Peer, err)
+				return
+			}
+
+			next.ServePFCP(w, r)
+		})
+	}
+}
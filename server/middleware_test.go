@@ -0,0 +1,114 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package server_test
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/server"
+)
+
+var (
+	peer = &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8805}
+
+	// echo responds to a Heartbeat Request.
+	echo = server.HandlerFunc(func(w server.ResponseWriter, r *server.Request) {
+		_ = w.Write(message.NewHeartbeatResponse(0, ie.NewRecoveryTimeStamp(ts)))
+	})
+)
+
+// serve calls h with a Heartbeat Request and returns the response written.
+func serve(h server.Handler) message.Message {
+	var res message.Message
+	h.ServePFCP(server.ResponseWriterFunc(func(m message.Message) error {
+		res = m
+		return nil
+	}), &server.Request{
+		Peer:    peer,
+		Message: message.NewHeartbeatRequest(1, ie.NewRecoveryTimeStamp(ts), nil),
+	})
+	return res
+}
+
+func TestChain(t *testing.T) {
+	var order []string
+	mw := func(name string) server.Middleware {
+		return func(next server.Handler) server.Handler {
+			return server.HandlerFunc(func(w server.ResponseWriter, r *server.Request) {
+				order = append(order, name+"-in")
+				next.ServePFCP(w, r)
+				order = append(order, name+"-out")
+			})
+		}
+	}
+
+	serve(server.Chain(echo, mw("a"), mw("b")))
+	if got, want := strings.Join(order, ","), "a-in,b-in,b-out,a-out"; got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestLogging(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if res := serve(server.Chain(echo, server.Logging(log.New(buf, "", 0)))); res == nil {
+		t.Fatal("response has not been written")
+	}
+
+	if got, want := buf.String(), "Heartbeat Request (Seq=1) from 127.0.0.1:8805: responded with Heartbeat Response"; !strings.HasPrefix(got, want) {
+		t.Errorf("got %q want %q", got, want)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	var got message.Message
+	serve(server.Chain(echo, server.Metrics(func(r *server.Request, res message.Message, elapsed time.Duration) {
+		got = res
+	})))
+
+	if got == nil || got.MessageType() != message.MsgTypeHeartbeatResponse {
+		t.Errorf("got unexpected response: %v", got)
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	h := server.HandlerFunc(func(w server.ResponseWriter, r *server.Request) {
+		panic("oops")
+	})
+
+	if res := serve(server.Chain(h, server.Recovery())); res != nil {
+		t.Errorf("got unexpected response: %v", res)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	h := server.Chain(echo, server.RateLimit(0.001, 2))
+
+	for i, want := range []bool{true, true, false} {
+		if got := serve(h) != nil; got != want {
+			t.Errorf("request #%d: got %v want %v", i, got, want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	h := server.Chain(echo, server.Validate(func(r *server.Request) error {
+		if r.Message.Sequence() == 1 {
+			return errors.New("invalid")
+		}
+		return nil
+	}))
+
+	if res := serve(h); res != nil {
+		t.Errorf("got unexpected response: %v", res)
+	}
+}
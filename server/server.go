@@ -0,0 +1,130 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package server
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/transport"
+)
+
+// DefaultPort is the UDP port PFCP uses, defined in TS 29.244 clause 4.2.2.
+const DefaultPort = 8805
+
+// Server is a PFCP server that dispatches the requests to the Handler
+// registered for the message type.
+type Server struct {
+	addr string
+	cfg  *transport.Config
+
+	mu          sync.RWMutex
+	handlers    map[uint8]Handler
+	middlewares []Middleware
+	conn        *transport.Conn
+}
+
+// New creates a new Server that listens on addr with ListenAndServe.
+//
+// If addr is empty, ":8805" is used. cfg is passed to the transport.Conn
+// created by ListenAndServe, and it can be nil to use the default parameters.
+func New(addr string, cfg *transport.Config) *Server {
+	if addr == "" {
+		addr = ":" + strconv.Itoa(DefaultPort)
+	}
+
+	return &Server{
+		addr:     addr,
+		cfg:      cfg,
+		handlers: map[uint8]Handler{},
+	}
+}
+
+// Handle registers the handler for the given message type.
+//
+// If a handler already exists for the message type, it is replaced.
+func (s *Server) Handle(msgType uint8, h Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[msgType] = h
+}
+
+// HandleFunc registers the handler function for the given message type.
+func (s *Server) HandleFunc(msgType uint8, fn func(w ResponseWriter, r *Request)) {
+	s.Handle(msgType, HandlerFunc(fn))
+}
+
+// Use appends the middlewares to the chain that wraps every handler.
+//
+// The middlewares are applied in the order given, i.e., the first one sees
+// the request first and the response last. They also wrap the requests of
+// the message type without any handler registered.
+func (s *Server) Use(mws ...Middleware) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.middlewares = append(s.middlewares, mws...)
+}
+
+// Handler returns the handler registered for the given message type, or nil
+// if there is none.
+func (s *Server) Handler(msgType uint8) Handler {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.handlers[msgType]
+}
+
+// Conn returns the transport.Conn the Server is serving on, which can be
+// used to send requests to the peers. It returns nil before serving.
+func (s *Server) Conn() *transport.Conn {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.conn
+}
+
+// ListenAndServe listens on the UDP address given to New and serves the
+// requests until ctx is done.
+//
+// ListenAndServe always returns a non-nil error.
+func (s *Server) ListenAndServe(ctx context.Context) error {
+	conn, err := transport.Listen("udp", s.addr, s.cfg)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return s.Serve(ctx, conn)
+}
+
+// Serve serves the requests received on conn until ctx is done or conn is
+// closed.
+//
+// Serve always returns a non-nil error.
+func (s *Server) Serve(ctx context.Context, conn *transport.Conn) error {
+	s.mu.Lock()
+	s.conn = conn
+	s.mu.Unlock()
+
+	return conn.Serve(ctx, s.serveRequest)
+}
+
+func (s *Server) serveRequest(c *transport.Conn, peer net.Addr, msg message.Message) {
+	s.mu.RLock()
+	h, ok := s.handlers[msg.MessageType()]
+	if !ok {
+		h = notFound
+	}
+	h = Chain(h, s.middlewares...)
+	s.mu.RUnlock()
+
+	r := &Request{Peer: peer, Message: msg}
+	h.ServePFCP(&responseWriter{conn: c, req: r}, r)
+}
@@ -0,0 +1,122 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package server_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/server"
+	"github.com/wmnsk/go-pfcp/transport"
+	"github.com/wmnsk/go-pfcp/transport/memnet"
+)
+
+var (
+	ts  = time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	cfg = &transport.Config{T1: 20 * time.Millisecond, N1: 1}
+)
+
+// setup starts serving s and returns a client Conn and the address of s.
+func setup(t *testing.T, s *server.Server) (*transport.Conn, net.Addr) {
+	t.Helper()
+
+	pc1, pc2 := memnet.NewNetwork(nil).Pipe()
+	client, conn := transport.NewConn(pc1, cfg), transport.NewConn(pc2, cfg)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() { _ = client.Serve(ctx, nil) }()
+	go func() { _ = s.Serve(ctx, conn) }()
+
+	t.Cleanup(func() {
+		cancel()
+		_ = client.Close()
+		_ = conn.Close()
+	})
+	return client, conn.LocalAddr()
+}
+
+func TestServer(t *testing.T) {
+	s := server.New("", nil)
+	s.HandleFunc(message.MsgTypeHeartbeatRequest, func(w server.ResponseWriter, r *server.Request) {
+		if err := w.Write(message.NewHeartbeatResponse(0, ie.NewRecoveryTimeStamp(ts))); err != nil {
+			t.Error(err)
+		}
+	})
+	s.Handle(message.MsgTypeSessionEstablishmentRequest, server.Typed(
+		func(w server.ResponseWriter, r *server.Request, req *message.SessionEstablishmentRequest) {
+			if err := w.Write(message.NewSessionEstablishmentResponse(0, 0, 0, 0, 0,
+				ie.NewCause(ie.CauseRequestAccepted),
+			)); err != nil {
+				t.Error(err)
+			}
+		},
+	))
+	s.HandleFunc(message.MsgTypeSessionDeletionRequest, func(w server.ResponseWriter, r *server.Request) {
+		if err := w.Write(message.NewSessionDeletionResponse(0, 0, 0, 0, 0,
+			ie.NewCause(ie.CauseRequestAccepted),
+		)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	client, addr := setup(t, s)
+	ctx := context.Background()
+
+	t.Run("NodeRelated", func(t *testing.T) {
+		req := message.NewHeartbeatRequest(1, ie.NewRecoveryTimeStamp(ts), nil)
+		res, err := client.SendRequest(ctx, addr, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := res.(*message.HeartbeatResponse); !ok {
+			t.Errorf("got unexpected response: %s", res.MessageTypeName())
+		}
+	})
+
+	t.Run("SessionEstablishment", func(t *testing.T) {
+		req := message.NewSessionEstablishmentRequest(0, 0, 0, 2, 0,
+			ie.NewNodeID("127.0.0.1", "", ""),
+			ie.NewFSEID(0x1111111111111111, net.ParseIP("127.0.0.1"), nil),
+		)
+		res, err := client.SendRequest(ctx, addr, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := res.SEID(), uint64(0x1111111111111111); got != want {
+			t.Errorf("got %#x want %#x", got, want)
+		}
+		if got, want := res.Sequence(), req.Sequence(); got != want {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("SessionDeletion", func(t *testing.T) {
+		req := message.NewSessionDeletionRequest(0, 0, 0x2222222222222222, 3, 0)
+		res, err := client.SendRequest(ctx, addr, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the header of the request has the SEID of the server, not the one of
+		// the client, so it is not copied to the response.
+		if got, want := res.SEID(), uint64(0); got != want {
+			t.Errorf("got %#x want %#x", got, want)
+		}
+	})
+
+	t.Run("NoHandler", func(t *testing.T) {
+		req := message.NewAssociationSetupRequest(4, ie.NewNodeID("127.0.0.1", "", ""))
+		_, err := client.SendRequest(ctx, addr, req)
+
+		var terr *transport.TimeoutError
+		if !errors.As(err, &terr) {
+			t.Errorf("got unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package transport
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Default values of the response cache parameters.
+//
+// The responses should be kept at least as long as the peer may retransmit
+// the request, which is T1 * (N1 + 1) with the default values.
+const (
+	DefaultResponseCacheSize   = 4096
+	DefaultResponseCacheExpiry = DefaultT1 * (DefaultN1 + 1)
+)
+
+// cacheKey identifies a request received from a peer.
+type cacheKey struct {
+	peer    string
+	seq     uint32
+	msgType uint8
+}
+
+type cacheEntry struct {
+	key     cacheKey
+	expires time.Time
+
+	// response is nil while the request is being handled.
+	response []byte
+}
+
+// responseCache keeps the responses sent to the requests, so that the
+// retransmitted requests can be answered without being handled twice.
+//
+// The entries are kept in the order of insertion, which is also the order
+// of expiry as all the entries have the same lifetime.
+type responseCache struct {
+	mu      sync.Mutex
+	size    int
+	expiry  time.Duration
+	entries map[cacheKey]*list.Element
+	order   *list.List
+}
+
+func newResponseCache(size int, expiry time.Duration) *responseCache {
+	return &responseCache{
+		size:    size,
+		expiry:  expiry,
+		entries: map[cacheKey]*list.Element{},
+		order:   list.New(),
+	}
+}
+
+// begin reports whether the request identified by key is a new one.
+//
+// If it is a retransmission, the response already sent is returned, which is
+// nil if the original request is still being handled. Otherwise, the request
+// is recorded as being handled.
+func (c *responseCache) begin(key cacheKey) (isNew bool, response []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	c.expire(now)
+
+	if e, ok := c.entries[key]; ok {
+		return false, e.Value.(*cacheEntry).response
+	}
+
+	if c.order.Len() >= c.size {
+		c.remove(c.order.Front())
+	}
+	c.entries[key] = c.order.PushBack(&cacheEntry{
+		key:     key,
+		expires: now.Add(c.expiry),
+	})
+	return true, nil
+}
+
+// store records the response to the request identified by key.
+func (c *responseCache) store(key cacheKey, response []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// the entry may have been evicted or expired while the request
+	// was being handled, which is not worth caching anymore.
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*cacheEntry).response = response
+	}
+}
+
+// forget removes the entry of the request identified by key if it has not
+// been answered, so that the retransmissions are handled as new requests.
+func (c *responseCache) forget(key cacheKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok && e.Value.(*cacheEntry).response == nil {
+		c.remove(e)
+	}
+}
+
+// len returns the number of entries, including the expired ones
+// that have not been removed yet.
+func (c *responseCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *responseCache) expire(now time.Time) {
+	for e := c.order.Front(); e != nil; e = c.order.Front() {
+		if now.Before(e.Value.(*cacheEntry).expires) {
+			return
+		}
+		c.remove(e)
+	}
+}
+
+func (c *responseCache) remove(e *list.Element) {
+	delete(c.entries, e.Value.(*cacheEntry).key)
+	c.order.Remove(e)
+}
@@ -0,0 +1,64 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package transport
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResponseCache(t *testing.T) {
+	c := newResponseCache(2, 50*time.Millisecond)
+	k1 := cacheKey{peer: "127.0.0.1:8805", seq: 1, msgType: 50}
+	k2 := cacheKey{peer: "127.0.0.1:8805", seq: 2, msgType: 50}
+	k3 := cacheKey{peer: "127.0.0.1:8805", seq: 2, msgType: 52}
+
+	t.Run("Replay", func(t *testing.T) {
+		if isNew, _ := c.begin(k1); !isNew {
+			t.Fatal("got duplicate for the first request")
+		}
+		if isNew, res := c.begin(k1); isNew || res != nil {
+			t.Fatalf("got isNew=%v, res=%x for the request being handled", isNew, res)
+		}
+
+		c.store(k1, []byte{0x01})
+		if isNew, res := c.begin(k1); isNew || len(res) != 1 {
+			t.Fatalf("got isNew=%v, res=%x for the request already answered", isNew, res)
+		}
+	})
+
+	t.Run("Forget", func(t *testing.T) {
+		c.forget(k1)
+		if isNew, _ := c.begin(k1); isNew {
+			t.Fatal("the entry answered has been forgotten")
+		}
+
+		c.begin(k2)
+		c.forget(k2)
+		if isNew, _ := c.begin(k2); !isNew {
+			t.Fatal("the entry not answered has not been forgotten")
+		}
+	})
+
+	t.Run("Eviction", func(t *testing.T) {
+		c.begin(k3)
+		if got, want := c.len(), 2; got != want {
+			t.Fatalf("got %v want %v", got, want)
+		}
+		if isNew, _ := c.begin(k1); !isNew {
+			t.Fatal("the oldest entry has not been evicted")
+		}
+	})
+
+	t.Run("Expiry", func(t *testing.T) {
+		time.Sleep(60 * time.Millisecond)
+		if isNew, _ := c.begin(k3); !isNew {
+			t.Fatal("the entry has not expired")
+		}
+		if got, want := c.len(), 1; got != want {
+			t.Fatalf("got %v want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,437 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/internal/logger"
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// Default values of the retransmission parameters.
+//
+// TS 29.244 leaves T1 and N1 to the operator, and these are the values
+// commonly used in the deployments.
+const (
+	DefaultT1 = 3 * time.Second
+	DefaultN1 = 3
+)
+
+// maxPacketSize is the size of the buffer used to read the incoming packets.
+const maxPacketSize = 0xffff
+
+// Config is a set of parameters to configure Conn.
+//
+// Zero values are replaced with the default ones.
+type Config struct {
+	// T1 is the time to wait for a response before retransmitting a request.
+	T1 time.Duration
+	// N1 is the maximum number of retransmissions of a request.
+	N1 int
+
+	// ResponseCacheSize is the maximum number of responses kept to answer
+	// the retransmitted requests. The cache is disabled if it is negative.
+	ResponseCacheSize int
+	// ResponseCacheExpiry is how long a response is kept in the cache.
+	ResponseCacheExpiry time.Duration
+}
+
+// Conn represents a PFCP connection on top of a net.PacketConn.
+//
+// A Conn sends requests reliably with SendRequest and dispatches the incoming
+// requests to a RequestHandler while Serve is running. As the responses are
+// read by Serve, SendRequest never returns a response unless Serve is running.
+//
+// A request encoded in a version of PFCP other than message.SupportedVersion
+// is answered with Version Not Supported Response while Serve is running with
+// a handler, as required by TS 29.244 clause 7.2.2.
+//
+// The responses sent with RespondTo are cached, and a retransmitted request
+// (the one with the same peer, sequence number and message type) is answered
+// with the cached response instead of being dispatched to the handler again,
+// as required by TS 29.244 clause 6.4.
+type Conn struct {
+	pc    net.PacketConn
+	t1    time.Duration
+	n1    int
+	cache *responseCache
+	seq   *SequenceAllocator
+
+	mu       sync.Mutex
+	pending  map[transactionKey]chan message.Message
+	inFlight map[uint32]int
+	serving  bool
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// transactionKey identifies a request waiting for the response.
+type transactionKey struct {
+	peer string
+	seq  uint32
+}
+
+// RequestHandler is called with each request received on Conn.
+//
+// Each call is made in its own goroutine, and the handler is expected to send
+// the response with Conn.RespondTo before returning. If the handler returns
+// without responding, the retransmissions of the request are handled as new
+// requests.
+type RequestHandler func(c *Conn, peer net.Addr, req message.Message)
+
+// NewConn creates a new Conn that works on top of pc.
+//
+// The Conn takes the ownership of pc, which is closed when the Conn is closed.
+// cfg can be nil to use the default parameters.
+func NewConn(pc net.PacketConn, cfg *Config) *Conn {
+	c := &Conn{
+		pc:       pc,
+		t1:       DefaultT1,
+		n1:       DefaultN1,
+		pending:  map[transactionKey]chan message.Message{},
+		inFlight: map[uint32]int{},
+		closed:   make(chan struct{}),
+	}
+
+	// start from a random number not to collide with the responses the peers
+	// may have cached for the requests sent before restart.
+	c.seq = NewSequenceAllocator(rand.Uint32(), c.isInFlight)
+
+	cacheSize, cacheExpiry := DefaultResponseCacheSize, DefaultResponseCacheExpiry
+	if cfg != nil {
+		if cfg.T1 > 0 {
+			c.t1 = cfg.T1
+		}
+		if cfg.N1 > 0 {
+			c.n1 = cfg.N1
+		}
+		if cfg.ResponseCacheSize != 0 {
+			cacheSize = cfg.ResponseCacheSize
+		}
+		if cfg.ResponseCacheExpiry > 0 {
+			cacheExpiry = cfg.ResponseCacheExpiry
+		}
+	}
+
+	if cacheSize > 0 {
+		c.cache = newResponseCache(cacheSize, cacheExpiry)
+	}
+
+	return c
+}
+
+// Listen creates a new Conn listening on the given network address.
+//
+// The network must be a packet-oriented one, such as "udp", "udp4" or "udp6".
+func Listen(network, address string, cfg *Config) (*Conn, error) {
+	pc, err := net.ListenPacket(network, address)
+	if err != nil {
+		return nil, err
+	}
+	return NewConn(pc, cfg), nil
+}
+
+// NextSequenceNumber returns the next sequence number available for
+// the requests sent from the Conn, which skips the ones still in flight.
+//
+// SendRequest calls this by itself, so there is usually no need to call this
+// unless the request is sent by other means.
+func (c *Conn) NextSequenceNumber() (uint32, error) {
+	return c.seq.Next()
+}
+
+func (c *Conn) isInFlight(seq uint32) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.inFlight[seq] > 0
+}
+
+// LocalAddr returns the local network address.
+func (c *Conn) LocalAddr() net.Addr {
+	return c.pc.LocalAddr()
+}
+
+// Close closes the connection.
+//
+// Any blocked SendRequest is unblocked and returns ErrConnClosed.
+func (c *Conn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		err = c.pc.Close()
+	})
+	return err
+}
+
+// Serve reads the incoming messages until ctx is done or the Conn is closed.
+//
+// The responses are passed to the corresponding SendRequest, and the requests
+// are dispatched to h. If h is nil, the requests are ignored, which is useful
+// when the Conn is used only to send requests.
+//
+// Serve always returns a non-nil error.
+func (c *Conn) Serve(ctx context.Context, h RequestHandler) error {
+	c.mu.Lock()
+	if c.serving {
+		c.mu.Unlock()
+		return ErrAlreadyServing
+	}
+	c.serving = true
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		c.serving = false
+		c.mu.Unlock()
+	}()
+
+	// unblock ReadFrom when ctx is done.
+	if err := c.pc.SetReadDeadline(time.Time{}); err != nil {
+		return err
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = c.pc.SetReadDeadline(time.Now())
+		case <-stop:
+		}
+	}()
+
+	buf := make([]byte, maxPacketSize)
+	for {
+		n, peer, err := c.pc.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			select {
+			case <-c.closed:
+				return ErrConnClosed
+			default:
+				return err
+			}
+		}
+
+		// copy the bytes as message.Parse does not copy the given buffer.
+		b := make([]byte, n)
+		copy(b, buf[:n])
+		c.handlePacket(peer, b, h)
+	}
+}
+
+func (c *Conn) handlePacket(peer net.Addr, b []byte, h RequestHandler) {
+	msg, err := message.ParseStrict(b)
+	if err != nil {
+		var verr *message.VersionNotSupportedError
+		if errors.As(err, &verr) && h != nil && message.IsRequestType(verr.Header.Type) {
+			c.rejectVersion(peer, verr.Header)
+			return
+		}
+		logger.Logf("ignored undecodable message from %s: %v", peer, err)
+		return
+	}
+
+	if !msg.IsRequest() {
+		c.deliver(peer, msg)
+		return
+	}
+
+	if h == nil {
+		logger.Logf("ignored %s from %s: no handler is registered", msg.MessageTypeName(), peer)
+		return
+	}
+
+	if c.cache == nil {
+		go h(c, peer, msg)
+		return
+	}
+
+	key := cacheKeyOf(peer, msg)
+	isNew, res := c.cache.begin(key)
+	if !isNew {
+		c.replay(peer, msg, res)
+		return
+	}
+	go func() {
+		h(c, peer, msg)
+		c.cache.forget(key)
+	}()
+}
+
+// rejectVersion answers the request with the header h with Version Not
+// Supported Response.
+func (c *Conn) rejectVersion(peer net.Addr, h *message.Header) {
+	if err := c.SendMessageTo(message.NewVersionNotSupportedResponse(h.Sequence()), peer); err != nil {
+		logger.Logf("failed to send Version Not Supported Response to %s: %v", peer, err)
+	}
+}
+
+// replay answers the retransmitted request with the response already sent.
+func (c *Conn) replay(peer net.Addr, req message.Message, res []byte) {
+	if res == nil {
+		logger.Logf("ignored retransmitted %s from %s: the original request is still being handled", req.MessageTypeName(), peer)
+		return
+	}
+
+	if _, err := c.pc.WriteTo(res, peer); err != nil {
+		logger.Logf("failed to resend the response to %s from %s: %v", req.MessageTypeName(), peer, err)
+	}
+}
+
+func cacheKeyOf(peer net.Addr, req message.Message) cacheKey {
+	return cacheKey{peer: peer.String(), seq: req.Sequence(), msgType: req.MessageType()}
+}
+
+// deliver passes the response to the SendRequest waiting for it.
+func (c *Conn) deliver(peer net.Addr, res message.Message) {
+	key := transactionKey{peer: peer.String(), seq: res.Sequence()}
+
+	c.mu.Lock()
+	ch, ok := c.pending[key]
+	if ok {
+		delete(c.pending, key)
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		logger.Logf("ignored %s from %s: no request is waiting for Seq=%d", res.MessageTypeName(), peer, res.Sequence())
+		return
+	}
+	ch <- res
+}
+
+// SendRequest sends a request to peer and waits for the response.
+//
+// The sequence number of msg is overwritten with the one allocated by the
+// Conn, so the caller does not need to manage it.
+//
+// The response is identified by the sequence number of msg and the address
+// of peer. If no response is received within T1, the request is retransmitted
+// up to N1 times, and then *TimeoutError is returned. If the peer answers
+// with Version Not Supported Response, *VersionNotSupportedError is returned.
+//
+// Serve must be running on the Conn to receive the response.
+func (c *Conn) SendRequest(ctx context.Context, peer net.Addr, msg message.Message) (message.Message, error) {
+	if !msg.IsRequest() {
+		return nil, ErrNotRequest
+	}
+
+	seq, err := c.NextSequenceNumber()
+	if err != nil {
+		return nil, err
+	}
+	msg.SetSequenceNumber(seq)
+
+	// the buffer is kept until the transaction ends for retransmissions.
+	buf, err := message.MarshalBuffer(msg)
+	if err != nil {
+		return nil, err
+	}
+	defer buf.Release()
+	b := buf.Bytes()
+
+	key := transactionKey{peer: peer.String(), seq: seq}
+	ch := make(chan message.Message, 1)
+
+	c.mu.Lock()
+	if _, ok := c.pending[key]; ok {
+		c.mu.Unlock()
+		return nil, ErrDuplicateSeqNum
+	}
+	c.pending[key] = ch
+	c.inFlight[seq]++
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		if c.pending[key] == ch {
+			delete(c.pending, key)
+		}
+		if c.inFlight[seq]--; c.inFlight[seq] <= 0 {
+			delete(c.inFlight, seq)
+		}
+		c.mu.Unlock()
+	}()
+
+	timer := time.NewTimer(c.t1)
+	defer timer.Stop()
+
+	for sent := 0; ; {
+		if _, err := c.pc.WriteTo(b, peer); err != nil {
+			return nil, err
+		}
+		sent++
+
+		select {
+		case res := <-ch:
+			if res.MessageType() == message.MsgTypeVersionNotSupportedResponse {
+				return nil, &VersionNotSupportedError{
+					Peer:        peer,
+					MessageType: msg.MessageType(),
+					Sequence:    msg.Sequence(),
+				}
+			}
+			return res, nil
+		case <-timer.C:
+			if sent > c.n1 {
+				return nil, &TimeoutError{
+					Peer:          peer,
+					MessageType:   msg.MessageType(),
+					Sequence:      msg.Sequence(),
+					Transmissions: sent,
+				}
+			}
+			timer.Reset(c.t1)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-c.closed:
+			return nil, ErrConnClosed
+		}
+	}
+}
+
+// SendMessageTo sends a message to peer without waiting for any response.
+func (c *Conn) SendMessageTo(msg message.Message, peer net.Addr) error {
+	buf, err := message.MarshalBuffer(msg)
+	if err != nil {
+		return err
+	}
+	defer buf.Release()
+
+	_, err = c.pc.WriteTo(buf.Bytes(), peer)
+	return err
+}
+
+// RespondTo sends res to peer as the response to req.
+//
+// The sequence number of res is overwritten with the one in req, and the
+// response is cached to answer the retransmissions of req.
+func (c *Conn) RespondTo(peer net.Addr, req, res message.Message) error {
+	res.SetSequenceNumber(req.Sequence())
+	if c.cache == nil {
+		return c.SendMessageTo(res, peer)
+	}
+
+	// the bytes are kept in the cache, so they are not taken from the pool.
+	b := make([]byte, res.MarshalLen())
+	if err := res.MarshalTo(b); err != nil {
+		return err
+	}
+
+	c.cache.store(cacheKeyOf(peer, req), b)
+
+	_, err := c.pc.WriteTo(b, peer)
+	return err
+}
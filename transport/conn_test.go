@@ -0,0 +1,316 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package transport_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/transport"
+	"github.com/wmnsk/go-pfcp/transport/memnet"
+)
+
+var ts = time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// setup returns a pair of Conns on nw served until the test ends.
+// The requests received on the second Conn are passed to h.
+func setup(t *testing.T, nw *memnet.Network, cfg *transport.Config, h transport.RequestHandler) (*transport.Conn, *transport.Conn) {
+	t.Helper()
+
+	pc1, pc2 := nw.Pipe()
+	client, server := transport.NewConn(pc1, cfg), transport.NewConn(pc2, cfg)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() { _ = client.Serve(ctx, nil) }()
+	go func() { _ = server.Serve(ctx, h) }()
+
+	t.Cleanup(func() {
+		cancel()
+		_ = client.Close()
+		_ = server.Close()
+	})
+	return client, server
+}
+
+func TestSendRequest(t *testing.T) {
+	client, server := setup(t, memnet.NewNetwork(nil), &transport.Config{T1: 50 * time.Millisecond, N1: 2},
+		func(c *transport.Conn, peer net.Addr, req message.Message) {
+			res := message.NewHeartbeatResponse(0, ie.NewRecoveryTimeStamp(ts))
+			if err := c.RespondTo(peer, req, res); err != nil {
+				t.Error(err)
+			}
+		},
+	)
+
+	req := message.NewHeartbeatRequest(0x112233, ie.NewRecoveryTimeStamp(ts), nil)
+	res, err := client.SendRequest(context.Background(), server.LocalAddr(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := res.(*message.HeartbeatResponse); !ok {
+		t.Errorf("got unexpected response: %s", res.MessageTypeName())
+	}
+	if got, want := res.Sequence(), req.Sequence(); got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestVersionNotSupported(t *testing.T) {
+	client, server := setup(t, memnet.NewNetwork(nil), &transport.Config{T1: 50 * time.Millisecond, N1: 2},
+		func(c *transport.Conn, peer net.Addr, req message.Message) {
+			t.Errorf("got unexpected request: %s", req.MessageTypeName())
+		},
+	)
+
+	req := message.NewHeartbeatRequest(0, ie.NewRecoveryTimeStamp(ts), nil)
+	req.Header.Flags = 2<<5 | req.Header.Flags&0x1f // version 2
+	_, err := client.SendRequest(context.Background(), server.LocalAddr(), req)
+
+	var verr *transport.VersionNotSupportedError
+	if !errors.As(err, &verr) {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+	if got, want := verr.Sequence, req.Sequence(); got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestSendRequestRetransmission(t *testing.T) {
+	var received int32
+	// disable the cache to let the retransmissions reach the handler.
+	client, server := setup(t, memnet.NewNetwork(nil), &transport.Config{T1: 50 * time.Millisecond, N1: 2, ResponseCacheSize: -1},
+		func(c *transport.Conn, peer net.Addr, req message.Message) {
+			// drop the first two transmissions.
+			if atomic.AddInt32(&received, 1) < 3 {
+				return
+			}
+			res := message.NewHeartbeatResponse(0, ie.NewRecoveryTimeStamp(ts))
+			if err := c.RespondTo(peer, req, res); err != nil {
+				t.Error(err)
+			}
+		},
+	)
+
+	req := message.NewHeartbeatRequest(1, ie.NewRecoveryTimeStamp(ts), nil)
+	if _, err := client.SendRequest(context.Background(), server.LocalAddr(), req); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := atomic.LoadInt32(&received), int32(3); got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestSendRequestTimeout(t *testing.T) {
+	var received int32
+	client, server := setup(t, memnet.NewNetwork(nil), &transport.Config{T1: 20 * time.Millisecond, N1: 2, ResponseCacheSize: -1},
+		func(c *transport.Conn, peer net.Addr, req message.Message) {
+			atomic.AddInt32(&received, 1)
+		},
+	)
+
+	req := message.NewHeartbeatRequest(1, ie.NewRecoveryTimeStamp(ts), nil)
+	_, err := client.SendRequest(context.Background(), server.LocalAddr(), req)
+
+	var terr *transport.TimeoutError
+	if !errors.As(err, &terr) {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+	if got, want := terr.Transmissions, 3; got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+	if got, want := terr.Sequence, req.Sequence(); got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+
+	// give the last transmission time to arrive.
+	time.Sleep(20 * time.Millisecond)
+	if got, want := atomic.LoadInt32(&received), int32(3); got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestSendRequestContext(t *testing.T) {
+	client, server := setup(t, memnet.NewNetwork(nil), nil, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req := message.NewHeartbeatRequest(1, ie.NewRecoveryTimeStamp(ts), nil)
+	if _, err := client.SendRequest(ctx, server.LocalAddr(), req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got unexpected error: %v", err)
+	}
+}
+
+func TestSendRequestNotRequest(t *testing.T) {
+	client, server := setup(t, memnet.NewNetwork(nil), nil, nil)
+
+	res := message.NewHeartbeatResponse(1, ie.NewRecoveryTimeStamp(ts))
+	if _, err := client.SendRequest(context.Background(), server.LocalAddr(), res); !errors.Is(err, transport.ErrNotRequest) {
+		t.Errorf("got unexpected error: %v", err)
+	}
+}
+
+func TestDuplicateRequest(t *testing.T) {
+	var handled int32
+	nw := memnet.NewNetwork(nil)
+	client, server := setup(t, nw, &transport.Config{T1: 20 * time.Millisecond, N1: 5},
+		func(c *transport.Conn, peer net.Addr, req message.Message) {
+			atomic.AddInt32(&handled, 1)
+
+			// let the client retransmit the request while handling it.
+			time.Sleep(50 * time.Millisecond)
+			res := message.NewHeartbeatResponse(0, ie.NewRecoveryTimeStamp(ts))
+			if err := c.RespondTo(peer, req, res); err != nil {
+				t.Error(err)
+			}
+		},
+	)
+
+	req := message.NewHeartbeatRequest(0, ie.NewRecoveryTimeStamp(ts), nil)
+	if _, err := client.SendRequest(context.Background(), server.LocalAddr(), req); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := atomic.LoadInt32(&handled), int32(1); got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+
+	// send the same request twice from a raw socket, and the second one should
+	// be answered with the cached response without being handled.
+	pc, err := nw.Listen("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pc.Close()
+
+	b, err := req.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 1500)
+	for range 2 {
+		if _, err := pc.WriteTo(b, server.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := pc.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		n, _, err := pc.ReadFrom(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := message.Parse(buf[:n])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := res.Sequence(), req.Sequence(); got != want {
+			t.Errorf("got %v want %v", got, want)
+		}
+	}
+
+	// the one from the raw socket is handled once as it comes from another peer.
+	if got, want := atomic.LoadInt32(&handled), int32(2); got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestSequenceAllocation(t *testing.T) {
+	client, server := setup(t, memnet.NewNetwork(nil), &transport.Config{T1: 20 * time.Millisecond, N1: 1},
+		func(c *transport.Conn, peer net.Addr, req message.Message) {
+			res := message.NewHeartbeatResponse(0, ie.NewRecoveryTimeStamp(ts))
+			if err := c.RespondTo(peer, req, res); err != nil {
+				t.Error(err)
+			}
+		},
+	)
+
+	seen := map[uint32]bool{}
+	for range 3 {
+		req := message.NewHeartbeatRequest(0, ie.NewRecoveryTimeStamp(ts), nil)
+		res, err := client.SendRequest(context.Background(), server.LocalAddr(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[res.Sequence()] {
+			t.Errorf("sequence number %d is allocated twice", res.Sequence())
+		}
+		seen[res.Sequence()] = true
+	}
+}
+
+func TestImpairedNetwork(t *testing.T) {
+	t.Run("Loss", func(t *testing.T) {
+		// drop the first transmission of every request.
+		seen := map[uint32]bool{}
+		nw := memnet.NewNetwork(&memnet.Config{
+			Filter: func(p *memnet.Packet) memnet.Action {
+				if p.Message == nil || !p.Message.IsRequest() || seen[p.Message.Sequence()] {
+					return memnet.ActionPass
+				}
+				seen[p.Message.Sequence()] = true
+				return memnet.ActionDrop
+			},
+		})
+
+		var handled int32
+		client, server := setup(t, nw, &transport.Config{T1: 20 * time.Millisecond, N1: 1},
+			func(c *transport.Conn, peer net.Addr, req message.Message) {
+				atomic.AddInt32(&handled, 1)
+				if err := c.RespondTo(peer, req, message.NewHeartbeatResponse(0, ie.NewRecoveryTimeStamp(ts))); err != nil {
+					t.Error(err)
+				}
+			},
+		)
+
+		for range 3 {
+			req := message.NewHeartbeatRequest(0, ie.NewRecoveryTimeStamp(ts), nil)
+			if _, err := client.SendRequest(context.Background(), server.LocalAddr(), req); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if got, want := atomic.LoadInt32(&handled), int32(3); got != want {
+			t.Errorf("got %v want %v", got, want)
+		}
+		if got, want := nw.Stats().Dropped, 3; got != want {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("Duplication", func(t *testing.T) {
+		nw := memnet.NewNetwork(&memnet.Config{Duplicate: 1})
+
+		var handled int32
+		client, server := setup(t, nw, nil,
+			func(c *transport.Conn, peer net.Addr, req message.Message) {
+				atomic.AddInt32(&handled, 1)
+				if err := c.RespondTo(peer, req, message.NewHeartbeatResponse(0, ie.NewRecoveryTimeStamp(ts))); err != nil {
+					t.Error(err)
+				}
+			},
+		)
+
+		for range 3 {
+			req := message.NewHeartbeatRequest(0, ie.NewRecoveryTimeStamp(ts), nil)
+			if _, err := client.SendRequest(context.Background(), server.LocalAddr(), req); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// give the duplicated packets time to arrive.
+		time.Sleep(10 * time.Millisecond)
+		if got, want := atomic.LoadInt32(&handled), int32(3); got != want {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+}
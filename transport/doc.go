@@ -0,0 +1,11 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package transport provides the reliable delivery of PFCP messages over
+// a net.PacketConn, as described in TS 29.244 clause 6.4.
+//
+// Conn sends requests and waits for the corresponding responses, matching them
+// by the sequence number and the address of the peer. A request is retransmitted
+// every T1 until a response is received or it has been retransmitted N1 times.
+package transport
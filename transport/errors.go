@@ -0,0 +1,66 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"net"
+)
+
+// Error definitions.
+var (
+	ErrNotRequest       = errors.New("message is not a request")
+	ErrConnClosed       = errors.New("connection is closed")
+	ErrAlreadyServing   = errors.New("connection is already being served")
+	ErrDuplicateSeqNum  = errors.New("a request with the same sequence number is already in flight")
+	ErrNoSequenceNumber = errors.New("no sequence number is available")
+	ErrQueueFull        = errors.New("request rejected as the queue is full")
+	ErrQueueClosed      = errors.New("queue is closed")
+)
+
+// TimeoutError indicates that no response has been received for a request
+// after it had been retransmitted N1 times.
+type TimeoutError struct {
+	Peer          net.Addr
+	MessageType   uint8
+	Sequence      uint32
+	Transmissions int
+}
+
+// Error returns message with the request and the number of transmissions.
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("no response to message(Type=%d, Seq=%d) from %s after %d transmissions",
+		e.MessageType, e.Sequence, e.Peer, e.Transmissions,
+	)
+}
+
+// Timeout reports whether the error is a timeout. It always returns true.
+func (e *TimeoutError) Timeout() bool {
+	return true
+}
+
+// Temporary reports whether the error is temporary. It always returns false.
+//
+// This is implemented only to satisfy the net.Error interface.
+func (e *TimeoutError) Temporary() bool {
+	return false
+}
+
+// VersionNotSupportedError indicates that the peer has answered a request with
+// Version Not Supported Response, i.e., it does not support the version of
+// PFCP the request is encoded in.
+type VersionNotSupportedError struct {
+	Peer        net.Addr
+	MessageType uint8
+	Sequence    uint32
+}
+
+// Error returns message with the request rejected.
+func (e *VersionNotSupportedError) Error() string {
+	return fmt.Sprintf("message(Type=%d, Seq=%d) rejected by %s: version not supported",
+		e.MessageType, e.Sequence, e.Peer,
+	)
+}
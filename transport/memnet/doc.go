@@ -0,0 +1,11 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+// Package memnet provides an in-memory network of net.PacketConn, which can be
+// used to run the transport and server packages in tests without sockets.
+//
+// The network can impair the packets with loss, reordering, duplication and
+// delay at random, or deterministically with a Filter that sees the decoded
+// PFCP messages.
+package memnet
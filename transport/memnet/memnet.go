@@ -0,0 +1,385 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package memnet
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/netip"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// queueSize is the number of packets each PacketConn can hold before being
+// read. Packets arriving at a full queue are dropped, as UDP does.
+const queueSize = 1024
+
+// DefaultReorderDelay is the default extra delay of the reordered packets.
+const DefaultReorderDelay = 10 * time.Millisecond
+
+// Error definitions.
+var (
+	ErrAddrInUse = errors.New("address already in use")
+)
+
+// Action is the action a Filter takes on a packet.
+type Action uint8
+
+// Action definitions.
+const (
+	// ActionPass lets the packet go through the random impairments configured.
+	ActionPass Action = iota
+	// ActionDrop drops the packet.
+	ActionDrop
+	// ActionDuplicate delivers the packet twice.
+	ActionDuplicate
+)
+
+// Packet is a packet sent on the Network.
+type Packet struct {
+	From, To net.Addr
+	Data     []byte
+	// Message is the PFCP message decoded from Data, which is nil if it is not
+	// a valid PFCP message.
+	Message message.Message
+}
+
+// Config is a set of parameters to configure the impairments of Network.
+//
+// The probabilities are in the range of 0 to 1, and zero value means no
+// impairment.
+type Config struct {
+	// Loss is the probability that a packet is dropped.
+	Loss float64
+	// Duplicate is the probability that a packet is delivered twice.
+	Duplicate float64
+	// Reorder is the probability that a packet is delayed by ReorderDelay, so
+	// that the packets sent after it arrive earlier.
+	Reorder float64
+	// ReorderDelay is the extra delay of the reordered packets. If zero,
+	// DefaultReorderDelay is used.
+	ReorderDelay time.Duration
+	// Delay is the delay of all packets.
+	Delay time.Duration
+	// Seed is the seed of the random impairments, which makes them
+	// reproducible.
+	Seed uint64
+
+	// Filter, if given, is called for every packet before the random
+	// impairments are applied. It may be called concurrently.
+	Filter func(p *Packet) Action
+}
+
+// Stats is the statistics of the packets on the Network.
+type Stats struct {
+	Sent       int
+	Dropped    int
+	Duplicated int
+	Reordered  int
+	Delivered  int
+}
+
+// Network is an in-memory network of PacketConns. It is safe for concurrent
+// use.
+type Network struct {
+	mu       sync.Mutex
+	cfg      Config
+	rand     *rand.Rand
+	conns    map[netip.AddrPort]*PacketConn
+	nextPort uint16
+	stats    Stats
+}
+
+// NewNetwork creates a new Network with the impairments configured with cfg.
+// cfg can be nil to deliver all packets immediately.
+func NewNetwork(cfg *Config) *Network {
+	n := &Network{
+		conns:    map[netip.AddrPort]*PacketConn{},
+		nextPort: 10000,
+	}
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	n.SetConfig(cfg)
+	return n
+}
+
+// SetConfig replaces the impairments of the network. This also resets the
+// random source with cfg.Seed.
+func (n *Network) SetConfig(cfg *Config) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.cfg = *cfg
+	if n.cfg.ReorderDelay == 0 {
+		n.cfg.ReorderDelay = DefaultReorderDelay
+	}
+	n.rand = rand.New(rand.NewPCG(cfg.Seed, cfg.Seed))
+}
+
+// Stats returns the statistics of the packets sent so far.
+func (n *Network) Stats() Stats {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.stats
+}
+
+// Listen creates a PacketConn bound to address, which is in the form of
+// "host:port". If address is empty, an address on 127.0.0.1 is allocated.
+func (n *Network) Listen(address string) (*PacketConn, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var ap netip.AddrPort
+	if address == "" {
+		for {
+			ap = netip.AddrPortFrom(netip.AddrFrom4([4]byte{127, 0, 0, 1}), n.nextPort)
+			n.nextPort++
+			if _, ok := n.conns[ap]; !ok {
+				break
+			}
+		}
+	} else {
+		var err error
+		ap, err = netip.ParseAddrPort(address)
+		if err != nil {
+			return nil, err
+		}
+		ap = netip.AddrPortFrom(ap.Addr().Unmap(), ap.Port())
+		if _, ok := n.conns[ap]; ok {
+			return nil, fmt.Errorf("%s: %w", address, ErrAddrInUse)
+		}
+	}
+
+	c := &PacketConn{
+		net:    n,
+		addr:   ap,
+		in:     make(chan *Packet, queueSize),
+		closed: make(chan struct{}),
+		wake:   make(chan struct{}),
+	}
+	n.conns[ap] = c
+	return c, nil
+}
+
+// Pipe creates a pair of PacketConns on the Network with the addresses
+// allocated automatically.
+func (n *Network) Pipe() (*PacketConn, *PacketConn) {
+	a, _ := n.Listen("") // never fails with an empty address
+	b, _ := n.Listen("")
+	return a, b
+}
+
+// send applies the impairments to p and schedules the delivery.
+func (n *Network) send(p *Packet) {
+	n.mu.Lock()
+	filter := n.cfg.Filter
+	n.mu.Unlock()
+
+	action := ActionPass
+	if filter != nil {
+		if msg, err := message.Parse(p.Data); err == nil {
+			p.Message = msg
+		}
+		action = filter(p)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.stats.Sent++
+
+	copies := 1
+	switch action {
+	case ActionDrop:
+		n.stats.Dropped++
+		return
+	case ActionDuplicate:
+		copies = 2
+	default:
+		if n.chance(n.cfg.Loss) {
+			n.stats.Dropped++
+			return
+		}
+		if n.chance(n.cfg.Duplicate) {
+			copies = 2
+		}
+	}
+	if copies > 1 {
+		n.stats.Duplicated++
+	}
+
+	delay := n.cfg.Delay
+	if action == ActionPass && n.chance(n.cfg.Reorder) {
+		delay += n.cfg.ReorderDelay
+		n.stats.Reordered++
+	}
+
+	to, ok := n.conns[addrPort(p.To)]
+	if !ok {
+		n.stats.Dropped += copies
+		return
+	}
+	for range copies {
+		if delay == 0 {
+			n.deliver(to, p)
+			continue
+		}
+		time.AfterFunc(delay, func() {
+			n.mu.Lock()
+			defer n.mu.Unlock()
+			n.deliver(to, p)
+		})
+	}
+}
+
+// deliver puts p into the queue of c. n.mu must be held.
+func (n *Network) deliver(c *PacketConn, p *Packet) {
+	select {
+	case <-c.closed:
+		n.stats.Dropped++
+	case c.in <- p:
+		n.stats.Delivered++
+	default:
+		n.stats.Dropped++
+	}
+}
+
+// chance returns true with the probability p. n.mu must be held.
+func (n *Network) chance(p float64) bool {
+	return p > 0 && n.rand.Float64() < p
+}
+
+func (n *Network) remove(c *PacketConn) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.conns, c.addr)
+}
+
+// addrPort converts addr to netip.AddrPort, which is invalid if addr is not
+// an IP address with port.
+func addrPort(addr net.Addr) netip.AddrPort {
+	switch a := addr.(type) {
+	case *net.UDPAddr:
+		ap := a.AddrPort()
+		return netip.AddrPortFrom(ap.Addr().Unmap(), ap.Port())
+	default:
+		ap, _ := netip.ParseAddrPort(addr.String())
+		return ap
+	}
+}
+
+// PacketConn is a net.PacketConn on a Network.
+type PacketConn struct {
+	net  *Network
+	addr netip.AddrPort
+	in   chan *Packet
+
+	closeOnce sync.Once
+	closed    chan struct{}
+
+	mu       sync.Mutex
+	deadline time.Time
+	wake     chan struct{} // closed when the deadline is changed
+}
+
+// ReadFrom reads a packet from the connection.
+func (c *PacketConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	for {
+		c.mu.Lock()
+		deadline, wake := c.deadline, c.wake
+		c.mu.Unlock()
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if !deadline.IsZero() {
+			d := time.Until(deadline)
+			if d <= 0 {
+				return 0, nil, c.opError("read", os.ErrDeadlineExceeded)
+			}
+			timer = time.NewTimer(d)
+			timeout = timer.C
+		}
+
+		select {
+		case p := <-c.in:
+			stopTimer(timer)
+			return copy(b, p.Data), p.From, nil
+		case <-c.closed:
+			stopTimer(timer)
+			return 0, nil, c.opError("read", net.ErrClosed)
+		case <-timeout:
+			return 0, nil, c.opError("read", os.ErrDeadlineExceeded)
+		case <-wake:
+			stopTimer(timer)
+		}
+	}
+}
+
+// WriteTo writes a packet with payload b to addr.
+//
+// As with UDP, it does not fail even if there is no PacketConn bound to addr.
+func (c *PacketConn) WriteTo(b []byte, addr net.Addr) (int, error) {
+	select {
+	case <-c.closed:
+		return 0, c.opError("write", net.ErrClosed)
+	default:
+	}
+
+	data := make([]byte, len(b))
+	copy(data, b)
+	c.net.send(&Packet{From: c.LocalAddr(), To: addr, Data: data})
+	return len(b), nil
+}
+
+// Close closes the connection, and unbinds the address from the Network.
+func (c *PacketConn) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		c.net.remove(c)
+	})
+	return nil
+}
+
+// LocalAddr returns the local address as *net.UDPAddr.
+func (c *PacketConn) LocalAddr() net.Addr {
+	return net.UDPAddrFromAddrPort(c.addr)
+}
+
+// SetDeadline sets the read deadline, as there is no deadline for writes.
+func (c *PacketConn) SetDeadline(t time.Time) error {
+	return c.SetReadDeadline(t)
+}
+
+// SetReadDeadline sets the deadline for ReadFrom.
+func (c *PacketConn) SetReadDeadline(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.deadline = t
+	close(c.wake)
+	c.wake = make(chan struct{})
+	return nil
+}
+
+// SetWriteDeadline does nothing, as writes never block.
+func (c *PacketConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+func stopTimer(t *time.Timer) {
+	if t != nil {
+		t.Stop()
+	}
+}
+
+func (c *PacketConn) opError(op string, err error) error {
+	return &net.OpError{Op: op, Net: "memnet", Addr: c.LocalAddr(), Err: err}
+}
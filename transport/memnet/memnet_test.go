@@ -0,0 +1,166 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package memnet_test
+
+import (
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/transport/memnet"
+)
+
+// read reads a packet from pc and returns the payload, or nil if no packet
+// arrives within a while.
+func read(t *testing.T, pc net.PacketConn) []byte {
+	t.Helper()
+
+	if err := pc.SetReadDeadline(time.Now().Add(50 * time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 1500)
+	n, _, err := pc.ReadFrom(buf)
+	if err != nil {
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return nil
+		}
+		t.Fatal(err)
+	}
+	return buf[:n]
+}
+
+func TestPacketConn(t *testing.T) {
+	nw := memnet.NewNetwork(nil)
+
+	t.Run("Delivery", func(t *testing.T) {
+		a, b := nw.Pipe()
+		defer a.Close()
+		defer b.Close()
+
+		if _, err := a.WriteTo([]byte("foo"), b.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := string(read(t, b)), "foo"; got != want {
+			t.Errorf("got %s want %s", got, want)
+		}
+	})
+
+	t.Run("Listen", func(t *testing.T) {
+		pc, err := nw.Listen("10.0.0.1:8805")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer pc.Close()
+
+		if got, want := pc.LocalAddr().String(), "10.0.0.1:8805"; got != want {
+			t.Errorf("got %s want %s", got, want)
+		}
+		if _, err := nw.Listen("10.0.0.1:8805"); !errors.Is(err, memnet.ErrAddrInUse) {
+			t.Errorf("got unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		a, _ := nw.Pipe()
+
+		errCh := make(chan error, 1)
+		go func() {
+			_, _, err := a.ReadFrom(make([]byte, 1500))
+			errCh <- err
+		}()
+		if err := a.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := <-errCh; !errors.Is(err, net.ErrClosed) {
+			t.Errorf("got unexpected error: %v", err)
+		}
+	})
+}
+
+func TestImpairments(t *testing.T) {
+	t.Run("Loss", func(t *testing.T) {
+		nw := memnet.NewNetwork(&memnet.Config{Loss: 1})
+		a, b := nw.Pipe()
+
+		if _, err := a.WriteTo([]byte("foo"), b.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+		if got := read(t, b); got != nil {
+			t.Errorf("got %s, want nothing", got)
+		}
+		if got, want := nw.Stats().Dropped, 1; got != want {
+			t.Errorf("got %d want %d", got, want)
+		}
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		nw := memnet.NewNetwork(&memnet.Config{Duplicate: 1})
+		a, b := nw.Pipe()
+
+		if _, err := a.WriteTo([]byte("foo"), b.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+		for range 2 {
+			if got, want := string(read(t, b)), "foo"; got != want {
+				t.Errorf("got %s want %s", got, want)
+			}
+		}
+	})
+
+	t.Run("Reorder", func(t *testing.T) {
+		nw := memnet.NewNetwork(&memnet.Config{Reorder: 1})
+		a, b := nw.Pipe()
+
+		if _, err := a.WriteTo([]byte("foo"), b.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+		nw.SetConfig(&memnet.Config{})
+		if _, err := a.WriteTo([]byte("bar"), b.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, want := range []string{"bar", "foo"} {
+			if got := string(read(t, b)); got != want {
+				t.Errorf("got %s want %s", got, want)
+			}
+		}
+	})
+
+	t.Run("Delay", func(t *testing.T) {
+		nw := memnet.NewNetwork(&memnet.Config{Delay: 20 * time.Millisecond})
+		a, b := nw.Pipe()
+
+		start := time.Now()
+		if _, err := a.WriteTo([]byte("foo"), b.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := string(read(t, b)), "foo"; got != want {
+			t.Errorf("got %s want %s", got, want)
+		}
+		if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+			t.Errorf("delivered after %s, want 20ms at least", elapsed)
+		}
+	})
+
+	t.Run("Seed", func(t *testing.T) {
+		// the same seed gives the same result.
+		var delivered []int
+		for range 2 {
+			nw := memnet.NewNetwork(&memnet.Config{Loss: 0.5, Seed: 1})
+			a, b := nw.Pipe()
+			for range 20 {
+				if _, err := a.WriteTo([]byte("foo"), b.LocalAddr()); err != nil {
+					t.Fatal(err)
+				}
+			}
+			delivered = append(delivered, nw.Stats().Delivered)
+		}
+		if delivered[0] != delivered[1] {
+			t.Errorf("got different results with the same seed: %v", delivered)
+		}
+	})
+}
@@ -0,0 +1,263 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package transport
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/wmnsk/go-pfcp/message"
+)
+
+// NumPriorities is the number of the message priorities, which are carried in
+// the 4-bit Message Priority field of the header.
+const NumPriorities = 16
+
+// LowestPriority is the lowest message priority. 0 is the highest.
+const LowestPriority uint8 = NumPriorities - 1
+
+// Default values of QueueConfig.
+const (
+	DefaultQueueSize    = 1024
+	DefaultQueueWorkers = 64
+)
+
+// QueueConfig is a set of parameters to configure SendQueue.
+type QueueConfig struct {
+	// Size is the maximum number of requests waiting in the queue.
+	Size int
+	// Workers is the maximum number of requests waiting for the responses at
+	// the same time. The requests beyond this wait in the queue.
+	Workers int
+}
+
+// SendQueue is a queue of the outgoing requests, which sends the requests
+// on a Conn in the order of the message priority, as described in TS 29.244
+// clause 6.4.
+//
+// The priority of a request is the value of the Message Priority field if
+// the MP flag is set in the header. The node-related requests are treated
+// as the highest priority 0, and the session-related ones without MP flag
+// as the lowest priority, LowestPriority.
+//
+// When the queue is full, the request with the lowest priority is rejected
+// with ErrQueueFull.
+type SendQueue struct {
+	conn    *Conn
+	size    int
+	workers int
+
+	mu     sync.Mutex
+	queues [NumPriorities][]*queuedRequest
+	len    int
+	busy   int
+	closed bool
+}
+
+type queuedRequest struct {
+	ctx  context.Context
+	peer net.Addr
+	msg  message.Message
+	pri  uint8
+
+	// done receives the result exactly once.
+	done chan queueResult
+}
+
+type queueResult struct {
+	res message.Message
+	err error
+}
+
+// NewSendQueue creates a new SendQueue that sends the requests on conn.
+// cfg can be nil to use the default parameters.
+func NewSendQueue(conn *Conn, cfg *QueueConfig) *SendQueue {
+	q := &SendQueue{
+		conn:    conn,
+		size:    DefaultQueueSize,
+		workers: DefaultQueueWorkers,
+	}
+	if cfg != nil {
+		if cfg.Size > 0 {
+			q.size = cfg.Size
+		}
+		if cfg.Workers > 0 {
+			q.workers = cfg.Workers
+		}
+	}
+	return q
+}
+
+// Priority returns the priority of msg in the queue.
+func Priority(msg message.Message) uint8 {
+	h, ok := msg.(interface {
+		HasSEID() bool
+		HasMP() bool
+		MP() uint8
+	})
+	if !ok || !h.HasSEID() {
+		return 0
+	}
+	if !h.HasMP() {
+		return LowestPriority
+	}
+	return h.MP()
+}
+
+// SendRequest queues msg and waits until it is sent to peer and the
+// response is received, in the same way as Conn.SendRequest.
+//
+// It returns ErrQueueFull if the request is rejected as the queue is full,
+// either on queuing or later for a request with the higher priority.
+func (q *SendQueue) SendRequest(ctx context.Context, peer net.Addr, msg message.Message) (message.Message, error) {
+	if !msg.IsRequest() {
+		return nil, ErrNotRequest
+	}
+
+	r := &queuedRequest{
+		ctx:  ctx,
+		peer: peer,
+		msg:  msg,
+		pri:  Priority(msg),
+		done: make(chan queueResult, 1),
+	}
+	if err := q.push(r); err != nil {
+		return nil, err
+	}
+
+	select {
+	case result := <-r.done:
+		return result.res, result.err
+	case <-ctx.Done():
+		if q.remove(r) {
+			return nil, ctx.Err()
+		}
+		// already being sent, which fails soon with ctx.
+		result := <-r.done
+		return result.res, result.err
+	}
+}
+
+// Depth returns the number of requests waiting in the queue per priority.
+func (q *SendQueue) Depth() [NumPriorities]int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var depth [NumPriorities]int
+	for pri, requests := range q.queues {
+		depth[pri] = len(requests)
+	}
+	return depth
+}
+
+// Len returns the number of requests waiting in the queue.
+func (q *SendQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.len
+}
+
+// Close rejects all the requests waiting in the queue with ErrQueueClosed.
+// The ones already sent are not affected.
+func (q *SendQueue) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.closed = true
+	for pri, requests := range q.queues {
+		for _, r := range requests {
+			r.done <- queueResult{err: ErrQueueClosed}
+		}
+		q.queues[pri] = nil
+	}
+	q.len = 0
+}
+
+// push adds r to the queue, evicting the one with the lowest priority if the
+// queue is full, and starts sending if possible.
+func (q *SendQueue) push(r *queuedRequest) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrQueueClosed
+	}
+
+	if q.len >= q.size {
+		victim := q.lowest()
+		if victim < 0 || uint8(victim) <= r.pri {
+			return ErrQueueFull
+		}
+
+		// the newest one with the lowest priority is rejected.
+		requests := q.queues[victim]
+		requests[len(requests)-1].done <- queueResult{err: ErrQueueFull}
+		q.queues[victim] = requests[:len(requests)-1]
+		q.len--
+	}
+
+	q.queues[r.pri] = append(q.queues[r.pri], r)
+	q.len++
+	q.dispatch()
+	return nil
+}
+
+// remove removes r from the queue, and reports whether it was in the queue.
+func (q *SendQueue) remove(r *queuedRequest) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	requests := q.queues[r.pri]
+	for n, queued := range requests {
+		if queued == r {
+			q.queues[r.pri] = append(requests[:n], requests[n+1:]...)
+			q.len--
+			return true
+		}
+	}
+	return false
+}
+
+// lowest returns the lowest priority that has any request, or -1 if the
+// queue is empty. q.mu must be held.
+func (q *SendQueue) lowest() int {
+	for pri := NumPriorities - 1; pri >= 0; pri-- {
+		if len(q.queues[pri]) > 0 {
+			return pri
+		}
+	}
+	return -1
+}
+
+// dispatch starts sending the requests with the highest priority while the
+// number of requests in flight is below the limit. q.mu must be held.
+func (q *SendQueue) dispatch() {
+	for q.busy < q.workers && q.len > 0 {
+		var r *queuedRequest
+		for pri := range q.queues {
+			if len(q.queues[pri]) > 0 {
+				r = q.queues[pri][0]
+				q.queues[pri] = q.queues[pri][1:]
+				break
+			}
+		}
+		q.len--
+		q.busy++
+
+		go q.send(r)
+	}
+}
+
+func (q *SendQueue) send(r *queuedRequest) {
+	res, err := q.conn.SendRequest(r.ctx, r.peer, r.msg)
+	r.done <- queueResult{res: res, err: err}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.busy--
+	q.dispatch()
+}
@@ -0,0 +1,146 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package transport_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-pfcp/ie"
+	"github.com/wmnsk/go-pfcp/message"
+	"github.com/wmnsk/go-pfcp/transport"
+	"github.com/wmnsk/go-pfcp/transport/memnet"
+)
+
+func newPrioritizedRequest(pri uint8) message.Message {
+	req := message.NewSessionModificationRequest(0, 0, 1, 0, 0)
+	req.SetMP(pri)
+	return req
+}
+
+// waitDepth waits for the queue to have n requests with the priority pri.
+func waitDepth(t *testing.T, q *transport.SendQueue, pri uint8, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for q.Depth()[pri] != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d requests with priority %d queued, want %d", q.Depth()[pri], pri, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestPriority(t *testing.T) {
+	for _, c := range []struct {
+		description string
+		msg         message.Message
+		want        uint8
+	}{
+		{"NodeRelated", message.NewHeartbeatRequest(0, ie.NewRecoveryTimeStamp(ts), nil), 0},
+		{"WithoutMP", message.NewSessionModificationRequest(0, 0, 1, 0, 0), transport.LowestPriority},
+		{"WithMP", newPrioritizedRequest(3), 3},
+	} {
+		t.Run(c.description, func(t *testing.T) {
+			if got := transport.Priority(c.msg); got != c.want {
+				t.Errorf("got %d want %d", got, c.want)
+			}
+		})
+	}
+}
+
+func TestSendQueue(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		order []uint8
+	)
+	gate, blocked := make(chan struct{}), make(chan struct{})
+	client, server := setup(t, memnet.NewNetwork(nil), &transport.Config{T1: time.Second, N1: 1},
+		func(c *transport.Conn, peer net.Addr, req message.Message) {
+			pri := transport.Priority(req)
+			if pri == 0 {
+				// block the first request to make the queue congested.
+				close(blocked)
+				<-gate
+			}
+
+			mu.Lock()
+			order = append(order, pri)
+			mu.Unlock()
+
+			res := message.NewSessionModificationResponse(0, 0, 1, 0, 0, ie.NewCause(ie.CauseRequestAccepted))
+			if err := c.RespondTo(peer, req, res); err != nil {
+				t.Error(err)
+			}
+		},
+	)
+
+	q := transport.NewSendQueue(client, &transport.QueueConfig{Size: 3, Workers: 1})
+	defer q.Close()
+
+	var wg sync.WaitGroup
+	errs := make(map[uint8]error)
+	send := func(pri uint8) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := q.SendRequest(context.Background(), server.LocalAddr(), newPrioritizedRequest(pri))
+			mu.Lock()
+			errs[pri] = err
+			mu.Unlock()
+		}()
+	}
+
+	send(0)
+	<-blocked
+	for _, pri := range []uint8{5, 1, 3} {
+		send(pri)
+		waitDepth(t, q, pri, 1)
+	}
+
+	depth := q.Depth()
+	for _, pri := range []uint8{1, 3, 5} {
+		if got := depth[pri]; got != 1 {
+			t.Errorf("got depth %d for priority %d, want 1", got, pri)
+		}
+	}
+
+	// the queue is full: the lowest priority is rejected for a higher one.
+	send(2)
+	waitDepth(t, q, 2, 1)
+	if got := q.Depth()[5]; got != 0 {
+		t.Errorf("priority 5 is still queued")
+	}
+	if _, err := q.SendRequest(context.Background(), server.LocalAddr(), newPrioritizedRequest(4)); !errors.Is(err, transport.ErrQueueFull) {
+		t.Errorf("got unexpected error: %v", err)
+	}
+
+	close(gate)
+	wg.Wait()
+
+	if !errors.Is(errs[5], transport.ErrQueueFull) {
+		t.Errorf("got unexpected error for priority 5: %v", errs[5])
+	}
+	for _, pri := range []uint8{0, 1, 2, 3} {
+		if errs[pri] != nil {
+			t.Errorf("got unexpected error for priority %d: %v", pri, errs[pri])
+		}
+	}
+
+	want := []uint8{0, 1, 2, 3}
+	if len(order) != len(want) {
+		t.Fatalf("got %v want %v", order, want)
+	}
+	for n := range want {
+		if order[n] != want[n] {
+			t.Errorf("got %v want %v", order, want)
+			break
+		}
+	}
+}
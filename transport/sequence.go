@@ -0,0 +1,54 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package transport
+
+import (
+	"sync"
+)
+
+// MaxSequenceNumber is the largest sequence number, as the field is only
+// 24 bits long on the wire.
+const MaxSequenceNumber uint32 = 0xffffff
+
+// SequenceAllocator allocates the sequence numbers of the requests sent from
+// a local node. It is safe for concurrent use.
+//
+// The sequence numbers are allocated in ascending order and wrap around to 0
+// after MaxSequenceNumber, skipping the ones still in use.
+type SequenceAllocator struct {
+	mu    sync.Mutex
+	next  uint32
+	inUse func(seq uint32) bool
+}
+
+// NewSequenceAllocator creates a new SequenceAllocator that starts allocating
+// from start.
+//
+// inUse reports whether a sequence number is still used by a request waiting
+// for the response, and it can be nil if there is no need to skip any.
+func NewSequenceAllocator(start uint32, inUse func(seq uint32) bool) *SequenceAllocator {
+	return &SequenceAllocator{
+		next:  start & MaxSequenceNumber,
+		inUse: inUse,
+	}
+}
+
+// Next returns the next sequence number available.
+//
+// It returns ErrNoSequenceNumber if all the sequence numbers are in use.
+func (a *SequenceAllocator) Next() (uint32, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for range MaxSequenceNumber + 1 {
+		seq := a.next
+		a.next = (a.next + 1) & MaxSequenceNumber
+
+		if a.inUse == nil || !a.inUse(seq) {
+			return seq, nil
+		}
+	}
+	return 0, ErrNoSequenceNumber
+}
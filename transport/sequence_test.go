@@ -0,0 +1,79 @@
+// Copyright go-pfcp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package transport_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/wmnsk/go-pfcp/transport"
+)
+
+func TestSequenceAllocator(t *testing.T) {
+	t.Run("Wraparound", func(t *testing.T) {
+		a := transport.NewSequenceAllocator(transport.MaxSequenceNumber-1, nil)
+		for _, want := range []uint32{0xfffffe, 0xffffff, 0, 1} {
+			got, err := a.Next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("got %#x want %#x", got, want)
+			}
+		}
+	})
+
+	t.Run("SkipInUse", func(t *testing.T) {
+		a := transport.NewSequenceAllocator(1, func(seq uint32) bool {
+			return seq == 2 || seq == 3
+		})
+		for _, want := range []uint32{1, 4, 5} {
+			got, err := a.Next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("got %v want %v", got, want)
+			}
+		}
+	})
+
+	t.Run("Exhausted", func(t *testing.T) {
+		a := transport.NewSequenceAllocator(1, func(seq uint32) bool { return true })
+		if _, err := a.Next(); !errors.Is(err, transport.ErrNoSequenceNumber) {
+			t.Errorf("got unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		a := transport.NewSequenceAllocator(0, nil)
+
+		var (
+			mu   sync.Mutex
+			wg   sync.WaitGroup
+			seen = map[uint32]bool{}
+		)
+		for range 100 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				seq, err := a.Next()
+				if err != nil {
+					t.Error(err)
+					return
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+				if seen[seq] {
+					t.Errorf("sequence number %d is allocated twice", seq)
+				}
+				seen[seq] = true
+			}()
+		}
+		wg.Wait()
+	})
+}